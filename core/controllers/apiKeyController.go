@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type apiKeyController struct {
+	models *models.ApiKeyModel
+}
+
+var ApiKeyCtl = &apiKeyController{
+	models: &models.ApiKeyModel{},
+}
+
+type apiKeySecret struct {
+	Api_key string `json:"api_key"`
+}
+
+// swagger:operation GET /v1/auth/user/apikey/get apiKeyController getApiKey
+//
+// 查询服务账号的API key
+//
+// 不返回API key明文
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: service account id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *apiKeyController) Get(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+	if _, ok := userDomain(ctx, user_id, "r"); !ok {
+		return
+	}
+
+	rst, err := this.models.Get(user_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_api_key_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/user/apikey/post apiKeyController postApiKey
+//
+// 为服务账号创建API key
+//
+// API key明文只在创建时返回一次. 调用接口时通过 Authorization: Bearer <api key> 或者 X-Api-Key 头部传入
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: service account id
+//   required: true
+//   type: string
+//   format:
+// - name: key_name
+//   in: query
+//   description: api key name
+//   required: true
+//   type: string
+//   format:
+// - name: scopes
+//   in: query
+//   description: resource ids separated by space, empty for all granted resources
+//   required: false
+//   type: string
+//   format:
+// - name: expire_days
+//   in: query
+//   description: 1 to 3650, default 90
+//   required: false
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *apiKeyController) Post(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	user_id := ctx.Request.FormValue("userId")
+	if _, ok := userDomain(ctx, user_id, "w"); !ok {
+		return
+	}
+
+	key, msg, err := this.models.Post(user_id, ctx.Request.FormValue("key_name"), ctx.Request.FormValue("scopes"),
+		ctx.Request.FormValue("expire_days"), jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, apiKeySecret{Api_key: key})
+}
+
+// swagger:operation POST /v1/auth/user/apikey/delete apiKeyController deleteApiKey
+//
+// 删除服务账号的API key
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: service account id
+//   required: true
+//   type: string
+//   format:
+// - name: key_id
+//   in: query
+//   description: api key id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *apiKeyController) Delete(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+	if _, ok := userDomain(ctx, user_id, "w"); !ok {
+		return
+	}
+
+	msg, err := this.models.Delete(user_id, ctx.Request.FormValue("key_id"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
@@ -42,11 +42,6 @@ var DomainCtl = &domainController{models: &models.DomainMmodel{}}
 func (this *domainController) Page(ctx *context.Context) {
 	defer hret.HttpPanic()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("DomainPage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.Get(ctx.Request, "as_of_date_page_not_exist"))
@@ -82,12 +77,6 @@ func (this *domainController) Page(ctx *context.Context) {
 func (this *domainController) Get(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	// 权限控制
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := this.models.Get()
 	if err != nil {
 		logs.Error(err)
@@ -136,16 +125,10 @@ func (this *domainController) Get(ctx *context.Context) {
 func (this *domainController) Post(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	// Check user permissions
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 
 	// get user connection information from cookie
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
 		return
@@ -195,11 +178,6 @@ func (this *domainController) Post(ctx *context.Context) {
 //     description: success
 func (this *domainController) Delete(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	ijs := []byte(ctx.Request.FormValue("JSON"))
 	var js []models.DomainMmodel
 	err := json.Unmarshal(ijs, &js)
@@ -209,8 +187,7 @@ func (this *domainController) Delete(ctx *context.Context) {
 		return
 	}
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "as_of_date_disconnect"))
@@ -280,15 +257,9 @@ func (this *domainController) Delete(ctx *context.Context) {
 func (this *domainController) Put(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	form := ctx.Request.Form
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -367,8 +338,7 @@ func (this *domainController) GetDetails(ctx *context.Context) {
 func (this *domainController) GetId(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request), err)
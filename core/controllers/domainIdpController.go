@@ -0,0 +1,331 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type domainIdpController struct {
+	models *models.DomainIdpModel
+}
+
+var DomainIdpCtl = &domainIdpController{
+	models: &models.DomainIdpModel{},
+}
+
+// swagger:operation GET /v1/auth/domain/idp/get domainIdpController getDomainIdp
+//
+// 查询域配置的外部身份提供者
+//
+// 不返回应用密钥
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *domainIdpController) Get(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "r") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, domain_id))
+		return
+	}
+
+	rst, err := this.models.Get(domain_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_idp_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation PUT /v1/auth/domain/idp/put domainIdpController putDomainIdp
+//
+// 配置域的外部身份提供者
+//
+// 没有配置时新增,已经配置时修改. 在身份提供者中注册应用时,回调地址是本系统的/login/oidc/callback
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: issuer
+//   in: query
+//   description: issuer of identity provider
+//   required: true
+//   type: string
+//   format:
+// - name: client_id
+//   in: query
+//   description: client id registered in identity provider
+//   required: true
+//   type: string
+//   format:
+// - name: client_secret
+//   in: query
+//   description: client secret, empty to keep the old secret
+//   required: false
+//   type: string
+//   format:
+// - name: scopes
+//   in: query
+//   description: openid profile email
+//   required: false
+//   type: string
+//   format:
+// - name: link_email
+//   in: query
+//   description: 1 link user by verified email
+//   required: true
+//   type: string
+//   format:
+// - name: status
+//   in: query
+//   description: 0 valid, 1 disabled
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *domainIdpController) Put(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	arg := models.DomainIdpData{
+		Domain_id:  ctx.Request.FormValue("domain_id"),
+		Issuer:     ctx.Request.FormValue("issuer"),
+		Client_id:  ctx.Request.FormValue("client_id"),
+		Scopes:     ctx.Request.FormValue("scopes"),
+		Link_email: ctx.Request.FormValue("link_email"),
+		Status:     ctx.Request.FormValue("status"),
+	}
+	if !hrpc.DomainAuth(ctx.Request, arg.Domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, arg.Domain_id))
+		return
+	}
+
+	msg, err := this.models.Put(arg, ctx.Request.FormValue("client_secret"), jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/domain/idp/delete domainIdpController deleteDomainIdp
+//
+// 删除域的外部身份提供者
+//
+// 用户绑定的外部身份不会删除,重新配置同一个身份提供者后仍然可以使用
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *domainIdpController) Delete(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	msg, err := this.models.Delete(domain_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// 查询用户所在的域,并校验当前用户对这个域的权限,以及用户是否在当前用户的机构范围内
+func userDomain(ctx *context.Context, user_id string, mode string) (string, bool) {
+	did, err := hrpc.GetDomainId(user_id)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_get_domain"), err)
+		return "", false
+	}
+	if !hrpc.DomainAuth(ctx.Request, did, mode) {
+		if mode == "r" {
+			hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, did))
+		} else {
+			hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, did))
+		}
+		return "", false
+	}
+	if !userInScope(ctx, user_id) {
+		return "", false
+	}
+	return did, true
+}
+
+// swagger:operation GET /v1/auth/user/identity/get domainIdpController getUserIdentity
+//
+// 查询用户绑定的外部身份
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *domainIdpController) GetIdentity(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+	if _, ok := userDomain(ctx, user_id, "r"); !ok {
+		return
+	}
+
+	rst, err := this.models.GetIdentity(user_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_idp_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/user/identity/post domainIdpController postUserIdentity
+//
+// 绑定外部身份
+//
+// 把身份提供者中的用户绑定到本系统的用户,subject是身份提供者签发的ID token中的sub
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// - name: issuer
+//   in: query
+//   description: issuer of identity provider
+//   required: true
+//   type: string
+//   format:
+// - name: subject
+//   in: query
+//   description: subject in identity provider
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *domainIdpController) PostIdentity(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+	did, ok := userDomain(ctx, user_id, "w")
+	if !ok {
+		return
+	}
+
+	msg, err := this.models.PostIdentity(user_id, did, ctx.Request.FormValue("issuer"), ctx.Request.FormValue("subject"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/user/identity/delete domainIdpController deleteUserIdentity
+//
+// 解除外部身份绑定
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// - name: identity_id
+//   in: query
+//   description: identity id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *domainIdpController) DeleteIdentity(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+	if _, ok := userDomain(ctx, user_id, "w"); !ok {
+		return
+	}
+
+	msg, err := this.models.DeleteIdentity(user_id, ctx.Request.FormValue("identity_id"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
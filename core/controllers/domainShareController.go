@@ -60,12 +60,6 @@ var DomainShareCtl = DomainShareController{
 func (DomainShareController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	// Check the user permissions
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	// check the domain details
 	// config this domain to others
 	var domain_id = ctx.Request.FormValue("domain_id")
@@ -116,17 +110,11 @@ func (DomainShareController) Page(ctx *context.Context) {
 func (this DomainShareController) Get(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	domain_id := ctx.Request.FormValue("domain_id")
 	// if the request argument domain_id is empty,
 	// so set domain_id yourself.
 	if validator.IsEmpty(domain_id) {
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -227,10 +215,6 @@ func (this DomainShareController) UnAuth(ctx *context.Context) {
 func (this DomainShareController) Post(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 
 	domain_id := form.Get("domain_id")
@@ -240,8 +224,7 @@ func (this DomainShareController) Post(ctx *context.Context) {
 		return
 	}
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 421, i18n.Disconnect(ctx.Request))
@@ -289,11 +272,6 @@ func (this DomainShareController) Post(ctx *context.Context) {
 func (this DomainShareController) Delete(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	domain_id := ctx.Request.FormValue("domain_id")
 	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
 		hret.Error(ctx.ResponseWriter, 420, i18n.Get(ctx.Request, "as_of_date_domain_permission_denied_modify"))
@@ -356,10 +334,6 @@ func (this DomainShareController) Delete(ctx *context.Context) {
 func (this DomainShareController) Put(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 
 	domain_id := form.Get("domain_id")
@@ -369,8 +343,7 @@ func (this DomainShareController) Put(ctx *context.Context) {
 	}
 
 	// get user session from cookies
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 421, i18n.Disconnect(ctx.Request))
@@ -409,8 +382,7 @@ func (this DomainShareController) Put(ctx *context.Context) {
 func (this *DomainShareController) GetAccessDomain(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -445,8 +417,7 @@ func (this *DomainShareController) GetAccessDomain(ctx *context.Context) {
 func (this *DomainShareController) GetDomainOwner(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 外部身份提供者登录失败时,回到登录页面,通过login_error参数显示错误信息
+func federationError(ctx *context.Context, msg string) {
+	uri := "/?login_error=" + url.QueryEscape(i18n.Get(ctx.Request, msg))
+	http.Redirect(ctx.ResponseWriter, ctx.Request, uri, http.StatusFound)
+}
+
+// swagger:operation GET /login/oidc LoginSystem LoginOidc
+//
+// 使用外部身份提供者登录
+//
+// 跳转到域配置的OpenID Connect身份提供者,用户在身份提供者中登录后回调/login/oidc/callback
+//
+// ---
+// produces:
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '302':
+//     description: redirect to identity provider
+func LoginOidc(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	if loginThrottledRedirect(ctx) {
+		return
+	}
+
+	uri, state, msg, err := hrpc.FederatedLoginURL(ctx.Request, ctx.Request.FormValue("domain_id"))
+	if err != nil {
+		federationError(ctx, msg)
+		return
+	}
+	http.SetCookie(ctx.ResponseWriter, &http.Cookie{
+		Name:     hrpc.FederationStateCookie,
+		Value:    state,
+		Path:     "/login/oidc",
+		HttpOnly: true,
+	})
+	http.Redirect(ctx.ResponseWriter, ctx.Request, uri, http.StatusFound)
+}
+
+// swagger:operation GET /login/oidc/callback LoginSystem LoginOidcCallback
+//
+// 外部身份提供者登录回调
+//
+// 校验state与ID token,找到身份提供者中的用户绑定的本系统用户后签发token,然后进入主菜单页面.
+// 与账号密码登录一样,启用或者被要求启用两步验证的用户,回到登录页面完成两步验证后再签发token.
+//
+// ---
+// produces:
+// - text/html
+// parameters:
+// - name: code
+//   in: query
+//   description: authorization code
+//   required: true
+//   type: string
+//   format:
+// - name: state
+//   in: query
+//   description: state
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '302':
+//     description: redirect to home page
+func LoginOidcCallback(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	// state只能使用一次,先清除cookie
+	cok, cerr := ctx.Request.Cookie(hrpc.FederationStateCookie)
+	http.SetCookie(ctx.ResponseWriter, &http.Cookie{
+		Name:     hrpc.FederationStateCookie,
+		Path:     "/login/oidc",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	if e := ctx.Request.FormValue("error"); e != "" {
+		logs.Warn("idp login failed, error is:", e, ctx.Request.FormValue("error_description"))
+		federationError(ctx, "error_idp_denied")
+		return
+	}
+
+	// 回调中的state必须是这个浏览器发起登录时生成的state
+	state := ctx.Request.FormValue("state")
+	if cerr != nil || state == "" || cok.Value != state {
+		federationError(ctx, "error_idp_state")
+		return
+	}
+
+	if loginThrottledRedirect(ctx) {
+		return
+	}
+
+	user, msg, err := hrpc.FederatedCallback(ctx.Request, state, ctx.Request.FormValue("code"))
+	if err != nil {
+		logs.Error(err)
+		federationError(ctx, msg)
+		return
+	}
+
+	orgid, err := indexModels.GetDefaultOrgId(user.User_id)
+	if err != nil {
+		logs.Error(user.User_id, " 用户没有指定机构", err)
+		federationError(ctx, "error_user_no_org")
+		return
+	}
+
+	// 挑战码放在URL的片段中传给登录页面,片段不会发送到服务端,也不会出现在Referer中
+	enrolled := hrpc.MfaEnabled(user.User_id)
+	if enrolled || hrpc.MfaRequired(user.User_id, user.Domain_id) {
+		challenge, err := hrpc.NewMfaChallenge(user.User_id, user.Domain_id, orgid)
+		if err != nil {
+			federationError(ctx, "error_mfa_challenge_invalid")
+			return
+		}
+		uri := "/#mfa_challenge=" + challenge
+		if enrolled {
+			uri += "&mfa_enrolled=1"
+		}
+		logs.Info("user ", user.User_id, " login by idp, issuer is:", user.Issuer, ", waiting for mfa")
+		http.Redirect(ctx.ResponseWriter, ctx.Request, uri, http.StatusFound)
+		return
+	}
+
+	pair, err := hrpc.IssueTokens(ctx.Request, user.User_id, user.Domain_id, orgid)
+	if err != nil {
+		logs.Error(user.User_id, " 签发token失败", err)
+		federationError(ctx, "error_refresh_token_issue")
+		return
+	}
+	logs.Info("user ", user.User_id, " login by idp, issuer is:", user.Issuer)
+	setTokenCookie(ctx.ResponseWriter, pair)
+	http.Redirect(ctx.ResponseWriter, ctx.Request, "/HomePage", http.StatusFound)
+}
+
+// 外部身份提供者登录只按照客户端IP限流,限流时回到登录页面
+func loginThrottledRedirect(ctx *context.Context) bool {
+	ok, _ := hrpc.AllowLogin(hrpc.ClientIP(ctx.Request), "")
+	if !ok {
+		federationError(ctx, "error_login_too_many")
+	}
+	return !ok
+}
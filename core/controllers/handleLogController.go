@@ -6,7 +6,6 @@ import (
 
 	"github.com/astaxie/beego/context"
 	"github.com/hzwy23/hauth/core/groupcache"
-	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
@@ -42,11 +41,6 @@ var HandleLogsCtl = &handleLogsController{}
 func (this *handleLogsController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("AsofdateHandleLogPage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
@@ -78,14 +72,9 @@ func (this *handleLogsController) Page(ctx *context.Context) {
 func (this handleLogsController) Download(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	ctx.ResponseWriter.Header().Set("Content-Type", "application/vnd.ms-excel")
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -132,6 +121,12 @@ func (this handleLogsController) Download(ctx *context.Context) {
 
 		cell7 := row.AddCell()
 		cell7.Value = v.Data
+
+		cell8 := row.AddCell()
+		cell8.Value = v.Api_key
+
+		cell9 := row.AddCell()
+		cell9.Value = v.Impersonator
 	}
 
 	file.Write(ctx.ResponseWriter)
@@ -175,19 +170,12 @@ func (this handleLogsController) Download(ctx *context.Context) {
 func (this handleLogsController) GetHandleLogs(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	// Check the user permissions
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	// Get form data from client request.
 	offset := ctx.Request.FormValue("offset")
 	limit := ctx.Request.FormValue("limit")
 
 	// Get user connection information from cookie.
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -251,20 +239,13 @@ func (this handleLogsController) GetHandleLogs(ctx *context.Context) {
 func (this handleLogsController) SerachLogs(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	// Check the user permissions
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	// Get form data from request.
 	userid := ctx.Request.FormValue("UserId")
 	start := ctx.Request.FormValue("StartDate")
 	end := ctx.Request.FormValue("EndDate")
 
 	// get user connection information from cookie
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -3,7 +3,6 @@ package controllers
 import (
 	"github.com/astaxie/beego/context"
 	"github.com/hzwy23/hauth/core/groupcache"
-	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 )
@@ -31,11 +30,6 @@ var HelpCtl = &helpController{}
 func (this helpController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
 
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("AsofdateHelpPage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
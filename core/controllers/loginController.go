@@ -3,11 +3,12 @@ package controllers
 import (
 	"html/template"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/astaxie/beego/context"
 	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
-	"github.com/hzwy23/hauth/utils/crypto/haes"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 	"github.com/hzwy23/hauth/utils/jwt"
@@ -37,8 +38,7 @@ func HomePage(ctx *context.Context) {
 		ctx.Redirect(302, "/")
 	})
 
-	cok, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cok.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		ctx.Redirect(302, "/")
@@ -52,7 +52,18 @@ func HomePage(ctx *context.Context) {
 		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_get_login_page"), err)
 		return
 	}
-	h.Execute(ctx.ResponseWriter, jclaim.UserId)
+	h.Execute(ctx.ResponseWriter, homePageData{
+		UserId:       jclaim.UserId,
+		Impersonator: jclaim.Impersonator,
+		ExpiresAt:    time.Unix(jclaim.ExpiresAt, 0).Format("2006-01-02 15:04:05"),
+	})
+}
+
+// 主菜单页面的数据,管理员模拟用户登录时,页面显示提示信息
+type homePageData struct {
+	UserId       string
+	Impersonator string
+	ExpiresAt    string
 }
 
 // swagger:operation POST /login LoginSystem LoginSystem
@@ -88,10 +99,15 @@ func LoginSystem(ctx *context.Context) {
 
 	userId := ctx.Request.FormValue("username")
 	userPasswd := ctx.Request.FormValue("password")
-	psd, err := haes.Encrypt(userPasswd)
-	if err != nil {
-		logs.Error("decrypt passwd failed.", psd)
-		hret.Error(ctx.ResponseWriter, 400, i18n.Get(ctx.Request, "error_system"))
+
+	if loginThrottled(ctx, userId) {
+		return
+	}
+
+	// 先校验密码,外部认证方式可能在校验时创建本地用户
+	ok, code, cnt, rmsg := hrpc.Authenticate(userId, userPasswd)
+	if !ok {
+		hret.Error(ctx.ResponseWriter, code, i18n.Get(ctx.Request, rmsg), cnt)
 		return
 	}
 
@@ -109,14 +125,129 @@ func LoginSystem(ctx *context.Context) {
 		return
 	}
 
-	if ok, code, cnt, rmsg := hrpc.CheckPasswd(userId, psd); ok {
-		token := jwt.GenToken(userId, domainId, orgid, 86400)
-		cookie := http.Cookie{Name: "Authorization", Value: token, Path: "/", MaxAge: 86400}
-		http.SetCookie(ctx.ResponseWriter, &cookie)
-		hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
-	} else {
-		hret.Error(ctx.ResponseWriter, code, i18n.Get(ctx.Request, rmsg), cnt)
+	// 启用或者被要求启用两步验证的用户,返回挑战码,校验一次性密码后再签发token
+	enrolled := hrpc.MfaEnabled(userId)
+	if enrolled || hrpc.MfaRequired(userId, domainId) {
+		challenge, err := hrpc.NewMfaChallenge(userId, domainId, orgid)
+		if err != nil {
+			hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_mfa_challenge_invalid"), err)
+			return
+		}
+		hret.Json(ctx.ResponseWriter, mfaChallenge{
+			Mfa_required: true,
+			Mfa_enrolled: enrolled,
+			Challenge:    challenge,
+		})
+		return
+	}
+
+	pair, err := hrpc.IssueTokens(ctx.Request, userId, domainId, orgid)
+	if err != nil {
+		logs.Error(userId, " 签发token失败", err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_refresh_token_issue"), err)
+		return
+	}
+	setTokenCookie(ctx.ResponseWriter, pair)
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// 两步验证的挑战信息
+// Mfa_enrolled为false时,用户需要先使用挑战码绑定身份验证器
+type mfaChallenge struct {
+	Mfa_required bool   `json:"mfa_required"`
+	Mfa_enrolled bool   `json:"mfa_enrolled"`
+	Challenge    string `json:"challenge"`
+}
+
+// swagger:operation POST /login/mfa LoginSystem LoginMfa
+//
+// 两步验证登录
+//
+// 密码校验通过后,客户端提交登录接口返回的挑战码,以及身份验证器中的一次性密码或者恢复码,
+// 校验成功后签发token. 登录时完成绑定的用户,返回新生成的恢复码.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: challenge
+//   in: query
+//   description: challenge returned by login
+//   required: true
+//   type: string
+//   format:
+// - name: code
+//   in: query
+//   description: one-time password or recovery code
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func LoginMfa(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	challenge := ctx.Request.FormValue("challenge")
+	code := ctx.Request.FormValue("code")
+
+	rst, msg, err := hrpc.VerifyMfaChallenge(challenge, code)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 401, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+
+	pair, err := hrpc.IssueTokens(ctx.Request, rst.User_id, rst.Domain_id, rst.Org_unit_id)
+	if err != nil {
+		logs.Error(rst.User_id, " 签发token失败", err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_refresh_token_issue"), err)
+		return
+	}
+	setTokenCookie(ctx.ResponseWriter, pair)
+	if len(rst.Recovery_codes) > 0 {
+		hret.Json(ctx.ResponseWriter, rst.Recovery_codes)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /login/mfa/enroll LoginSystem LoginMfaEnroll
+//
+// 登录时绑定身份验证器
+//
+// 要求两步验证但是还没有绑定身份验证器的用户,使用登录接口返回的挑战码获取密钥与二维码地址,
+// 然后通过/login/mfa提交一次性密码完成绑定与登录.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: challenge
+//   in: query
+//   description: challenge returned by login
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func LoginMfaEnroll(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	rst, msg, err := hrpc.EnrollMfaByChallenge(ctx.Request.FormValue("challenge"))
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 401, i18n.Get(ctx.Request, msg), err)
+		return
 	}
+	hret.Json(ctx.ResponseWriter, rst)
 }
 
 //
@@ -149,7 +280,92 @@ func LoginSystem(ctx *context.Context) {
 //   '200':
 //     description: all domain information
 func LogoutSystem(ctx *context.Context) {
-	cookie := http.Cookie{Name: "Authorization", Value: "", Path: "/", MaxAge: -1}
-	http.SetCookie(ctx.ResponseWriter, &cookie)
+	// 吊销当前连接使用的token,防止token被复制后继续使用
+	if jclaim, err := jwt.GetJwtClaims(ctx.Request); err == nil {
+		hrpc.RevokeToken(jclaim, jclaim.UserId)
+	}
+	if cok, err := ctx.Request.Cookie("RefreshToken"); err == nil {
+		hrpc.RevokeRefreshToken(cok.Value)
+	}
+
+	setTokenCookie(ctx.ResponseWriter, nil)
 	hret.Success(ctx.ResponseWriter, i18n.Get(ctx.Request, "logout"))
 }
+
+// swagger:operation POST /v1/auth/token/refresh LoginSystem RefreshToken
+//
+// 刷新token
+//
+// 使用刷新token换取新的访问token与刷新token,旧的刷新token随即失效.
+// 刷新token可以通过cookie中的RefreshToken传入,也可以通过参数refresh_token传入.
+// 如果提交的刷新token已经被使用过,系统将吊销这次登录产生的所有刷新token.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: refresh_token
+//   in: query
+//   description: refresh token
+//   required: false
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func RefreshToken(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	refresh := ctx.Request.FormValue("refresh_token")
+	if refresh == "" {
+		if cok, err := ctx.Request.Cookie("RefreshToken"); err == nil {
+			refresh = cok.Value
+		}
+	}
+
+	pair, msg, err := hrpc.RefreshTokens(refresh)
+	if err != nil {
+		setTokenCookie(ctx.ResponseWriter, nil)
+		hret.Error(ctx.ResponseWriter, 401, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	setTokenCookie(ctx.ResponseWriter, pair)
+	hret.Json(ctx.ResponseWriter, pair)
+}
+
+// 登录请求过于频繁时,返回429,并在Retry-After头部中告诉客户端需要等待的秒数
+func loginThrottled(ctx *context.Context, user_id string) bool {
+	ok, retry := hrpc.AllowLogin(hrpc.ClientIP(ctx.Request), user_id)
+	if ok {
+		return false
+	}
+	ctx.ResponseWriter.Header().Set("Retry-After", strconv.FormatInt(retry, 10))
+	hret.Error(ctx.ResponseWriter, 429, i18n.Get(ctx.Request, "error_login_too_many"), retry)
+	return true
+}
+
+// 将访问token,刷新token与CSRF token写入cookie,pair为nil时清除cookie
+// 刷新token只能由服务端读取,不允许页面脚本访问; CSRF token由页面脚本读取后放到请求头部中
+func setTokenCookie(w http.ResponseWriter, pair *hrpc.TokenPair) {
+	refresh := http.Cookie{Name: "RefreshToken", Value: "", Path: "/", MaxAge: -1, HttpOnly: true}
+	if pair != nil {
+		refresh.Value, refresh.MaxAge = pair.RefreshToken, int(pair.RefreshMaxAge)
+	}
+	setAccessCookie(w, pair)
+	http.SetCookie(w, &refresh)
+}
+
+// 只写入访问token与CSRF token,不修改刷新token
+func setAccessCookie(w http.ResponseWriter, pair *hrpc.TokenPair) {
+	access := http.Cookie{Name: "Authorization", Value: "", Path: "/", MaxAge: -1}
+	csrf := http.Cookie{Name: hrpc.CsrfCookie, Value: "", Path: "/", MaxAge: -1}
+	if pair != nil {
+		access.Value, access.MaxAge = pair.AccessToken, int(pair.AccessMaxAge)
+		csrf.Value, csrf.MaxAge = pair.CsrfToken, int(pair.AccessMaxAge)
+	}
+	http.SetCookie(w, &access)
+	http.SetCookie(w, &csrf)
+}
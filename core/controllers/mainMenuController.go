@@ -47,8 +47,7 @@ func SubSystemEntry(ctx *context.Context) {
 	id := ctx.Request.FormValue("Id")
 
 	// get user connection information from cookie.
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -117,8 +116,7 @@ func HomePageMenus(ctx *context.Context) {
 	Id := ctx.Request.FormValue("Id")
 
 	// get user connection information from cookie
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	claim, err := jwt.ParseJwt(cookie.Value)
+	claim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
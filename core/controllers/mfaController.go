@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type mfaController struct {
+	models *models.MfaModel
+}
+
+var MfaCtl = &mfaController{
+	models: &models.MfaModel{},
+}
+
+// swagger:operation GET /v1/auth/mfa/status mfaController getMfaStatus
+//
+// 查询当前用户两步验证状态
+//
+// 返回用户是否启用了两步验证,是否被要求启用两步验证,以及剩余的恢复码个数
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) Status(ctx *context.Context) {
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+	hret.Json(ctx.ResponseWriter, hrpc.GetMfaStatus(jclaim.UserId, jclaim.DomainId))
+}
+
+// swagger:operation POST /v1/auth/mfa/enroll mfaController enrollMfa
+//
+// 绑定身份验证器
+//
+// 为当前用户生成新的密钥,返回密钥与二维码地址,用户需要提交一次性密码确认绑定
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) Enroll(ctx *context.Context) {
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	rst, msg, err := hrpc.EnrollMfa(jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/mfa/confirm mfaController confirmMfa
+//
+// 确认绑定身份验证器
+//
+// 用户提交身份验证器中的一次性密码,校验成功后启用两步验证,并返回恢复码
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: code
+//   in: query
+//   description: one-time password
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) Confirm(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	codes, msg, err := hrpc.ConfirmMfa(jclaim.UserId, ctx.Request.FormValue("code"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, codes)
+}
+
+// swagger:operation POST /v1/auth/mfa/recovery mfaController regenerateRecoveryCodes
+//
+// 重新生成恢复码
+//
+// 用户提交身份验证器中的一次性密码,校验成功后重新生成恢复码,原有的恢复码全部失效
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: code
+//   in: query
+//   description: one-time password
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) Recovery(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	codes, msg, err := hrpc.RegenerateRecoveryCodes(jclaim.UserId, ctx.Request.FormValue("code"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, codes)
+}
+
+// swagger:operation POST /v1/auth/mfa/reset mfaController resetMfa
+//
+// 重置用户两步验证
+//
+// 管理员删除用户已经绑定的身份验证器与恢复码,用户需要重新绑定
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) Reset(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+
+	did, err := hrpc.GetDomainId(user_id)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_get_domain"), err)
+		return
+	}
+
+	if !hrpc.DomainAuth(ctx.Request, did, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, did))
+		return
+	}
+
+	if !userInScope(ctx, user_id) {
+		return
+	}
+
+	err = hrpc.ResetMfa(user_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_mfa_reset"), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation GET /v1/auth/mfa/require/get mfaController getMfaRequire
+//
+// 查询两步验证要求
+//
+// 返回域,以及域中的角色两步验证要求, require_type为0表示域,为1表示角色
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) GetRequire(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "r") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, domain_id))
+		return
+	}
+
+	rst, err := this.models.GetRequire(domain_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_mfa_require_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/mfa/require/post mfaController postMfaRequire
+//
+// 新增两步验证要求
+//
+// 要求域中的用户,或者拥有指定角色的用户启用两步验证
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: require_type
+//   in: query
+//   description: 0 domain, 1 role
+//   required: true
+//   type: string
+//   format:
+// - name: target_id
+//   in: query
+//   description: domain id or role id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) PostRequire(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	msg, err := this.models.PostRequire(domain_id, ctx.Request.FormValue("require_type"),
+		ctx.Request.FormValue("target_id"), jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/mfa/require/delete mfaController deleteMfaRequire
+//
+// 删除两步验证要求
+//
+// 已经绑定身份验证器的用户不受影响,仍然需要两步验证
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: require_type
+//   in: query
+//   description: 0 domain, 1 role
+//   required: true
+//   type: string
+//   format:
+// - name: target_id
+//   in: query
+//   description: domain id or role id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *mfaController) DeleteRequire(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	msg, err := this.models.DeleteRequire(domain_id, ctx.Request.FormValue("require_type"), ctx.Request.FormValue("target_id"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
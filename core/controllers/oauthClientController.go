@@ -0,0 +1,347 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type oauthClientController struct {
+	models *models.OauthClientModel
+}
+
+var OauthClientCtl = &oauthClientController{
+	models: &models.OauthClientModel{},
+}
+
+// 注册应用与重置密钥时返回应用密钥,密钥只显示一次
+type oauthClientSecret struct {
+	Client_id     string `json:"client_id"`
+	Client_secret string `json:"client_secret"`
+}
+
+func oauthClientForm(ctx *context.Context) models.OauthClientData {
+	return models.OauthClientData{
+		Client_id:     ctx.Request.FormValue("client_id"),
+		Client_name:   ctx.Request.FormValue("client_name"),
+		Redirect_uris: ctx.Request.FormValue("redirect_uris"),
+		Grant_types:   ctx.Request.FormValue("grant_types"),
+		Scopes:        ctx.Request.FormValue("scopes"),
+		Client_type:   ctx.Request.FormValue("client_type"),
+		Trusted:       ctx.Request.FormValue("trusted"),
+		Status:        ctx.Request.FormValue("status"),
+		Domain_id:     ctx.Request.FormValue("domain_id"),
+	}
+}
+
+// swagger:operation GET /v1/auth/oauth/client/get oauthClientController getOauthClient
+//
+// 查询域中注册的应用
+//
+// 返回OpenID Connect应用的回调地址,授权方式与授权范围,不返回应用密钥
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthClientController) Get(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "r") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, domain_id))
+		return
+	}
+
+	rst, err := this.models.Get(domain_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_oauth_client_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/oauth/client/post oauthClientController postOauthClient
+//
+// 注册应用
+//
+// 机密应用返回生成的应用密钥,密钥只返回这一次
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: client_id
+//   in: query
+//   description: client id
+//   required: true
+//   type: string
+//   format:
+// - name: client_name
+//   in: query
+//   description: client name
+//   required: true
+//   type: string
+//   format:
+// - name: client_type
+//   in: query
+//   description: 0 confidential, 1 public
+//   required: true
+//   type: string
+//   format:
+// - name: redirect_uris
+//   in: query
+//   description: redirect uris, separated by space
+//   required: false
+//   type: string
+//   format:
+// - name: grant_types
+//   in: query
+//   description: authorization_code client_credentials
+//   required: true
+//   type: string
+//   format:
+// - name: scopes
+//   in: query
+//   description: openid profile email
+//   required: false
+//   type: string
+//   format:
+// - name: trusted
+//   in: query
+//   description: 1 skip consent page
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthClientController) Post(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	arg := oauthClientForm(ctx)
+	if !hrpc.DomainAuth(ctx.Request, arg.Domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, arg.Domain_id))
+		return
+	}
+
+	secret, msg, err := this.models.Post(arg, jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, oauthClientSecret{Client_id: arg.Client_id, Client_secret: secret})
+}
+
+// swagger:operation PUT /v1/auth/oauth/client/put oauthClientController putOauthClient
+//
+// 修改应用信息
+//
+// 应用类型不能修改,status为1时禁用应用
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: client_id
+//   in: query
+//   description: client id
+//   required: true
+//   type: string
+//   format:
+// - name: client_name
+//   in: query
+//   description: client name
+//   required: true
+//   type: string
+//   format:
+// - name: redirect_uris
+//   in: query
+//   description: redirect uris, separated by space
+//   required: false
+//   type: string
+//   format:
+// - name: grant_types
+//   in: query
+//   description: authorization_code client_credentials
+//   required: true
+//   type: string
+//   format:
+// - name: scopes
+//   in: query
+//   description: openid profile email
+//   required: false
+//   type: string
+//   format:
+// - name: trusted
+//   in: query
+//   description: 1 skip consent page
+//   required: true
+//   type: string
+//   format:
+// - name: status
+//   in: query
+//   description: 0 valid, 1 disabled
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthClientController) Put(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	arg := oauthClientForm(ctx)
+	if !hrpc.DomainAuth(ctx.Request, arg.Domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, arg.Domain_id))
+		return
+	}
+
+	msg, err := this.models.Put(arg, jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/oauth/client/delete oauthClientController deleteOauthClient
+//
+// 删除应用
+//
+// 已经签发的token在过期之前仍然有效
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: client_id
+//   in: query
+//   description: client id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthClientController) Delete(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	msg, err := this.models.Delete(domain_id, ctx.Request.FormValue("client_id"))
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/oauth/client/secret oauthClientController resetOauthClientSecret
+//
+// 重置应用密钥
+//
+// 只有机密应用有密钥,旧密钥立即失效,新密钥只返回这一次
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// - name: client_id
+//   in: query
+//   description: client id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthClientController) Secret(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	client_id := ctx.Request.FormValue("client_id")
+	secret, msg, err := this.models.ResetSecret(domain_id, client_id, jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, oauthClientSecret{Client_id: client_id, Client_secret: secret})
+}
@@ -0,0 +1,406 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/groupcache"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// OpenID Connect 身份提供者接口
+// 授权页面复用登录页面,用户登录后在登录页面中确认授权.
+type oauthController struct {
+}
+
+var OauthCtl = &oauthController{}
+
+// 授权确认页面显示的应用信息
+type authorizeInfo struct {
+	Client_id   string   `json:"client_id"`
+	Client_name string   `json:"client_name"`
+	Scopes      []string `json:"scopes"`
+	Logged_in   bool     `json:"logged_in"`
+	User_id     string   `json:"user_id"`
+}
+
+type authorizeRedirect struct {
+	Redirect string `json:"redirect"`
+}
+
+// 协议接口的返回值使用application/json
+func writeOauthJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	hret.Json(w, v)
+}
+
+// 返回当前登录的用户,没有登录或者token已经被吊销时返回nil
+// 模拟登录时也返回nil,管理员不能以被模拟用户的身份授权第三方应用
+func loginClaims(r *http.Request) *jwt.JwtClaims {
+	jclaim, err := jwt.GetJwtClaims(r)
+	if err != nil || hrpc.IsRevoked(jclaim) || jclaim.Impersonator != "" {
+		return nil
+	}
+	return jclaim
+}
+
+// swagger:operation GET /.well-known/openid-configuration oauthController oidcDiscovery
+//
+// OpenID Connect 服务发现
+//
+// 返回授权接口,token接口,JWKS地址以及支持的授权方式
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: success
+func (this *oauthController) Discovery(ctx *context.Context) {
+	writeOauthJson(ctx.ResponseWriter, http.StatusOK, hrpc.GetOidcDiscovery(hrpc.OidcIssuer(ctx.Request)))
+}
+
+// swagger:operation GET /oauth2/jwks oauthController oidcJwks
+//
+// 查询校验token使用的公钥
+//
+// 只返回RS与ES系列算法的公钥
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: success
+func (this *oauthController) Jwks(ctx *context.Context) {
+	writeOauthJson(ctx.ResponseWriter, http.StatusOK, jwt.PublicKeys())
+}
+
+// swagger:operation GET /oauth2/authorize oauthController oidcAuthorize
+//
+// 授权接口
+//
+// 校验授权请求后显示登录页面,用户登录并确认授权后,携带授权码重定向到应用的回调地址.
+// 已经登录的用户访问受信任的应用时,直接重定向到回调地址.
+//
+// ---
+// produces:
+// - text/html
+// parameters:
+// - name: response_type
+//   in: query
+//   description: code
+//   required: true
+//   type: string
+//   format:
+// - name: client_id
+//   in: query
+//   description: client id
+//   required: true
+//   type: string
+//   format:
+// - name: redirect_uri
+//   in: query
+//   description: registered redirect uri
+//   required: false
+//   type: string
+//   format:
+// - name: scope
+//   in: query
+//   description: openid profile email
+//   required: false
+//   type: string
+//   format:
+// - name: state
+//   in: query
+//   description: state
+//   required: false
+//   type: string
+//   format:
+// - name: nonce
+//   in: query
+//   description: nonce
+//   required: false
+//   type: string
+//   format:
+// - name: code_challenge
+//   in: query
+//   description: PKCE code challenge
+//   required: true
+//   type: string
+//   format:
+// - name: code_challenge_method
+//   in: query
+//   description: S256
+//   required: true
+//   type: string
+//   format:
+// - name: prompt
+//   in: query
+//   description: none
+//   required: false
+//   type: string
+//   format:
+// responses:
+//   '302':
+//     description: redirect to client
+func (this *oauthController) Authorize(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	req, oerr := hrpc.ParseAuthRequest(ctx.Request.Form)
+	if oerr != nil {
+		if req == nil {
+			writeOauthJson(ctx.ResponseWriter, oerr.Status, oerr)
+			return
+		}
+		http.Redirect(ctx.ResponseWriter, ctx.Request, req.ErrorRedirect(oerr), http.StatusFound)
+		return
+	}
+
+	jclaim := loginClaims(ctx.Request)
+	if jclaim != nil && req.Client.IsTrusted() {
+		this.issueCode(ctx, req, jclaim)
+		return
+	}
+
+	// 应用要求不显示任何页面时,无法完成登录或者授权确认
+	if req.Prompt == "none" {
+		code := "consent_required"
+		if jclaim == nil {
+			code = "login_required"
+		}
+		http.Redirect(ctx.ResponseWriter, ctx.Request, req.ErrorRedirect(&hrpc.OauthError{Code: code}), http.StatusFound)
+		return
+	}
+
+	rst, err := groupcache.GetStaticFile("AsofdateIndexPage")
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
+		return
+	}
+	ctx.ResponseWriter.Write(rst)
+}
+
+func (this *oauthController) issueCode(ctx *context.Context, req *hrpc.AuthRequest, jclaim *jwt.JwtClaims) {
+	uri, err := req.IssueCode(jclaim.UserId, jclaim.DomainId, jclaim.OrgUnitId, jclaim.IssuedAt)
+	if err != nil {
+		uri = req.ErrorRedirect(&hrpc.OauthError{Code: "server_error"})
+	}
+	http.Redirect(ctx.ResponseWriter, ctx.Request, uri, http.StatusFound)
+}
+
+// swagger:operation GET /oauth2/authorize/info oauthController oidcAuthorizeInfo
+//
+// 查询授权请求中的应用信息
+//
+// 登录页面根据返回的信息显示授权确认框,参数与授权接口相同
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: success
+func (this *oauthController) AuthorizeInfo(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	req, oerr := hrpc.ParseAuthRequest(ctx.Request.Form)
+	if oerr != nil {
+		writeOauthJson(ctx.ResponseWriter, oerr.Status, oerr)
+		return
+	}
+
+	rst := authorizeInfo{
+		Client_id:   req.Client.Client_id,
+		Client_name: req.Client.Client_name,
+		Scopes:      strings.Fields(req.Scope),
+	}
+	if jclaim := loginClaims(ctx.Request); jclaim != nil {
+		rst.Logged_in = true
+		rst.User_id = jclaim.UserId
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /oauth2/authorize oauthController oidcConsent
+//
+// 确认授权
+//
+// 用户在登录页面中同意或者拒绝授权,返回需要跳转的应用回调地址,其他参数与授权接口相同
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: consent
+//   in: query
+//   description: allow or deny
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthController) Consent(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim := loginClaims(ctx.Request)
+	if jclaim == nil {
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	req, oerr := hrpc.ParseAuthRequest(ctx.Request.Form)
+	if oerr != nil {
+		if req == nil {
+			writeOauthJson(ctx.ResponseWriter, oerr.Status, oerr)
+			return
+		}
+		hret.Json(ctx.ResponseWriter, authorizeRedirect{Redirect: req.ErrorRedirect(oerr)})
+		return
+	}
+
+	if ctx.Request.FormValue("consent") != "allow" {
+		hret.Json(ctx.ResponseWriter, authorizeRedirect{Redirect: req.ErrorRedirect(&hrpc.OauthError{Code: "access_denied"})})
+		return
+	}
+
+	uri, err := req.IssueCode(jclaim.UserId, jclaim.DomainId, jclaim.OrgUnitId, jclaim.IssuedAt)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_oauth_code_issue"), err)
+		return
+	}
+	logs.Info("user ", jclaim.UserId, " authorize client ", req.Client.Client_id, ", scope is:", req.Scope)
+	hret.Json(ctx.ResponseWriter, authorizeRedirect{Redirect: uri})
+}
+
+// swagger:operation POST /oauth2/token oauthController oidcToken
+//
+// token接口
+//
+// 支持authorization_code与client_credentials两种授权方式,
+// 应用使用HTTP Basic认证,或者在表单中提交client_id与client_secret
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: grant_type
+//   in: query
+//   description: authorization_code or client_credentials
+//   required: true
+//   type: string
+//   format:
+// - name: code
+//   in: query
+//   description: authorization code
+//   required: false
+//   type: string
+//   format:
+// - name: redirect_uri
+//   in: query
+//   description: redirect uri used in authorization request
+//   required: false
+//   type: string
+//   format:
+// - name: code_verifier
+//   in: query
+//   description: PKCE code verifier
+//   required: false
+//   type: string
+//   format:
+// - name: scope
+//   in: query
+//   description: scope for client credentials
+//   required: false
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthController) Token(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	w := ctx.ResponseWriter
+
+	client, oerr := hrpc.AuthenticateClient(ctx.Request)
+	if oerr != nil {
+		if _, _, ok := ctx.Request.BasicAuth(); ok {
+			w.Header().Set("WWW-Authenticate", `Basic realm="hauth"`)
+		}
+		writeOauthJson(w, oerr.Status, oerr)
+		return
+	}
+
+	var tokens *hrpc.OidcTokens
+	issuer := hrpc.OidcIssuer(ctx.Request)
+	switch ctx.Request.FormValue("grant_type") {
+	case hrpc.GrantAuthorizationCode:
+		tokens, oerr = hrpc.ExchangeCode(client, issuer, ctx.Request.FormValue("code"),
+			ctx.Request.FormValue("redirect_uri"), ctx.Request.FormValue("code_verifier"))
+	case hrpc.GrantClientCredentials:
+		tokens, oerr = hrpc.ClientCredentials(client, issuer, ctx.Request.FormValue("scope"))
+	default:
+		oerr = &hrpc.OauthError{Status: http.StatusBadRequest, Code: "unsupported_grant_type"}
+	}
+	if oerr != nil {
+		logs.Warn("oauth token request failed, client id is:", client.Client_id, oerr)
+		writeOauthJson(w, oerr.Status, oerr)
+		return
+	}
+	writeOauthJson(w, http.StatusOK, tokens)
+}
+
+// swagger:operation POST /oauth2/introspect oauthController oauthIntrospect
+//
+// token自省
+//
+// 机密应用查询访问token或者API key是否有效,以及token所属的用户,域,机构与角色,
+// 无效的token只返回active = false
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: token
+//   in: query
+//   description: access token or api key
+//   required: true
+//   type: string
+//   format:
+// - name: token_type_hint
+//   in: query
+//   description: access_token, ignored
+//   required: false
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *oauthController) Introspect(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	w := ctx.ResponseWriter
+
+	client, oerr := hrpc.AuthenticateClient(ctx.Request)
+	if oerr == nil && client.IsPublic() {
+		oerr = &hrpc.OauthError{Status: http.StatusUnauthorized, Code: "invalid_client", Description: "public client can not introspect token."}
+	}
+	if oerr != nil {
+		if _, _, ok := ctx.Request.BasicAuth(); ok {
+			w.Header().Set("WWW-Authenticate", `Basic realm="hauth"`)
+		}
+		writeOauthJson(w, oerr.Status, oerr)
+		return
+	}
+
+	token := ctx.Request.FormValue("token")
+	if token == "" {
+		writeOauthJson(w, http.StatusBadRequest, &hrpc.OauthError{Status: http.StatusBadRequest, Code: "invalid_request", Description: "token is empty."})
+		return
+	}
+	writeOauthJson(w, http.StatusOK, hrpc.IntrospectToken(client, token))
+}
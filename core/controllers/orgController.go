@@ -46,11 +46,6 @@ var OrgCtl = &orgController{
 //     description: success
 func (orgController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("AsofdateOrgPage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
@@ -83,15 +78,9 @@ func (orgController) Page(ctx *context.Context) {
 //     description: success
 func (this orgController) Get(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	domain_id := ctx.Request.FormValue("domain_id")
 	if validator.IsEmpty(domain_id) {
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -105,13 +94,18 @@ func (this orgController) Get(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+
 	rst, err := this.models.Get(domain_id)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 417, i18n.Get(ctx.Request, "error_query_org_info"))
 		return
 	}
-	hret.Json(ctx.ResponseWriter, rst)
+	hret.Json(ctx.ResponseWriter, filterOrgs(scope, rst))
 }
 
 // swagger:operation POST /v1/auth/resource/org/delete orgController orgController
@@ -144,11 +138,6 @@ func (this orgController) Get(ctx *context.Context) {
 //     description: success
 func (this orgController) Delete(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	domain_id := ctx.Request.FormValue("domain_id")
 
 	var mjs []models.SysOrgInfo
@@ -160,8 +149,7 @@ func (this orgController) Delete(ctx *context.Context) {
 	}
 
 	if validator.IsEmpty(domain_id) {
-		cok, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cok.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
 			return
@@ -174,6 +162,19 @@ func (this orgController) Delete(ctx *context.Context) {
 		return
 	}
 
+	// 删除机构时同时删除下级机构,下级机构也必须在机构范围内
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+	for _, val := range mjs {
+		if !scope.Covers(val.Org_unit_id, true) {
+			logs.Error("org is outside of the org scope, org id is:", val.Org_unit_id)
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_org_scope_org"))
+			return
+		}
+	}
+
 	msg, err := this.models.Delete(mjs, domain_id)
 	if err != nil {
 		logs.Error(err)
@@ -208,16 +209,10 @@ func (this orgController) Delete(ctx *context.Context) {
 //     description: success
 func (this orgController) Update(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	form := ctx.Request.Form
 	org_unit_id := form.Get("Id")
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -236,6 +231,11 @@ func (this orgController) Update(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok || !orgInScope(ctx, scope, org_unit_id, form.Get("Up_org_id")) {
+		return
+	}
+
 	msg, err := this.models.Update(form, jclaim.UserId)
 	if err != nil {
 		logs.Error(err)
@@ -287,14 +287,9 @@ func (this orgController) Update(ctx *context.Context) {
 //     description: success
 func (this orgController) Post(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -307,6 +302,12 @@ func (this orgController) Post(ctx *context.Context) {
 		return
 	}
 
+	// 新增的机构挂在上级机构下,上级机构必须在机构范围内
+	scope, ok := orgScope(ctx)
+	if !ok || !orgInScope(ctx, scope, form.Get("Up_org_id")) {
+		return
+	}
+
 	msg, err := this.models.Post(form, jclaim.UserId)
 	if err != nil {
 		logs.Error(err)
@@ -355,6 +356,11 @@ func (this orgController) GetSubOrgInfo(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+
 	rst, err := this.models.GetSubOrgInfo(did, org_unit_id)
 	if err != nil {
 		logs.Error(err)
@@ -362,7 +368,7 @@ func (this orgController) GetSubOrgInfo(ctx *context.Context) {
 		return
 	}
 
-	hret.Json(ctx.ResponseWriter, rst)
+	hret.Json(ctx.ResponseWriter, filterOrgs(scope, rst))
 }
 
 // swagger:operation GET /v1/auth/resource/org/download orgController orgController
@@ -389,17 +395,11 @@ func (this orgController) GetSubOrgInfo(ctx *context.Context) {
 //     description: success
 func (this orgController) Download(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	ctx.ResponseWriter.Header().Set("Content-Type", "application/vnd.ms-excel")
 	domain_id := ctx.Request.FormValue("domain_id")
 
 	if validator.IsEmpty(domain_id) {
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -413,12 +413,18 @@ func (this orgController) Download(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+
 	rst, err := this.models.Get(domain_id)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 417, i18n.Get(ctx.Request, "error_query_org_info"))
 		return
 	}
+	rst = filterOrgs(scope, rst)
 
 	var sheet *xlsx.Sheet
 	file, err := xlsx.OpenFile(filepath.Join(os.Getenv("HBIGDATA_HOME"), "views", "uploadTemplate", "hauthOrgExportTemplate.xlsx"))
@@ -521,8 +527,7 @@ func (this orgController) Upload(ctx *context.Context) {
 	}
 
 	// 从cookies中获取用户连接信息
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -586,6 +591,21 @@ func (this orgController) Upload(ctx *context.Context) {
 		index++
 	}
 
+	// 导入的机构必须挂在机构范围内的机构下,或者挂在同时导入的机构下
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+	added := make(map[string]bool)
+	for _, val := range data {
+		added[val.Org_unit_id] = true
+	}
+	for _, val := range data {
+		if !added[val.Up_org_id] && !orgInScope(ctx, scope, val.Up_org_id) {
+			return
+		}
+	}
+
 	msg, err := this.models.Upload(data)
 	if err != nil {
 		logs.Error(err)
@@ -595,6 +615,71 @@ func (this orgController) Upload(ctx *context.Context) {
 	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
 }
 
+// 查询当前用户的机构范围
+func orgScope(ctx *context.Context) (*hrpc.OrgScope, bool) {
+	scope, err := hrpc.GetOrgScope(ctx.Request)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_org_scope_query"), err)
+		return nil, false
+	}
+	return scope, true
+}
+
+// 校验机构是否在当前用户的机构范围内
+func orgInScope(ctx *context.Context, scope *hrpc.OrgScope, org_id ...string) bool {
+	for _, val := range org_id {
+		if !scope.Contains(val) {
+			logs.Error("org is outside of the org scope, org id is:", val)
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_org_scope_org"))
+			return false
+		}
+	}
+	return true
+}
+
+// 校验用户所属的机构是否在当前用户的机构范围内
+func userInScope(ctx *context.Context, user_id ...string) bool {
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return false
+	}
+	for _, val := range user_id {
+		if !scope.ContainsUser(val) {
+			logs.Error("user is outside of the org scope, user id is:", val)
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_org_scope_user"))
+			return false
+		}
+	}
+	return true
+}
+
+// 校验当前用户的机构范围不限制机构,角色在整个域中共用,只有不限制机构的用户才能维护
+func unlimitedScope(ctx *context.Context) bool {
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return false
+	}
+	if !scope.All {
+		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_org_scope_role"))
+		return false
+	}
+	return true
+}
+
+// 只保留机构范围内的机构
+func filterOrgs(scope *hrpc.OrgScope, list []models.SysOrgInfo) []models.SysOrgInfo {
+	if scope.All {
+		return list
+	}
+	var rst []models.SysOrgInfo
+	for _, val := range list {
+		if scope.Contains(val.Org_unit_id) {
+			rst = append(rst, val)
+		}
+	}
+	return rst
+}
+
 func init() {
 	groupcache.RegisterStaticFile("AsofdateOrgPage", "./views/hauth/org_page.tpl")
 }
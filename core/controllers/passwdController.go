@@ -1,12 +1,11 @@
 package controllers
 
 import (
-	"net/http"
 	"strings"
 
 	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
-	"github.com/hzwy23/hauth/utils/crypto/haes"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 	"github.com/hzwy23/hauth/utils/jwt"
@@ -78,37 +77,205 @@ func (this passwdController) PostModifyPasswd(ctx *context.Context) {
 		return
 	}
 
-	if len(strings.TrimSpace(newPasswd)) < 6 || len(strings.TrimSpace(newPasswd)) > 30 {
-		logs.Error("新密码长度不能小于6位,且不能大于30位")
-		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_passwd_short"))
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
 		return
 	}
 
-	oriEn, err := haes.Encrypt(oriPasswd)
+	err_msg, err := this.p.UpdateMyPasswd(newPasswd, jclaim.UserId, oriPasswd)
 	if err != nil {
-		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_password_encrpty"))
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, err_msg), err)
+		return
+	}
+	hrpc.RevokeUserTokens(jclaim.UserId, jclaim.UserId)
+	setTokenCookie(ctx.ResponseWriter, nil)
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /passwd/expired passwdController passwdController
+//
+// 修改已经过期的密码
+//
+// 密码过期,或者管理员重置密码后,用户登录时会返回407,
+// 用户需要通过这个API修改密码后,才能登录系统.这个API不需要登录.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: username
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// - name: orapasswd
+//   in: query
+//   description: old password
+//   required: true
+//   type: string
+//   format:
+// - name: newpasswd
+//   in: query
+//   description: new password
+//   required: true
+//   type: string
+//   format:
+// - name: surepasswd
+//   in: query
+//   description: confirm new password
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this passwdController) PostExpiredPasswd(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	userId := ctx.Request.FormValue("username")
+	if loginThrottled(ctx, userId) {
+		return
+	}
+	oriPasswd := ctx.Request.FormValue("orapasswd")
+	newPasswd := ctx.Request.FormValue("newpasswd")
+	surePasswd := ctx.Request.FormValue("surepasswd")
+
+	if oriPasswd == newPasswd {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_passwd_same"))
 		return
 	}
 
-	newPd, err := haes.Encrypt(newPasswd)
+	if newPasswd != surePasswd {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_passwd_confirm_failed"))
+		return
+	}
+
+	if len(strings.TrimSpace(newPasswd)) != len(newPasswd) {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_passwd_blank"))
+		return
+	}
+
+	err_msg, err := this.p.UpdateMyPasswd(newPasswd, userId, oriPasswd)
 	if err != nil {
-		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_password_encrpty"))
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, err_msg), err)
 		return
 	}
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	hrpc.RevokeUserTokens(userId, userId)
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /passwd/forgot passwdController passwdController
+//
+// 申请找回密码
+//
+// 向用户配置的邮箱发送重置密码链接,链接只能使用一次.
+// 无论账号是否存在,都返回成功.这个API不需要登录.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: username
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this passwdController) PostForgotPasswd(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	userId := ctx.Request.FormValue("username")
+	if loginThrottled(ctx, userId) {
+		return
+	}
+	if strings.TrimSpace(userId) == "" {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_id_empty"))
+		return
+	}
+
+	err_msg, err := hrpc.RequestPasswdReset(ctx.Request, userId)
 	if err != nil {
 		logs.Error(err)
-		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, err_msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Get(ctx.Request, "passwd_reset_sent"))
+}
+
+// swagger:operation POST /passwd/reset passwdController passwdController
+//
+// 使用邮件中的重置token设置新密码
+//
+// 新密码需要满足密码策略的要求,设置成功后,用户所有的重置token与登录会话失效.
+// 这个API不需要登录.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: reset_token
+//   in: query
+//   description: password reset token in the mail
+//   required: true
+//   type: string
+//   format:
+// - name: newpasswd
+//   in: query
+//   description: new password
+//   required: true
+//   type: string
+//   format:
+// - name: surepasswd
+//   in: query
+//   description: confirm new password
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this passwdController) PostResetPasswd(ctx *context.Context) {
+	ctx.Request.ParseForm()
+
+	if loginThrottled(ctx, "") {
+		return
+	}
+	token := ctx.Request.FormValue("reset_token")
+	newPasswd := ctx.Request.FormValue("newpasswd")
+	surePasswd := ctx.Request.FormValue("surepasswd")
+
+	if newPasswd != surePasswd {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_passwd_confirm_failed"))
+		return
+	}
+
+	if len(strings.TrimSpace(newPasswd)) != len(newPasswd) {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_passwd_blank"))
 		return
 	}
 
-	err_msg, err := this.p.UpdateMyPasswd(newPd, jclaim.UserId, oriEn)
+	err_msg, err := hrpc.ResetPasswd(token, newPasswd)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, err_msg), err)
 		return
 	}
-	http.SetCookie(ctx.ResponseWriter, &http.Cookie{Name: "Authorization", Value: "", Path: "/", MaxAge: -1})
 	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
 }
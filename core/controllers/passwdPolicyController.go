@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type passwdPolicyController struct {
+	models *models.PasswdPolicyModel
+}
+
+var PasswdPolicyCtl = &passwdPolicyController{
+	models: &models.PasswdPolicyModel{},
+}
+
+// swagger:operation GET /v1/auth/passwd/policy/get passwdPolicyController getPasswdPolicy
+//
+// 查询域的密码策略
+//
+// 域没有配置密码策略时,返回app.conf中配置的全局密码策略
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *passwdPolicyController) Get(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if domain_id == "" {
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
+		if err != nil {
+			logs.Error(err)
+			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+			return
+		}
+		domain_id = jclaim.DomainId
+	}
+
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "r") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, domain_id))
+		return
+	}
+
+	hret.Json(ctx.ResponseWriter, this.models.Get(domain_id))
+}
+
+// swagger:operation PUT /v1/auth/passwd/policy/put passwdPolicyController putPasswdPolicy
+//
+// 设置域的密码策略
+//
+// 配置后,域中用户修改密码时,按照域的密码策略进行校验
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *passwdPolicyController) Put(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	msg, err := this.models.Put(ctx.Request.Form, jclaim.UserId)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/passwd/policy/delete passwdPolicyController deletePasswdPolicy
+//
+// 删除域的密码策略
+//
+// 删除后,域中的用户使用app.conf中配置的全局密码策略
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *passwdPolicyController) Delete(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	msg, err := this.models.Delete(domain_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/hret"
+)
+
+type permCacheController struct {
+}
+
+var PermCacheCtl = &permCacheController{}
+
+// swagger:operation GET /v1/auth/permcache/stats permCacheController getPermCacheStats
+//
+// 查询权限缓存的统计信息
+//
+// 返回缓存的用户数量,命中次数,未命中次数,命中率与清除次数,用于确认权限缓存是否生效
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this *permCacheController) Stats(ctx *context.Context) {
+	hret.Json(ctx.ResponseWriter, hrpc.GetPermCacheStats())
+}
@@ -3,7 +3,6 @@ package controllers
 import (
 	"github.com/astaxie/beego/context"
 	"github.com/hzwy23/hauth/core/groupcache"
-	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
@@ -35,11 +34,6 @@ var ResourceCtl = &resourceController{
 //     description: all domain information
 func (resourceController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("AsofdateResourcePage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
@@ -73,11 +67,6 @@ func (resourceController) Page(ctx *context.Context) {
 //     description: success
 func (this resourceController) Get(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := this.models.Get()
 	if err != nil {
 		logs.Error(err)
@@ -137,11 +126,6 @@ func (this resourceController) Query(ctx *context.Context) {
 //     description: success
 func (this resourceController) Post(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	form := ctx.Request.Form
 
 	msg, err := this.models.Post(form)
@@ -177,11 +161,6 @@ func (this resourceController) Post(ctx *context.Context) {
 //     description: success
 func (this resourceController) Delete(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	res_id := ctx.Request.FormValue("res_id")
 
 	msg, err := this.models.Delete(res_id)
@@ -225,11 +204,6 @@ func (this resourceController) Delete(ctx *context.Context) {
 //     description: success
 func (this resourceController) Update(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	res_id := ctx.Request.FormValue("res_id")
 	res_name := ctx.Request.FormValue("res_name")
 
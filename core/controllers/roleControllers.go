@@ -47,11 +47,6 @@ var RoleCtl = &roleController{
 //     description: success
 func (roleController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("AsofdateRolePage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
@@ -84,16 +79,10 @@ func (roleController) Page(ctx *context.Context) {
 //     description: success
 func (this roleController) Get(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	domain_id := ctx.Request.FormValue("domain_id")
 
 	if validator.IsEmpty(domain_id) {
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -122,7 +111,7 @@ func (this roleController) Get(ctx *context.Context) {
 //
 // 新增角色信息
 //
-// 在某个指定的域中,新增角色信息
+// 在某个指定的域中,新增角色信息,可以指定上级角色parent_role_id,角色继承上级角色的资源
 //
 // ---
 // produces:
@@ -142,11 +131,6 @@ func (this roleController) Get(ctx *context.Context) {
 //     description: success
 func (this roleController) Post(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	form := ctx.Request.Form
 	domainid := form.Get("domain_id")
 	if !hrpc.DomainAuth(ctx.Request, domainid, "w") {
@@ -155,8 +139,11 @@ func (this roleController) Post(ctx *context.Context) {
 		return
 	}
 
-	cok, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cok.Value)
+	if !unlimitedScope(ctx) {
+		return
+	}
+
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
 		return
@@ -195,11 +182,6 @@ func (this roleController) Post(ctx *context.Context) {
 //     description: success
 func (this roleController) Delete(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	var allrole []models.RoleInfo
 	err := json.Unmarshal([]byte(ctx.Request.FormValue("JSON")), &allrole)
 	if err != nil {
@@ -213,6 +195,19 @@ func (this roleController) Delete(ctx *context.Context) {
 			hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, val.Domain_id))
 			return
 		}
+		if hrpc.IsSuperAdminRole(val.Role_id) {
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_role_forbid_delete_super_admin"))
+			return
+		}
+		// 有下级角色时不能删除,否则下级角色会失去继承的资源
+		if has, err := this.models.HasChildren(val.Role_id); err != nil || has {
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_role_forbid_delete_parent"))
+			return
+		}
+	}
+
+	if !unlimitedScope(ctx) {
+		return
 	}
 
 	msg, err := this.models.Delete(allrole)
@@ -228,7 +223,7 @@ func (this roleController) Delete(ctx *context.Context) {
 //
 // 更新角色信息
 //
-// 更新某个域中的角色信息,角色编码不能更新
+// 更新某个域中的角色信息,角色编码不能更新,修改上级角色Parent_role_id时不能形成环
 //
 // ---
 // produces:
@@ -248,11 +243,6 @@ func (this roleController) Delete(ctx *context.Context) {
 //     description: success
 func (this roleController) Update(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	form := ctx.Request.Form
 	Role_id := form.Get("Role_id")
 
@@ -267,8 +257,11 @@ func (this roleController) Update(ctx *context.Context) {
 		return
 	}
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	if !unlimitedScope(ctx) {
+		return
+	}
+
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -4,7 +4,6 @@ import (
 	"html/template"
 
 	"github.com/astaxie/beego/context"
-	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
@@ -47,11 +46,6 @@ var RoleAndResourceCtl = &roleAndResourceController{
 //     description: success
 func (this roleAndResourceController) ResourcePage(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	var role_id = ctx.Request.FormValue("role_id")
 
 	rst, err := this.model.GetRow(role_id)
@@ -90,17 +84,12 @@ func (this roleAndResourceController) ResourcePage(ctx *context.Context) {
 //     description: success
 func (this roleAndResourceController) GetResource(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	role_id := ctx.Request.FormValue("role_id")
 	type_id := ctx.Request.FormValue("type_id")
 
 	if type_id == "0" {
-		// 查询角色已经获取到的资源信息
-		rst, err := this.resRoleModel.Get(role_id)
+		// 查询角色已经获取到的资源信息,包括从上级角色继承的资源
+		rst, err := this.resRoleModel.GetAll(role_id)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_role_get_resource"))
@@ -145,11 +134,6 @@ func (this roleAndResourceController) GetResource(ctx *context.Context) {
 //     description: success
 func (this roleAndResourceController) HandleResource(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	res_id := ctx.Request.FormValue("res_id")
 	role_id := ctx.Request.FormValue("role_id")
 	type_id := ctx.Request.FormValue("type_id")
@@ -164,9 +148,32 @@ func (this roleAndResourceController) HandleResource(ctx *context.Context) {
 		return
 	}
 
+	if !unlimitedScope(ctx) {
+		return
+	}
+
 	// 撤销权限操作
 	if type_id == "0" {
-		err := this.resRoleModel.Delete(role_id, res_id)
+		// 继承的资源只能在上级角色中撤销
+		direct, err := this.resRoleModel.Get(role_id)
+		if err != nil {
+			logs.Error(err)
+			hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_role_get_resource"))
+			return
+		}
+		found := false
+		for _, val := range direct {
+			if val.Res_id == res_id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_role_resource_inherited"))
+			return
+		}
+
+		err = this.resRoleModel.Delete(role_id, res_id)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_role_delete_failed"))
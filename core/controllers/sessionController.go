@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type sessionController struct {
+	models *models.SessionModel
+}
+
+var SessionCtl = &sessionController{
+	models: &models.SessionModel{},
+}
+
+// swagger:operation GET /v1/auth/session/get sessionController getSession
+//
+// 查询当前用户的登录会话
+//
+// current为true的是当前连接使用的会话
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this *sessionController) Get(ctx *context.Context) {
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	rst, err := this.models.Get(jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_session_query"), err)
+		return
+	}
+	for i := range rst {
+		rst[i].Current = rst[i].Session_id == jclaim.SessionId
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/session/delete sessionController deleteSession
+//
+// 结束当前用户的登录会话
+//
+// 会话中签发的访问token与刷新token立即失效
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: session_id
+//   in: query
+//   description: session id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *sessionController) Delete(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	session_id := ctx.Request.FormValue("session_id")
+	user_id, _, err := hrpc.GetSessionOwner(session_id)
+	if err == nil && user_id != jclaim.UserId {
+		err = errors.New("session is not owned by user " + jclaim.UserId)
+	}
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_session_not_found"), err)
+		return
+	}
+
+	if err = hrpc.EndSession(session_id, jclaim.UserId); err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_session_delete"), err)
+		return
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation GET /v1/auth/session/domain/get sessionController getDomainSession
+//
+// 查询域中所有用户的登录会话
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: domain_id
+//   in: query
+//   description: domain code number
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *sessionController) GetDomain(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	domain_id := ctx.Request.FormValue("domain_id")
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "r") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, domain_id))
+		return
+	}
+
+	rst, err := this.models.GetDomain(domain_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_session_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation POST /v1/auth/session/domain/delete sessionController deleteDomainSession
+//
+// 结束域中用户的登录会话
+//
+// 管理员强制用户的一个会话退出,用户的其他会话不受影响
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: session_id
+//   in: query
+//   description: session id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this *sessionController) DeleteDomain(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	session_id := ctx.Request.FormValue("session_id")
+	user_id, domain_id, err := hrpc.GetSessionOwner(session_id)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_session_not_found"), err)
+		return
+	}
+	if !hrpc.DomainAuth(ctx.Request, domain_id, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+		return
+	}
+
+	if err = hrpc.EndSession(session_id, jclaim.UserId); err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_session_delete"), err)
+		return
+	}
+	logs.Info("user ", jclaim.UserId, " end session of user ", user_id, ", session id is:", session_id)
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
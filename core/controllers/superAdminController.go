@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/models"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+)
+
+type superAdminController struct {
+	models *models.SuperAdminModel
+}
+
+var SuperAdminCtl = &superAdminController{
+	models: &models.SuperAdminModel{},
+}
+
+// swagger:operation GET /v1/auth/superadmin/get superAdminController getSuperAdmin
+//
+// 查询拥有超级管理员角色的用户
+//
+// 超级管理员角色通过用户授权页面授予与移除,只有超级管理员可以操作
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this *superAdminController) Get(ctx *context.Context) {
+	rst, err := this.models.Get()
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_super_admin_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
+
+// swagger:operation GET /v1/auth/superadmin/audit superAdminController getSuperAdminAudit
+//
+// 查询超级管理员角色的授予与移除记录
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this *superAdminController) Audit(ctx *context.Context) {
+	rst, err := this.models.Audit()
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_super_admin_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, rst)
+}
@@ -3,7 +3,6 @@ package controllers
 import (
 	"github.com/astaxie/beego/context"
 	"github.com/hzwy23/hauth/core/groupcache"
-	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 )
@@ -29,11 +28,6 @@ var SwaggerCtl = &swaggerController{}
 //   '200':
 //     description: success
 func (this swaggerController) Page(ctx *context.Context) {
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("SwaggerPage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.Get(ctx.Request, "as_of_date_page_not_exist"))
@@ -2,12 +2,12 @@ package controllers
 
 import (
 	"github.com/astaxie/beego/context"
-	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 	"github.com/hzwy23/hauth/utils/jwt"
 	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/routematch"
 	"github.com/hzwy23/hauth/utils/validator"
 )
 
@@ -48,8 +48,7 @@ func (this *themeController) Post(ctx *context.Context) {
 	theme_id := ctx.Request.FormValue("theme_id")
 
 	// get user connection info from cookes.
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -84,11 +83,6 @@ func (this *themeController) Post(ctx *context.Context) {
 //     description: success
 func (this themeController) Put(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	res_id := ctx.Request.FormValue("res_id")
 	theme_id := ctx.Request.FormValue("theme_id")
 	res_url := ctx.Request.FormValue("res_url")
@@ -98,6 +92,7 @@ func (this themeController) Put(ctx *context.Context) {
 	res_group_id := ctx.Request.FormValue("res_group_id")
 	res_sort_id := ctx.Request.FormValue("res_sort_id")
 	res_open_type := ctx.Request.FormValue("res_openType")
+	res_method := ctx.Request.FormValue("res_method")
 
 	if validator.IsNull(res_sort_id) {
 		res_sort_id = "0"
@@ -105,9 +100,17 @@ func (this themeController) Put(ctx *context.Context) {
 
 	flag, res_type := this.mres.CheckThemeExists(theme_id, res_id)
 	if validator.IsIn(res_type, "0", "1", "2") {
+		// API的路由地址可以包含占位符与通配符
+		if res_type == "2" {
+			if _, err := routematch.Compile(res_method, res_url); err != nil {
+				logs.Error(err)
+				hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_resource_route_pattern"), err)
+				return
+			}
+		}
 		if flag == 0 {
 			// 没有这个主题的配置信息,新增主题信息
-			msg, err := this.mres.Post(theme_id, res_id, res_url, res_class, res_img, res_by_color, res_group_id, res_sort_id, res_open_type)
+			msg, err := this.mres.Post(theme_id, res_id, res_url, res_class, res_img, res_by_color, res_group_id, res_sort_id, res_open_type, res_method)
 			if err != nil {
 				hret.Error(ctx.ResponseWriter, 421, msg, err)
 				return
@@ -116,7 +119,7 @@ func (this themeController) Put(ctx *context.Context) {
 			return
 		} else if flag > 0 {
 			// 更新主题信息
-			err := this.mres.Update(res_url, res_by_color, res_class, res_img, res_group_id, res_sort_id, theme_id, res_id, res_open_type)
+			err := this.mres.Update(res_url, res_by_color, res_class, res_img, res_group_id, res_sort_id, theme_id, res_id, res_open_type, res_method)
 			if err != nil {
 				logs.Error(err)
 				hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_theme_update"), err)
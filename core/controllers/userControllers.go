@@ -41,11 +41,6 @@ var UserCtl = &userController{
 //     description: success
 func (userController) Page(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	rst, err := groupcache.GetStaticFile("AsofdasteUserPage")
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 404, i18n.PageNotFound(ctx.Request))
@@ -80,19 +75,13 @@ func (userController) Page(ctx *context.Context) {
 //     description: success
 func (this userController) Get(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	domain_id := ctx.Request.FormValue("domain_id")
 
 	// if the domain_id argument is empty
 	// so query default domain info
 	if validator.IsEmpty(domain_id) {
 		// get user connection info from cookes.
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -106,6 +95,11 @@ func (this userController) Get(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+
 	// query domain info.
 	rst, err := this.models.GetDefault(domain_id)
 	if err != nil {
@@ -113,7 +107,7 @@ func (this userController) Get(ctx *context.Context) {
 		hret.Error(ctx.ResponseWriter, 410, i18n.Get(ctx.Request, "error_user_query"), err)
 		return
 	}
-	hret.Json(ctx.ResponseWriter, rst)
+	hret.Json(ctx.ResponseWriter, filterUsers(scope, rst))
 }
 
 // swagger:operation POST /v1/auth/user/post userController userController
@@ -140,15 +134,10 @@ func (this userController) Get(ctx *context.Context) {
 //     description: success
 func (this userController) Post(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 	domain_id := form.Get("domainId")
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -160,6 +149,11 @@ func (this userController) Post(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok || !orgInScope(ctx, scope, form.Get("userOrgUnitId")) {
+		return
+	}
+
 	msg, err := this.models.Post(form, jclaim.UserId)
 	if err != nil {
 		logs.Error(err)
@@ -186,11 +180,6 @@ func (this userController) Post(ctx *context.Context) {
 //     description: success
 func (this userController) Delete(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	var rst []models.UserInfo
 	err := json.Unmarshal([]byte(ctx.Request.FormValue("JSON")), &rst)
 	if err != nil {
@@ -199,8 +188,7 @@ func (this userController) Delete(ctx *context.Context) {
 		return
 	}
 
-	cok, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cok.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
 		return
@@ -218,7 +206,7 @@ func (this userController) Delete(ctx *context.Context) {
 			hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, val.Domain_id))
 			return
 		}
-		if val.User_id == "admin" {
+		if hrpc.IsSuperAdmin(val.User_id) {
 			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_user_forbid_delete_admin"))
 			return
 		}
@@ -228,6 +216,14 @@ func (this userController) Delete(ctx *context.Context) {
 		}
 	}
 
+	var users []string
+	for _, val := range rst {
+		users = append(users, val.User_id)
+	}
+	if !userInScope(ctx, users...) {
+		return
+	}
+
 	msg, err := this.models.Delete(rst)
 	if err != nil {
 		logs.Error(err)
@@ -281,8 +277,7 @@ func (this userController) Search(ctx *context.Context) {
 	var status_id = ctx.Request.FormValue("status_id")
 	var domain_id = ctx.Request.FormValue("domain_id")
 	if strings.TrimSpace(domain_id) == "" {
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			logs.Error(err)
 			hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -290,6 +285,11 @@ func (this userController) Search(ctx *context.Context) {
 		}
 		domain_id = jclaim.DomainId
 	}
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+
 	logs.Debug(org_id, status_id)
 	rst, err := this.models.Search(org_id, status_id, domain_id)
 	if err != nil {
@@ -297,7 +297,7 @@ func (this userController) Search(ctx *context.Context) {
 		hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_user_query"), err)
 		return
 	}
-	hret.Json(ctx.ResponseWriter, rst)
+	hret.Json(ctx.ResponseWriter, filterUsers(scope, rst))
 }
 
 // swagger:operation PUT /v1/auth/user/put userController userController
@@ -324,10 +324,6 @@ func (this userController) Search(ctx *context.Context) {
 //     description: success
 func (this userController) Put(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 
 	domain_id, err := hrpc.GetDomainId(form.Get("userId"))
@@ -343,8 +339,13 @@ func (this userController) Put(ctx *context.Context) {
 		return
 	}
 
-	cok, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cok.Value)
+	// 用户当前的机构与修改后的机构都必须在机构范围内
+	scope, ok := orgScope(ctx)
+	if !ok || !userInScope(ctx, form.Get("userId")) || !orgInScope(ctx, scope, form.Get("orgId")) {
+		return
+	}
+
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -383,10 +384,6 @@ func (this userController) Put(ctx *context.Context) {
 //     description: success
 func (this userController) ModifyPasswd(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	form := ctx.Request.Form
 
 	user_id := ctx.Request.FormValue("userid")
@@ -403,12 +400,31 @@ func (this userController) ModifyPasswd(ctx *context.Context) {
 		return
 	}
 
+	if !userInScope(ctx, user_id) {
+		return
+	}
+
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
 	msg, err := this.models.ModifyPasswd(form)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
 		return
 	}
+
+	// 密码被重置后,用户已经登录的连接全部失效
+	err = hrpc.RevokeUserTokens(user_id, jclaim.UserId)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_revoke_token"), err)
+		return
+	}
 	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
 
 }
@@ -437,11 +453,6 @@ func (this userController) ModifyPasswd(ctx *context.Context) {
 //     description: success
 func (this userController) ModifyStatus(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	user_id := ctx.Request.FormValue("userId")
 	status_id := ctx.Request.FormValue("userStatus")
 
@@ -452,8 +463,7 @@ func (this userController) ModifyStatus(ctx *context.Context) {
 		return
 	}
 
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
@@ -471,12 +481,84 @@ func (this userController) ModifyStatus(ctx *context.Context) {
 		return
 	}
 
+	if !userInScope(ctx, user_id) {
+		return
+	}
+
 	msg, err := this.models.ModifyStatus(status_id, user_id)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
 		return
 	}
+
+	// 用户被禁用后,用户已经登录的连接全部失效
+	if status_id == "1" {
+		err = hrpc.RevokeUserTokens(user_id, jclaim.UserId)
+		if err != nil {
+			logs.Error(err)
+			hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_revoke_token"), err)
+			return
+		}
+	}
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/user/revoke/token userController userController
+//
+// 强制用户退出系统
+//
+// 吊销用户已经签发的所有token,用户需要重新登录系统
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this userController) RevokeToken(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	user_id := ctx.Request.FormValue("userId")
+
+	did, err := hrpc.GetDomainId(user_id)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_get_domain"), err)
+		return
+	}
+
+	if !hrpc.DomainAuth(ctx.Request, did, "w") {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, did))
+		return
+	}
+
+	if !userInScope(ctx, user_id) {
+		return
+	}
+
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	err = hrpc.RevokeUserTokens(user_id, jclaim.UserId)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_revoke_token"), err)
+		return
+	}
 	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
 }
 
@@ -504,8 +586,7 @@ func (this userController) ModifyStatus(ctx *context.Context) {
 //     description: success
 func (this userController) GetUserDetails(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 401, i18n.Disconnect(ctx.Request))
@@ -520,6 +601,160 @@ func (this userController) GetUserDetails(ctx *context.Context) {
 	hret.Json(ctx.ResponseWriter, rst)
 }
 
+// 当前用户的信息,包括用户当前拥有的角色
+type userProfile struct {
+	models.UserInfo
+	Roles         []string `json:"roles"`
+	Grant_version string   `json:"gver"`
+	Impersonator  string   `json:"impersonator,omitempty"`
+}
+
+// swagger:operation GET /v1/auth/userinfo userController userInfo
+//
+// 查询当前用户的信息
+//
+// 其他服务使用用户的访问token,查询用户的基本信息,所在的域,机构与角色
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this userController) UserInfo(ctx *context.Context) {
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 401, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	rst, err := this.models.GetOwnerDetails(jclaim.UserId)
+	if err != nil || len(rst) == 0 {
+		logs.Error("query user details failed, user id is:", jclaim.UserId, err)
+		hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_user_query"))
+		return
+	}
+	grants, err := hrpc.LoadGrants(jclaim.UserId)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_user_query"), err)
+		return
+	}
+	hret.Json(ctx.ResponseWriter, userProfile{
+		UserInfo:      rst[0],
+		Roles:         grants.Roles,
+		Grant_version: grants.Version,
+		Impersonator:  jclaim.Impersonator,
+	})
+}
+
+// 只保留机构范围内的用户
+func filterUsers(scope *hrpc.OrgScope, list []models.UserInfo) []models.UserInfo {
+	if scope.All {
+		return list
+	}
+	var rst []models.UserInfo
+	for _, val := range list {
+		if scope.Contains(val.Org_unit_id) {
+			rst = append(rst, val)
+		}
+	}
+	return rst
+}
+
+// swagger:operation POST /v1/auth/user/impersonate userController impersonateUser
+//
+// 模拟用户登录
+//
+// 管理员使用用户的身份访问系统,用于排查用户的权限问题.
+// 模拟登录的访问token有效期固定,不能刷新,期间的操作日志同时记录管理员与被模拟的用户.
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// parameters:
+// - name: userId
+//   in: query
+//   description: user id
+//   required: true
+//   type: string
+//   format:
+// responses:
+//   '200':
+//     description: success
+func (this userController) Impersonate(ctx *context.Context) {
+	ctx.Request.ParseForm()
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+
+	user_id := ctx.Request.FormValue("userId")
+	did, ok := userDomain(ctx, user_id, "w")
+	if !ok {
+		return
+	}
+
+	orgid, err := indexModels.GetDefaultOrgId(user_id)
+	if err != nil {
+		logs.Error(user_id, " 用户没有指定机构", err)
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_user_no_org"), err)
+		return
+	}
+
+	pair, msg, err := hrpc.Impersonate(ctx.Request, jclaim, user_id, did, orgid)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, msg), err)
+		return
+	}
+	// 管理员的刷新token保留在cookie中,结束模拟登录后用来恢复管理员的登录状态
+	setAccessCookie(ctx.ResponseWriter, pair)
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
+// swagger:operation POST /v1/auth/user/impersonate/end userController endImpersonate
+//
+// 结束模拟登录
+//
+// 吊销模拟登录的访问token,页面再使用管理员的刷新token恢复管理员的登录状态
+//
+// ---
+// produces:
+// - application/json
+// - application/xml
+// - text/xml
+// - text/html
+// responses:
+//   '200':
+//     description: success
+func (this userController) EndImpersonate(ctx *context.Context) {
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
+		return
+	}
+	if jclaim.Impersonator == "" {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_impersonate_not_started"))
+		return
+	}
+
+	if err = hrpc.EndImpersonate(ctx.Request, jclaim); err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_impersonate"), err)
+		return
+	}
+	setAccessCookie(ctx.ResponseWriter, nil)
+	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
+}
+
 func init() {
 	groupcache.RegisterStaticFile("AsofdasteUserPage", "./views/hauth/UserInfoPage.tpl")
 }
@@ -7,10 +7,12 @@ import (
 	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/core/models"
 	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/utils"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 	"github.com/hzwy23/hauth/utils/jwt"
 	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/validator"
 )
 
 type userRolesController struct {
@@ -43,11 +45,6 @@ var UserRolesCtl = &userRolesController{
 //   '404':
 //     description: page not found.
 func (this *userRolesController) Page(ctx *context.Context) {
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	// According to the key get the value from the groupCache system
 	rst, err := groupcache.GetStaticFile("AuthorityPage")
 	if err != nil {
@@ -83,6 +80,9 @@ func (this *userRolesController) Page(ctx *context.Context) {
 func (this userRolesController) GetRolesByUserId(ctx *context.Context) {
 	ctx.Request.ParseForm()
 	user_id := ctx.Request.FormValue("user_id")
+	if !userInScope(ctx, user_id) {
+		return
+	}
 
 	rst, err := this.models.GetRolesByUser(user_id)
 	if err != nil {
@@ -123,6 +123,9 @@ func (this userRolesController) GetOtherRoles(ctx *context.Context) {
 		hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, "error_user_role_no_user"))
 		return
 	}
+	if !userInScope(ctx, user_id) {
+		return
+	}
 
 	rst, err := this.models.GetOtherRoles(user_id)
 	if err != nil {
@@ -137,7 +140,8 @@ func (this userRolesController) GetOtherRoles(ctx *context.Context) {
 //
 // 给指定的用户授予角色
 //
-// 给指定的用户授予角色
+// 给指定的用户授予角色,授权时可以指定机构范围org_scope,org_scope_sub为1时包含下级机构,
+// 被授予角色的用户只能管理机构范围内的用户与机构
 //
 // ---
 // produces:
@@ -157,10 +161,6 @@ func (this userRolesController) GetOtherRoles(ctx *context.Context) {
 //     description: success
 func (this userRolesController) Auth(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
 	var rst []models.UserRolesModel
 	err := json.Unmarshal([]byte(ctx.Request.FormValue("JSON")), &rst)
 	if err != nil {
@@ -169,6 +169,11 @@ func (this userRolesController) Auth(ctx *context.Context) {
 		return
 	}
 
+	scope, ok := orgScope(ctx)
+	if !ok {
+		return
+	}
+
 	for _, val := range rst {
 		domain_id, err := hrpc.GetDomainId(val.User_id)
 		if err != nil {
@@ -181,22 +186,51 @@ func (this userRolesController) Auth(ctx *context.Context) {
 			hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
 			return
 		}
+
+		if !scope.ContainsUser(val.User_id) {
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_org_scope_user"))
+			return
+		}
+
+		// 授权的机构范围必须是用户所在域中的机构,
+		// 并且不能超出当前用户的机构范围,限制了机构范围的用户不能授予不限制机构范围的角色
+		if !validator.IsIn(val.Org_scope_sub, "", "0", "1") {
+			hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_org_scope_format"))
+			return
+		}
+		if val.Org_scope != "" {
+			if did, err := utils.SplitDomain(val.Org_scope); err != nil || did != domain_id {
+				hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_org_scope_format"))
+				return
+			}
+		}
+		if !scope.All && (val.Org_scope == "" || !scope.Covers(val.Org_scope, val.Org_scope_sub == "1")) {
+			hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_org_scope_grant"))
+			return
+		}
 	}
 
-	cok, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cok.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 403, i18n.Disconnect(ctx.Request))
 		return
 	}
 
-	msg, err := this.models.Auth(rst, jclaim.UserId)
+	changes, msg, err := hrpc.CheckSuperAdminChange(ctx.Request, roleGrants(rst), hrpc.SuperAdminGrant)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, msg))
+		return
+	}
+
+	msg, err = this.models.Auth(rst, jclaim.UserId)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, msg), err)
 		return
 	}
+	hrpc.AuditSuperAdmin(ctx.Request, changes, hrpc.SuperAdminGrant)
 	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
 }
 
@@ -233,11 +267,6 @@ func (this userRolesController) Auth(ctx *context.Context) {
 //     description: success
 func (this userRolesController) Revoke(ctx *context.Context) {
 	ctx.Request.ParseForm()
-	if !hrpc.BasicAuth(ctx.Request) {
-		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
-		return
-	}
-
 	form := ctx.Request.FormValue("JSON")
 	var rst []models.UserRolesModel
 
@@ -262,15 +291,40 @@ func (this userRolesController) Revoke(ctx *context.Context) {
 		}
 	}
 
-	msg, err := this.models.Revoke(rst)
+	var users []string
+	for _, val := range rst {
+		users = append(users, val.User_id)
+	}
+	if !userInScope(ctx, users...) {
+		return
+	}
+
+	changes, msg, err := hrpc.CheckSuperAdminChange(ctx.Request, roleGrants(rst), hrpc.SuperAdminRevoke)
+	if err != nil {
+		logs.Error(err)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, msg))
+		return
+	}
+
+	msg, err = this.models.Revoke(rst)
 	if err != nil {
 		logs.Error(err)
 		hret.Error(ctx.ResponseWriter, 419, i18n.Get(ctx.Request, msg), err)
 		return
 	}
+	hrpc.AuditSuperAdmin(ctx.Request, changes, hrpc.SuperAdminRevoke)
 	hret.Success(ctx.ResponseWriter, i18n.Success(ctx.Request))
 }
 
+// 授予或者移除的用户角色
+func roleGrants(rst []models.UserRolesModel) []hrpc.RoleGrant {
+	var list []hrpc.RoleGrant
+	for _, val := range rst {
+		list = append(list, hrpc.RoleGrant{User_id: val.User_id, Role_id: val.Role_id})
+	}
+	return list
+}
+
 func init() {
 	// Registered in the static page to the groupCache system
 	groupcache.RegisterStaticFile("AuthorityPage", "./views/hauth/sys_batch_page.tpl")
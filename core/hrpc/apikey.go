@@ -0,0 +1,114 @@
+package hrpc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// API key 与服务账号
+// 服务账号(sys_sec_user.account_type = 1)不能交互式登录,只能使用API key访问/v1/下的接口,
+// 权限与普通用户一样,来自服务账号的角色与资源授权.
+//
+// API key的格式为 hak_<key_id>.<secret>, sys_api_key中只保存secret的sha256摘要,创建时只返回一次.
+// 请求通过 Authorization: Bearer <api key> 或者 X-Api-Key 头部传入.
+// scopes为空时,可以访问服务账号被授权的全部资源;不为空时,只能访问其中列出的资源编码.
+const (
+	AccountTypeUser    = "0"
+	AccountTypeService = "1"
+
+	apiKeyPrefix = "hak_"
+	// 最后使用时间的更新间隔,单位:秒
+	apiKeyTouchInterval = 60
+)
+
+// IsServiceAccount 判断用户是否是服务账号
+func IsServiceAccount(user_id string) bool {
+	account_type := ""
+	err := dbobj.QueryRow(sys_rdbms_hrpc_068, user_id).Scan(&account_type)
+	return err == nil && account_type == AccountTypeService
+}
+
+// GenApiKey 生成API key,返回key编码,API key与secret的sha256摘要
+func GenApiKey() (string, string, string, error) {
+	buf := make([]byte, 40)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+	key_id := hex.EncodeToString(buf[:8])
+	secret := hex.EncodeToString(buf[8:])
+	return key_id, apiKeyPrefix + key_id + "." + secret, sha256Hex(secret), nil
+}
+
+// RequestApiKey 读取请求中的API key,没有时返回空字符串
+func RequestApiKey(r *http.Request) string {
+	if key := r.Header.Get("X-Api-Key"); key != "" {
+		return strings.TrimSpace(key)
+	}
+	if auth := r.Header.Get("Authorization"); len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		if token := strings.TrimSpace(auth[7:]); strings.HasPrefix(token, apiKeyPrefix) {
+			return token
+		}
+	}
+	return ""
+}
+
+// AuthenticateApiKey 校验API key,返回服务账号的用户信息
+func AuthenticateApiKey(key string) (*jwt.JwtClaims, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, errors.New("api key format is invalid.")
+	}
+	idx := strings.Index(key, ".")
+	if idx < 0 {
+		return nil, errors.New("api key format is invalid.")
+	}
+	key_id, secret := key[len(apiKeyPrefix):idx], key[idx+1:]
+
+	var hash, user_id, scopes, status, account_type, org_id, domain_id string
+	var expire_time, last_used int64
+	err := dbobj.QueryRow(sys_rdbms_hrpc_069, key_id).Scan(&hash, &user_id, &scopes, &expire_time, &last_used,
+		&status, &account_type, &org_id, &domain_id)
+	if err != nil {
+		return nil, errors.New("api key is not found or disabled, key id is: " + key_id)
+	}
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(sha256Hex(secret))) != 1 {
+		return nil, errors.New("api key secret is invalid, key id is: " + key_id)
+	}
+
+	now := time.Now().Unix()
+	if expire_time <= now {
+		return nil, errors.New("api key is expired, key id is: " + key_id)
+	}
+	if status != "0" || account_type != AccountTypeService {
+		return nil, errors.New("service account is locked, user id is: " + user_id)
+	}
+
+	if now-last_used >= apiKeyTouchInterval {
+		if _, err = dbobj.Exec(sys_rdbms_hrpc_070, now, key_id); err != nil {
+			logs.Error(err)
+		}
+	}
+	return jwt.ApiKeyClaims(key_id, user_id, domain_id, org_id, scopes, expire_time), nil
+}
+
+// 使用API key访问时,接口对应的资源必须在API key的授权范围之内
+func apiKeyAllowed(jclaim *jwt.JwtClaims, res_ids []string) bool {
+	if jclaim.Scope == "" {
+		return true
+	}
+	scopes := strings.Fields(jclaim.Scope)
+	for _, res_id := range res_ids {
+		if containsString(scopes, res_id) {
+			return true
+		}
+	}
+	return false
+}
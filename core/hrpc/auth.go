@@ -5,38 +5,41 @@ package hrpc
 import (
 	"net/http"
 
-	"github.com/hzwy23/dbobj"
 	"github.com/hzwy23/hauth/utils/jwt"
 	"github.com/hzwy23/hauth/utils/logs"
 	"github.com/hzwy23/hauth/utils/validator"
 )
 
 // 校验用户是否有权限访问当前API
+// 按照请求的方法与路径匹配用户被授权的API资源声明的方法集合与路径模式
 func BasicAuth(r *http.Request) bool {
-	cookie, _ := r.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(r)
 	if err != nil {
 		logs.Error(err)
 		return false
 	}
-	if jclaim.UserId == "admin" {
-		return true
+	if IsRevoked(jclaim) {
+		logs.Error("token was revoked, user id is :", jclaim.UserId)
+		return false
 	}
-	cnt := 0
-	err = dbobj.QueryRow(sys_rdbms_hrpc_006, jclaim.UserId, r.URL.Path).Scan(&cnt)
+	routes, err := userPermissions(jclaim.UserId)
 	if err != nil {
 		logs.Error(err)
 		return false
 	}
-	if cnt == 0 {
-		logs.Error("insufficient privileges", "user id is :", jclaim.UserId, "api is :", r.URL.Path)
+	if !routes.allow(r.Method, r.URL.Path) {
+		logs.Error("insufficient privileges", "user id is :", jclaim.UserId, "api is :", r.Method, r.URL.Path)
+		return false
+	}
+	if jclaim.ApiKey != "" && !apiKeyAllowed(jclaim, routes.table.Match(r.Method, r.URL.Path)) {
+		logs.Error("api is out of api key scope, key id is :", jclaim.ApiKey, "api is :", r.URL.Path)
 		return false
 	}
 	return true
 }
 
 // 检查用户对指定的域的权限
-// 第一个参数中,http.Request,包含了用户的连接信息,cookie中.
+// 第一个参数中,http.Request,包含了用户的连接信息,在Authorization头部或cookie中.
 // 第二个参数中,domain_id,是用户想要访问的域
 // 第三个参数是访问模式,r 表示 只读, w 表示 读写.
 // 如果返回true,表示用户有权限
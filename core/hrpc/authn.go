@@ -0,0 +1,99 @@
+package hrpc
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 身份认证方式
+// 除了本地数据库sys_sec_user之外,可以注册外部的认证方式,例如LDAP.
+// 登录时依次使用注册的认证方式校验用户,所有的认证方式都不认识这个用户时,使用本地数据库校验.
+// 外部认证方式校验成功后,需要把用户信息同步到本地,后续的机构,角色,权限仍然使用本地数据.
+type Authenticator interface {
+	Name() string
+	// 返回ErrUnknownUser时,由下一个认证方式继续校验,
+	// 返回其他错误时,登录失败,返回值中的string是错误信息的i18n编码
+	Authenticate(user_id, passwd string) (string, error)
+}
+
+// ErrUnknownUser 用户不属于这个认证方式
+var ErrUnknownUser = errors.New("user is unknown to the authenticator.")
+
+var authenticators []Authenticator
+var authLock = new(sync.RWMutex)
+
+// RegisterAuthenticator 注册外部认证方式,按照注册的顺序校验
+func RegisterAuthenticator(a Authenticator) {
+	authLock.Lock()
+	defer authLock.Unlock()
+	for _, val := range authenticators {
+		if val.Name() == a.Name() {
+			panic("authenticator " + a.Name() + " has been registered.")
+		}
+	}
+	authenticators = append(authenticators, a)
+}
+
+// Authenticate 校验用户的账号与密码,返回值与CheckPasswd相同
+// 服务账号只能使用API key,密码正确也不能登录
+func Authenticate(user_id, passwd string) (bool, int, int64, string) {
+	ok, code, cnt, msg := authenticate(user_id, passwd)
+	if ok && IsServiceAccount(user_id) {
+		logs.Warn("service account can not login interactively, user id is:", user_id)
+		return false, 421, 0, "error_user_service_account"
+	}
+	return ok, code, cnt, msg
+}
+
+// 外部认证方式与本地密码使用同一个账号锁定策略,
+// 本地已经被锁定的用户不再请求外部认证方式,外部认证方式校验密码失败时同样累计连续错误次数.
+func authenticate(user_id, passwd string) (bool, int, int64, string) {
+	authLock.RLock()
+	list := authenticators
+	authLock.RUnlock()
+	if len(list) == 0 {
+		return CheckPasswd(user_id, passwd)
+	}
+
+	// 本地不存在的用户,可能由外部认证方式自动创建
+	sec, err := getUserSec(user_id)
+	if err != nil && err != sql.ErrNoRows {
+		logs.Error(err)
+		return false, 402, 0, "error_querydb"
+	}
+	if sec != nil {
+		if code, cnt, msg := checkLocked(sec); code != 200 {
+			return false, code, cnt, msg
+		}
+	}
+
+	for _, a := range list {
+		msg, err := a.Authenticate(user_id, passwd)
+		if err == ErrUnknownUser {
+			continue
+		}
+		if err != nil {
+			logs.Error(a.Name(), " authenticate failed, user id is:", user_id, err)
+			switch msg {
+			case "error_password":
+				if sec != nil {
+					code, cnt, msg := passwdFailed(sec)
+					return false, code, cnt, msg
+				}
+				return false, 405, 0, msg
+			case "error_user_admin_locked":
+				return false, 406, 0, msg
+			default:
+				return false, 421, 0, msg
+			}
+		}
+		if sec != nil {
+			passwdSucceeded(sec)
+		}
+		return true, 200, 0, ""
+	}
+	return CheckPasswd(user_id, passwd)
+}
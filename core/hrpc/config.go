@@ -0,0 +1,43 @@
+package hrpc
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type configGetter interface {
+	Get(key string) (string, error)
+}
+
+// 读取整数类型的配置项,没有配置或者配置错误时,返回默认值
+func confInt(conf configGetter, key string, def int64) int64 {
+	val, err := conf.Get(key)
+	if err != nil {
+		return def
+	}
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || n < 0 {
+		logs.Warn(key, " is invalid, use default value:", def)
+		return def
+	}
+	return n
+}
+
+// 读取布尔类型的配置项,true或者1表示开启
+func confBool(conf configGetter, key string, def bool) bool {
+	val, err := conf.Get(key)
+	if err != nil {
+		return def
+	}
+	switch strings.ToLower(val) {
+	case "true", "1":
+		return true
+	case "false", "0":
+		return false
+	default:
+		logs.Warn(key, " is invalid, use default value:", def)
+		return def
+	}
+}
@@ -0,0 +1,56 @@
+package hrpc
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/hzwy23/hauth/utils/jwt"
+)
+
+// CSRF token
+// 浏览器通过Authorization cookie传递token,其他网站的页面也能让浏览器带着cookie提交请求.
+// 签发访问token时,同时生成一个随机的CSRF token,明文写入页面脚本可以读取的CsrfToken cookie,
+// 摘要写入访问token的csrf字段. 通过cookie认证的POST,PUT,DELETE请求,
+// 必须在X-CSRF-Token头部中提交CSRF token,其他网站读取不到这个cookie,无法伪造请求.
+//
+// 通过Authorization头部传递token,或者使用API key的客户端,浏览器不会自动附带凭证,不需要校验.
+const (
+	CsrfCookie = "CsrfToken"
+	CsrfHeader = "X-CSRF-Token"
+)
+
+// 不修改数据的请求,不校验CSRF token
+func csrfSafeMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case "GET", "HEAD", "OPTIONS", "TRACE":
+		return true
+	}
+	return false
+}
+
+// CheckCsrf 校验通过cookie认证的请求中的CSRF token
+// 请求中没有token时返回true,由后续的认证逻辑拒绝请求
+func CheckCsrf(r *http.Request) bool {
+	if csrfSafeMethod(r.Method) || RequestApiKey(r) != "" {
+		return true
+	}
+	if auth := r.Header.Get("Authorization"); len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return true
+	}
+
+	cookie, err := r.Cookie("Authorization")
+	if err != nil || cookie.Value == "" {
+		return true
+	}
+	jclaim, err := jwt.ParseJwt(cookie.Value)
+	if err != nil {
+		return true
+	}
+
+	token := r.Header.Get(CsrfHeader)
+	if jclaim.Csrf == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(jclaim.Csrf), []byte(sha256Hex(token))) == 1
+}
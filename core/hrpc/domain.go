@@ -34,16 +34,20 @@ func GetDomainId(user_id string) (string, error) {
 // 返回值是2 表示有读写权限
 func checkDomainAuthLevel(req *http.Request, domain_id string) int {
 	level := -1
-	cookie, _ := req.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(req)
 	if err != nil {
 		logs.Error(err)
 		return level
 	}
 
-	// if the user is not admin, and user_id is not owner this domain_id
+	if IsRevoked(jclaim) {
+		logs.Error("token was revoked, user id is :", jclaim.UserId)
+		return level
+	}
+
+	// if the user is not super admin, and user_id is not owner this domain_id
 	// check share info. or not
-	if jclaim.UserId != "admin" && jclaim.DomainId != domain_id {
+	if jclaim.DomainId != domain_id && !IsSuperAdmin(jclaim.UserId) {
 		level = GetAuthLevel(jclaim.UserId, domain_id)
 		return level
 	} else {
@@ -0,0 +1,263 @@
+package hrpc
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/oidcclient"
+)
+
+// 外部身份提供者(IdP)登录
+// 每个域可以在sys_domain_idp中配置一个OpenID Connect身份提供者,应用密钥使用haes加密保存.
+// 用户从登录页面跳转到IdP,登录后IdP携带授权码回调/login/oidc/callback,
+// 校验ID token后,通过sys_user_identity把IdP中的用户(issuer + sub)对应到本系统的用户,再签发token.
+//
+// IdP中的用户必须先由管理员绑定到本系统的用户;
+// link_email = 1 时,ID token中的邮箱经过IdP验证,并且在域中只对应一个用户时,登录时自动绑定.
+//
+// state只保存sha256摘要,只能使用一次,同时写入cookie,回调时校验cookie,防止登录CSRF.
+const FederationStateCookie = "OidcState"
+
+// state_ttl 跳转到IdP后,完成登录的最长时间,单位:秒
+type federationConfig struct {
+	state_ttl int64
+}
+
+var federation = &federationConfig{
+	state_ttl: 300,
+}
+
+// DomainIdp 域配置的身份提供者
+type DomainIdp struct {
+	Domain_id     string
+	Issuer        string
+	Client_id     string
+	client_secret string
+	Scopes        string
+	Link_email    string
+}
+
+// FederatedUser IdP登录成功后,对应的本系统用户
+type FederatedUser struct {
+	User_id   string
+	Domain_id string
+	Issuer    string
+	Subject   string
+}
+
+// 身份提供者的服务发现与公钥缓存在Provider中,配置修改后重新创建
+type cachedProvider struct {
+	fingerprint string
+	provider    *oidcclient.Provider
+}
+
+var federationProviders = struct {
+	lock sync.Mutex
+	list map[string]*cachedProvider
+}{list: make(map[string]*cachedProvider)}
+
+// GetDomainIdp 查询域中启用的身份提供者
+func GetDomainIdp(domain_id string) (*DomainIdp, error) {
+	idp := DomainIdp{Domain_id: domain_id}
+	err := dbobj.QueryRow(sys_rdbms_hrpc_060, domain_id).Scan(&idp.Issuer, &idp.Client_id,
+		&idp.client_secret, &idp.Scopes, &idp.Link_email)
+	if err != nil {
+		return nil, err
+	}
+	idp.client_secret, err = haes.Decrypt(idp.client_secret)
+	if err != nil {
+		return nil, err
+	}
+	return &idp, nil
+}
+
+// IdentityHash 外部用户的唯一标识, sha256(issuer + 换行 + sub)
+func IdentityHash(issuer, subject string) string {
+	return sha256Hex(strings.TrimRight(issuer, "/") + "\n" + subject)
+}
+
+func federationRedirectUri(r *http.Request) string {
+	return OidcIssuer(r) + "/login/oidc/callback"
+}
+
+func (idp *DomainIdp) provider(redirect_uri string) *oidcclient.Provider {
+	fingerprint := strings.Join([]string{idp.Issuer, idp.Client_id, idp.client_secret, idp.Scopes, redirect_uri}, "\n")
+
+	federationProviders.lock.Lock()
+	defer federationProviders.lock.Unlock()
+	if c, ok := federationProviders.list[idp.Domain_id]; ok && c.fingerprint == fingerprint {
+		return c.provider
+	}
+	p := oidcclient.NewProvider(oidcclient.Config{
+		Issuer:       idp.Issuer,
+		ClientId:     idp.Client_id,
+		ClientSecret: idp.client_secret,
+		RedirectUri:  redirect_uri,
+		Scopes:       strings.Fields(idp.Scopes),
+	})
+	federationProviders.list[idp.Domain_id] = &cachedProvider{fingerprint: fingerprint, provider: p}
+	return p
+}
+
+// FederatedLoginURL 生成state,nonce与PKCE code verifier,返回跳转到IdP的地址与state
+func FederatedLoginURL(r *http.Request, domain_id string) (string, string, string, error) {
+	idp, err := GetDomainIdp(domain_id)
+	if err != nil {
+		logs.Error("query domain idp failed, domain id is:", domain_id, err)
+		return "", "", "error_idp_not_configured", err
+	}
+
+	var state, nonce, verifier string
+	for _, val := range []*string{&state, &nonce, &verifier} {
+		if *val, err = oidcclient.RandomString(); err != nil {
+			logs.Error(err)
+			return "", "", "error_idp_login", err
+		}
+	}
+
+	uri, err := idp.provider(federationRedirectUri(r)).AuthCodeURL(state, nonce, verifier)
+	if err != nil {
+		logs.Error("idp discovery failed, issuer is:", idp.Issuer, err)
+		return "", "", "error_idp_unavailable", err
+	}
+
+	_, err = dbobj.Exec(sys_rdbms_hrpc_061, sha256Hex(state), domain_id, nonce, verifier, time.Now().Unix()+federation.state_ttl)
+	if err != nil {
+		logs.Error(err)
+		return "", "", "error_idp_login", err
+	}
+	return uri, state, "success", nil
+}
+
+// FederatedCallback 校验state,使用授权码换取ID token,返回IdP用户对应的本系统用户
+func FederatedCallback(r *http.Request, state, code string) (*FederatedUser, string, error) {
+	hash := sha256Hex(state)
+	var domain_id, nonce, verifier string
+	var expire_time int64
+	err := dbobj.QueryRow(sys_rdbms_hrpc_062, hash).Scan(&domain_id, &nonce, &verifier, &expire_time)
+	if err != nil {
+		return nil, "error_idp_state", errors.New("login state is invalid.")
+	}
+
+	// state只能使用一次
+	rst, err := dbobj.Exec(sys_rdbms_hrpc_063, hash)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_idp_login", err
+	}
+	if cnt, err := rst.RowsAffected(); err != nil || cnt != 1 {
+		return nil, "error_idp_state", errors.New("login state has been used.")
+	}
+	if expire_time <= time.Now().Unix() {
+		return nil, "error_idp_state", errors.New("login state is expired.")
+	}
+
+	idp, err := GetDomainIdp(domain_id)
+	if err != nil {
+		logs.Error("query domain idp failed, domain id is:", domain_id, err)
+		return nil, "error_idp_not_configured", err
+	}
+
+	token, err := idp.provider(federationRedirectUri(r)).Exchange(code, verifier, nonce)
+	if err != nil {
+		logs.Error("idp login failed, issuer is:", idp.Issuer, err)
+		return nil, "error_idp_token", err
+	}
+
+	user := &FederatedUser{
+		Domain_id: domain_id,
+		Issuer:    token.Issuer,
+		Subject:   token.Subject,
+	}
+	user.User_id, err = idp.linkedUser(token)
+	if err != nil {
+		logs.Warn("idp user is not linked, issuer is:", token.Issuer, ", subject is:", token.Subject, err)
+		return nil, "error_idp_user_not_linked", err
+	}
+
+	// 绑定的用户必须属于这个域,不能是服务账号,并且没有被锁定
+	did, err := GetDomainId(user.User_id)
+	if err != nil || did != domain_id {
+		return nil, "error_idp_user_not_linked", errors.New("linked user is not in domain " + domain_id)
+	}
+	if IsServiceAccount(user.User_id) {
+		return nil, "error_user_service_account", errors.New("service account can not login interactively.")
+	}
+	status := ""
+	if err = dbobj.QueryRow(sys_rdbms_hrpc_050, user.User_id).Scan(&status); err != nil || status != "0" {
+		return nil, "error_user_admin_locked", errors.New("user is locked or deleted.")
+	}
+	return user, "success", nil
+}
+
+// 查询IdP用户绑定的本系统用户,没有绑定时,按照配置使用邮箱自动绑定
+func (idp *DomainIdp) linkedUser(token *oidcclient.IdToken) (string, error) {
+	identity_id := IdentityHash(token.Issuer, token.Subject)
+	user_id := ""
+	err := dbobj.QueryRow(sys_rdbms_hrpc_065, identity_id).Scan(&user_id)
+	if err == nil {
+		return user_id, nil
+	}
+	if idp.Link_email != "1" || !token.EmailVerified || token.Email == "" {
+		return "", err
+	}
+
+	rows, err := dbobj.Query(sys_rdbms_hrpc_066, idp.Domain_id, token.Email)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	var users []string
+	for rows.Next() {
+		if err = rows.Scan(&user_id); err != nil {
+			return "", err
+		}
+		users = append(users, user_id)
+	}
+	if len(users) != 1 {
+		return "", errors.New("email matches no user or more than one user: " + token.Email)
+	}
+
+	err = LinkIdentity(token.Issuer, token.Subject, users[0], idp.Domain_id)
+	if err != nil {
+		return "", err
+	}
+	logs.Info("link idp user to ", users[0], " by email, issuer is:", token.Issuer, ", subject is:", token.Subject)
+	return users[0], nil
+}
+
+// LinkIdentity 把IdP中的用户绑定到本系统的用户
+func LinkIdentity(issuer, subject, user_id, domain_id string) error {
+	issuer = strings.TrimRight(issuer, "/")
+	_, err := dbobj.Exec(sys_rdbms_hrpc_067, IdentityHash(issuer, subject), issuer, subject, user_id, domain_id, time.Now().Unix())
+	return err
+}
+
+// 清理过期的登录state
+func purgeFederationState() {
+	dbobj.Exec(sys_rdbms_hrpc_064, time.Now().Unix())
+}
+
+func (f *federationConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read federation config from app.conf failed, use default value.", err)
+		return
+	}
+	if n := confInt(conf, "Hauth.idp.state.timeout", f.state_ttl); n > 0 {
+		f.state_ttl = n
+	}
+}
+
+func init() {
+	federation.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
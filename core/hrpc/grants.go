@@ -0,0 +1,106 @@
+package hrpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 访问token中的授权信息
+// 签发访问token时,查询用户拥有的角色与角色授权的资源,写入token的authorities与perms,
+// 其他服务不需要查询认证服务,直接使用token中的授权信息判断权限.
+// 授权信息的版本是角色与资源编码的摘要,授权发生变化时版本随之变化,不需要单独维护版本号.
+type grantsConfig struct {
+	// 是否在token中写入资源编码
+	permissions bool
+}
+
+var grants = &grantsConfig{
+	permissions: false,
+}
+
+// 查询用户的角色编码与资源编码,都按照编码排序
+func queryGrants(user_id string) ([]string, []string, error) {
+	roles, err := queryStrings(sys_rdbms_hrpc_086, user_id)
+	if err != nil {
+		return nil, nil, err
+	}
+	perms, err := queryStrings(sys_rdbms_hrpc_087, user_id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return roles, perms, nil
+}
+
+func queryStrings(sql string, args ...interface{}) ([]string, error) {
+	rows, err := dbobj.Query(sql, args...)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []string
+	for rows.Next() {
+		val := ""
+		if err = rows.Scan(&val); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		rst = append(rst, val)
+	}
+	return rst, nil
+}
+
+func grantVersion(roles, perms []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(roles, ",") + "|" + strings.Join(perms, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// LoadGrants 查询用户当前的授权信息,用于签发访问token
+func LoadGrants(user_id string) (*jwt.Grants, error) {
+	roles, perms, err := queryGrants(user_id)
+	if err != nil {
+		return nil, err
+	}
+	g := &jwt.Grants{
+		Roles:   roles,
+		Version: grantVersion(roles, perms),
+	}
+	if grants.permissions {
+		g.Permissions = perms
+		if g.Permissions == nil {
+			g.Permissions = []string{}
+		}
+	}
+	return g, nil
+}
+
+// GrantVersion 返回用户当前授权信息的版本,与token中的gver不同时,表示token签发后授权发生了变化
+func GrantVersion(user_id string) (string, error) {
+	roles, perms, err := queryGrants(user_id)
+	if err != nil {
+		return "", err
+	}
+	return grantVersion(roles, perms), nil
+}
+
+func (g *grantsConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read grants config from app.conf failed, use default value.", err)
+		return
+	}
+	g.permissions = confBool(conf, "Hauth.jwt.permissions", g.permissions)
+}
+
+func init() {
+	grants.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,127 @@
+package hrpc
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/uuid"
+)
+
+// 模拟用户登录
+// 管理员不需要用户的密码,就可以使用用户的身份访问系统,用于排查用户的权限问题.
+// 模拟登录只签发访问token,不签发刷新token,有效期固定,过期后不能续期.
+// token中的impersonator是管理员,操作日志同时记录被模拟的用户与管理员.
+//
+// 模拟登录期间,不能修改被模拟用户的密码,两步验证,会话,也不能再次模拟其他用户.
+// 模拟登录的开始与结束都记录到sys_impersonate_audit中,记录写入失败时不能开始模拟登录.
+const (
+	ImpersonateStart = "start"
+	ImpersonateEnd   = "end"
+)
+
+type impersonateConfig struct {
+	ttl int64
+}
+
+var impersonate = &impersonateConfig{
+	ttl: 900,
+}
+
+// 模拟登录时禁止访问的API
+var impersonateDenied = map[string]bool{
+	"/v1/auth/passwd/update":         true,
+	"/v1/auth/mfa/enroll":            true,
+	"/v1/auth/mfa/confirm":           true,
+	"/v1/auth/mfa/recovery":          true,
+	"/v1/auth/session/delete":        true,
+	"/v1/auth/user/impersonate":      true,
+	"/v1/auth/user/apikey/post":      true,
+	"/v1/auth/user/identity/post":    true,
+	"/v1/auth/user/modify/passwd":    true,
+	"/v1/auth/oauth/client/secret":   true,
+	"/v1/auth/session/domain/delete": true,
+}
+
+// Impersonate 管理员模拟用户登录,返回模拟用户的访问token
+// 被模拟的用户不能是管理员自己,超级管理员与服务账号,并且没有被锁定
+func Impersonate(r *http.Request, admin *jwt.JwtClaims, user_id, domain_id, org_id string) (*TokenPair, string, error) {
+	if admin.Impersonator != "" {
+		return nil, "error_impersonate_nested", errors.New("impersonation can not be nested.")
+	}
+	if user_id == admin.UserId || IsSuperAdmin(user_id) || IsServiceAccount(user_id) {
+		return nil, "error_impersonate_user", errors.New("user can not be impersonated: " + user_id)
+	}
+	status := ""
+	if err := dbobj.QueryRow(sys_rdbms_hrpc_050, user_id).Scan(&status); err != nil || status != "0" {
+		return nil, "error_user_admin_locked", errors.New("user is locked or deleted.")
+	}
+
+	csrf, err := genRefreshToken()
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_impersonate", err
+	}
+	user_grants, err := LoadGrants(user_id)
+	if err != nil {
+		return nil, "error_impersonate", err
+	}
+	access := jwt.GenImpersonationToken(admin.UserId, user_id, domain_id, org_id, admin.SessionId, sha256Hex(csrf), user_grants, impersonate.ttl)
+	if access == "" {
+		return nil, "error_impersonate", errors.New("generate access token failed.")
+	}
+	if err = auditImpersonate(r, admin.UserId, user_id, admin.SessionId, ImpersonateStart); err != nil {
+		return nil, "error_impersonate", err
+	}
+	logs.Info("user ", admin.UserId, " impersonate user ", user_id, ", expires in ", impersonate.ttl, " seconds")
+	return &TokenPair{
+		AccessToken:  access,
+		AccessMaxAge: impersonate.ttl,
+		CsrfToken:    csrf,
+	}, "success", nil
+}
+
+// EndImpersonate 结束模拟登录,吊销模拟登录的访问token
+func EndImpersonate(r *http.Request, jclaim *jwt.JwtClaims) error {
+	if err := RevokeToken(jclaim, jclaim.Impersonator); err != nil {
+		return err
+	}
+	auditImpersonate(r, jclaim.Impersonator, jclaim.UserId, jclaim.SessionId, ImpersonateEnd)
+	logs.Info("user ", jclaim.Impersonator, " end impersonating user ", jclaim.UserId)
+	return nil
+}
+
+// 记录模拟登录的开始与结束,session_id是管理员的登录会话
+func auditImpersonate(r *http.Request, admin_id, user_id, session_id, action string) error {
+	_, err := dbobj.Exec(sys_rdbms_hrpc_098, uuid.GenUUID(), admin_id, user_id, session_id, action, time.Now().Unix(), ClientIP(r))
+	if err != nil {
+		logs.Error("write impersonate audit failed.", err)
+	}
+	return err
+}
+
+// ImpersonationAllowed 判断模拟登录时,是否允许访问API
+func ImpersonationAllowed(jclaim *jwt.JwtClaims, path string) bool {
+	return jclaim.Impersonator == "" || !impersonateDenied[path]
+}
+
+func (c *impersonateConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read impersonate config from app.conf failed, use default value.", err)
+		return
+	}
+	if n := confInt(conf, "Hauth.impersonate.timeout", c.ttl); n > 0 {
+		c.ttl = n
+	}
+}
+
+func init() {
+	impersonate.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,99 @@
+package hrpc
+
+import (
+	"strings"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// token自省, RFC 7662
+// 其他服务收到本系统签发的访问token,第三方应用的访问token或者API key后,
+// 使用注册的机密应用认证,向认证服务查询token是否有效,以及token所属的用户,域,机构与角色.
+// 已经过期,被吊销的token,以及用户被锁定或者应用被删除后,返回active = false.
+//
+// 返回的角色与授权版本是查询时用户当前的授权信息,不是签发token时的授权信息.
+const (
+	TokenTypeBearer = "Bearer"
+	TokenTypeApiKey = "api_key"
+)
+
+type Introspection struct {
+	Active     bool   `json:"active"`
+	Scope      string `json:"scope,omitempty"`
+	Client_id  string `json:"client_id,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Token_type string `json:"token_type,omitempty"`
+	Exp        int64  `json:"exp,omitempty"`
+	Iat        int64  `json:"iat,omitempty"`
+	Sub        string `json:"sub,omitempty"`
+	Aud        string `json:"aud,omitempty"`
+	Iss        string `json:"iss,omitempty"`
+	Jti        string `json:"jti,omitempty"`
+	// 以下是本系统扩展的字段
+	Domain_id     string   `json:"domain_id,omitempty"`
+	Org_unit_id   string   `json:"org_unit_id,omitempty"`
+	Roles         []string `json:"roles,omitempty"`
+	Grant_version string   `json:"gver,omitempty"`
+	Impersonator  string   `json:"impersonator,omitempty"`
+}
+
+var inactiveToken = &Introspection{Active: false}
+
+// IntrospectToken 查询token的状态, c是发起查询的应用
+func IntrospectToken(c *OauthClient, token string) *Introspection {
+	var jclaim *jwt.JwtClaims
+	var err error
+	token_type := TokenTypeBearer
+	if strings.HasPrefix(token, apiKeyPrefix) {
+		token_type = TokenTypeApiKey
+		jclaim, err = AuthenticateApiKey(token)
+	} else {
+		jclaim, err = jwt.ParseAccessToken(token)
+	}
+	if err != nil {
+		logs.Warn("introspect token failed, client id is:", c.Client_id, err)
+		return inactiveToken
+	}
+	if token_type == TokenTypeBearer && IsRevoked(jclaim) {
+		return inactiveToken
+	}
+
+	rst := &Introspection{
+		Active:       true,
+		Scope:        jclaim.Scope,
+		Client_id:    jclaim.Audience,
+		Token_type:   token_type,
+		Exp:          jclaim.ExpiresAt,
+		Iat:          jclaim.IssuedAt,
+		Sub:          jclaim.UserId,
+		Aud:          jclaim.Audience,
+		Iss:          jclaim.Issuer,
+		Jti:          jclaim.Id,
+		Domain_id:    jclaim.DomainId,
+		Org_unit_id:  jclaim.OrgUnitId,
+		Impersonator: jclaim.Impersonator,
+	}
+
+	// 应用使用client_credentials获取的token,用户就是应用本身
+	if jclaim.Audience != "" && jclaim.UserId == jclaim.Audience {
+		if _, err = GetOauthClient(jclaim.Audience); err != nil {
+			return inactiveToken
+		}
+		return rst
+	}
+
+	status := ""
+	if err = dbobj.QueryRow(sys_rdbms_hrpc_050, jclaim.UserId).Scan(&status); err != nil || status != "0" {
+		return inactiveToken
+	}
+	roles, perms, err := queryGrants(jclaim.UserId)
+	if err != nil {
+		return inactiveToken
+	}
+	rst.Username = jclaim.UserId
+	rst.Roles = roles
+	rst.Grant_version = grantVersion(roles, perms)
+	return rst
+}
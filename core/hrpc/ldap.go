@@ -0,0 +1,312 @@
+package hrpc
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
+	"github.com/hzwy23/hauth/utils/ldapauth"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/uuid"
+)
+
+// LDAP/AD 认证
+// 在app.conf中配置Hauth.ldap.enable = true后启用,用户使用目录服务中的密码登录.
+// 登录成功后,同步用户的姓名与邮箱,并按照Hauth.ldap.group.roles配置的映射关系授予或者回收角色,
+// 只有映射关系中出现的角色才会被回收,管理员手工授予的其他角色不受影响.
+//
+// 本地不存在的用户,配置了Hauth.ldap.jit = true时自动创建,
+// 用户所在的机构为 Hauth.ldap.domain + "_join_" + 部门属性,机构不存在时使用Hauth.ldap.default.org.
+// 自动创建的用户本地密码是随机值,只能通过目录服务登录.
+//
+// Hauth.ldap.exclude.users 中的用户不使用目录服务认证,默认是admin,目录服务不可用时仍然可以登录.
+const ldapUserOwner = "ldap"
+
+type ldapAuthenticator struct {
+	client      *ldapauth.Client
+	jit         bool
+	domain_id   string
+	default_org string
+	theme_id    string
+	exclude     map[string]bool
+	// key是组的DN, value是角色编码
+	group_roles map[string][]string
+}
+
+func (l *ldapAuthenticator) Name() string {
+	return "ldap"
+}
+
+func (l *ldapAuthenticator) Authenticate(user_id, passwd string) (string, error) {
+	if l.exclude[user_id] {
+		return "", ErrUnknownUser
+	}
+
+	entry, err := l.client.Authenticate(user_id, passwd)
+	switch err {
+	case nil:
+	case ldapauth.ErrUserNotFound:
+		return "", ErrUnknownUser
+	case ldapauth.ErrInvalidCredentials:
+		return "error_password", err
+	default:
+		// 目录服务不可用时,使用本地数据库校验
+		logs.Error("ldap is unavailable, use local password. user id is:", user_id, err)
+		return "", ErrUnknownUser
+	}
+
+	// 管理员在本地锁定的用户,不允许通过目录服务登录,也不同步目录服务中的用户信息
+	status := ""
+	err = dbobj.QueryRow(sys_rdbms_hrpc_050, user_id).Scan(&status)
+	if err != nil && err != sql.ErrNoRows {
+		return "error_querydb", err
+	}
+	if err == nil && status != "0" {
+		return "error_user_admin_locked", errors.New("user is locked.")
+	}
+
+	return l.syncUser(user_id, entry)
+}
+
+// 把目录服务中的用户信息同步到本地
+func (l *ldapAuthenticator) syncUser(user_id string, e *ldapauth.Entry) (string, error) {
+	// sys_user_info.user_email最长30个字符,超长时不同步
+	if len(e.Email) > 30 {
+		e.Email = ""
+	}
+
+	cnt := 0
+	err := dbobj.QueryRow(sys_rdbms_hrpc_044, user_id).Scan(&cnt)
+	if err != nil {
+		logs.Error(err)
+		return "error_querydb", err
+	}
+
+	if cnt == 0 {
+		if !l.jit {
+			return "error_ldap_user_not_provisioned", errors.New("user is not provisioned.")
+		}
+		if msg, err := l.createUser(user_id, e); err != nil {
+			return msg, err
+		}
+	} else {
+		_, err = dbobj.Exec(sys_rdbms_hrpc_046, nullString(e.Name), nullString(e.Email), ldapUserOwner, user_id)
+		if err != nil {
+			logs.Error(err)
+			return "error_ldap_sync", err
+		}
+	}
+
+	for group, roles := range l.group_roles {
+		member := e.HasGroup(group)
+		for _, role_id := range roles {
+			l.syncRole(user_id, role_id, member)
+		}
+	}
+	return "success", nil
+}
+
+func (l *ldapAuthenticator) createUser(user_id string, e *ldapauth.Entry) (string, error) {
+	org_id := l.default_org
+	if e.Org != "" && l.domain_id != "" {
+		cnt := 0
+		id := l.domain_id + "_join_" + e.Org
+		if err := dbobj.QueryRow(sys_rdbms_hrpc_045, id, l.domain_id).Scan(&cnt); err == nil && cnt > 0 {
+			org_id = id
+		}
+	}
+	if org_id == "" {
+		return "error_ldap_user_org", errors.New("can not find org for ldap user " + user_id)
+	}
+
+	name := e.Name
+	if name == "" {
+		name = user_id
+	}
+
+	// 本地密码使用随机值,用户只能通过目录服务登录
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "error_ldap_sync", err
+	}
+	hash, err := hpasswd.Hash(hex.EncodeToString(buf))
+	if err != nil {
+		return "error_ldap_sync", err
+	}
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return "error_sql_begin", err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_047, user_id, name, ldapUserOwner, nullString(e.Email), nullString(digits(e.Phone)), org_id, ldapUserOwner)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return "error_ldap_sync", err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_048, user_id, hash, "0", time.Now().Unix(), "0")
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return "error_ldap_sync", err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_049, user_id, l.theme_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return "error_ldap_sync", err
+	}
+	err = tx.Commit()
+	if err != nil {
+		logs.Error(err)
+		return "error_ldap_sync", err
+	}
+	logs.Info("create user from ldap, user id is:", user_id, ", org is:", org_id)
+	return "success", nil
+}
+
+// 按照用户是否属于映射的组,授予或者回收角色
+// 超级管理员角色只能由超级管理员手工授予,不通过LDAP同步
+func (l *ldapAuthenticator) syncRole(user_id, role_id string, member bool) {
+	if IsSuperAdminRole(role_id) {
+		logs.Warn("super admin role can not be synced from ldap, role id is:", role_id)
+		return
+	}
+	cnt := 0
+	err := dbobj.QueryRow(sys_rdbms_hrpc_051, user_id, role_id).Scan(&cnt)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	if member && cnt == 0 {
+		_, err = dbobj.Exec(sys_rdbms_hrpc_052, uuid.GenUUID(), role_id, user_id, ldapUserOwner)
+	} else if !member && cnt > 0 {
+		_, err = dbobj.Exec(sys_rdbms_hrpc_053, user_id, role_id)
+	} else {
+		return
+	}
+	if err != nil {
+		logs.Error("sync ldap role failed, user id is:", user_id, ", role id is:", role_id, err)
+		return
+	}
+	InvalidatePermissions(user_id)
+}
+
+// 空字符串保存为NULL
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// 电话号码只保留数字, sys_user_info.user_phone是数字类型
+func digits(s string) string {
+	var rst []rune
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			rst = append(rst, c)
+		}
+	}
+	if len(rst) > 15 {
+		return ""
+	}
+	return string(rst)
+}
+
+// 解析组与角色的映射关系, 格式为 组DN:角色;组DN:角色, 同一个组可以映射多个角色
+// 组必须是完整的DN,只写组名时,其他目录中的同名组也会被授予角色,这类配置被忽略
+func parseGroupRoles(s string) map[string][]string {
+	rst := make(map[string][]string)
+	for _, item := range strings.Split(s, ";") {
+		i := strings.LastIndex(item, ":")
+		if i <= 0 || i == len(item)-1 {
+			continue
+		}
+		group := strings.TrimSpace(item[:i])
+		if ldapauth.NormalizeDN(group) == "" || !strings.Contains(group, "=") {
+			logs.Error("ldap group must be a full dn, ignore group role mapping:", item)
+			continue
+		}
+		rst[group] = append(rst[group], strings.TrimSpace(item[i+1:]))
+	}
+	return rst
+}
+
+// 从app.conf中读取LDAP配置,没有启用时返回nil
+func loadLdapAuthenticator(file string) *ldapAuthenticator {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		return nil
+	}
+	if !confBool(conf, "Hauth.ldap.enable", false) {
+		return nil
+	}
+
+	get := func(key, def string) string {
+		if val, err := conf.Get(key); err == nil {
+			return val
+		}
+		return def
+	}
+
+	// 服务账号的密码与数据库密码一样,使用AES加密后配置
+	bind_passwd := get("Hauth.ldap.bind.passwd", "")
+	if bind_passwd != "" {
+		bind_passwd, err = haes.Decrypt(bind_passwd)
+		if err != nil {
+			logs.Error("decrypt Hauth.ldap.bind.passwd failed, ldap authenticator is disabled.", err)
+			return nil
+		}
+	}
+
+	l := &ldapAuthenticator{
+		client: ldapauth.NewClient(ldapauth.Config{
+			Url:                get("Hauth.ldap.url", ""),
+			StartTLS:           confBool(conf, "Hauth.ldap.starttls", false),
+			InsecureSkipVerify: confBool(conf, "Hauth.ldap.insecure", false),
+			Timeout:            time.Duration(confInt(conf, "Hauth.ldap.timeout", 5)) * time.Second,
+			BindDN:             get("Hauth.ldap.bind.dn", ""),
+			BindPassword:       bind_passwd,
+			BaseDN:             get("Hauth.ldap.base.dn", ""),
+			UserFilter:         get("Hauth.ldap.user.filter", "(uid=%s)"),
+			UserDN:             get("Hauth.ldap.user.dn", ""),
+			AttrName:           get("Hauth.ldap.attr.name", "cn"),
+			AttrEmail:          get("Hauth.ldap.attr.email", "mail"),
+			AttrPhone:          get("Hauth.ldap.attr.phone", ""),
+			AttrOrg:            get("Hauth.ldap.attr.org", ""),
+			AttrGroups:         get("Hauth.ldap.attr.groups", "memberOf"),
+			GroupBaseDN:        get("Hauth.ldap.group.base.dn", ""),
+			GroupFilter:        get("Hauth.ldap.group.filter", ""),
+		}),
+		jit:         confBool(conf, "Hauth.ldap.jit", false),
+		domain_id:   get("Hauth.ldap.domain", ""),
+		default_org: get("Hauth.ldap.default.org", ""),
+		theme_id:    get("Hauth.ldap.theme", "1001"),
+		exclude:     make(map[string]bool),
+		group_roles: parseGroupRoles(get("Hauth.ldap.group.roles", "")),
+	}
+	for _, u := range strings.Split(get("Hauth.ldap.exclude.users", "admin"), ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			l.exclude[u] = true
+		}
+	}
+	return l
+}
+
+func init() {
+	if l := loadLdapAuthenticator(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf")); l != nil {
+		RegisterAuthenticator(l)
+		logs.Info("ldap authenticator is enabled.")
+	}
+}
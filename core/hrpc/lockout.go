@@ -0,0 +1,96 @@
+package hrpc
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 账号锁定
+// 用户连续输错密码达到threshold次后,账号被锁定duration秒,锁定期间无法登录,到期后自动解锁.
+// 同一个账号每多锁定一次,锁定时间翻倍,最长不超过max_duration秒,用户登录成功后重新计算.
+//
+// 锁定原因保存在sys_sec_user.lock_reason中:
+// 0 未锁定
+// 1 连续输错密码,到期后自动解锁
+// 2 管理员锁定,需要管理员解锁
+const (
+	LockReasonNone   = "0"
+	LockReasonPasswd = "1"
+	LockReasonAdmin  = "2"
+)
+
+type lockoutConfig struct {
+	threshold    int64
+	duration     int64
+	max_duration int64
+}
+
+var lockout = &lockoutConfig{
+	threshold:    6,
+	duration:     900,
+	max_duration: 86400,
+}
+
+// 第lock_cnt次锁定的锁定时间
+func (l *lockoutConfig) lockDuration(lock_cnt int64) int64 {
+	d := l.duration
+	for i := int64(1); i < lock_cnt && d < l.max_duration; i++ {
+		d *= 2
+	}
+	if d > l.max_duration {
+		d = l.max_duration
+	}
+	return d
+}
+
+// 锁定用户,返回自动解锁的时间
+func lockUser(user_id string, lock_cnt int64) int64 {
+	now := time.Now().Unix()
+	unlock := now + lockout.lockDuration(lock_cnt)
+	_, err := dbobj.Exec(sys_rdbms_hrpc_008, lock_cnt, LockReasonPasswd, now, unlock, user_id)
+	if err != nil {
+		logs.Error(err)
+	}
+	logs.Warn("user is locked until", time.Unix(unlock, 0).Format("2006-01-02 15:04:05"), ", user id is:", user_id)
+	return unlock
+}
+
+// 用户登录成功后,清除连续错误次数与锁定信息
+func resetLockout(user_id string) {
+	_, err := dbobj.Exec(sys_rdbms_hrpc_039, LockReasonNone, user_id)
+	if err != nil {
+		logs.Error(err)
+	}
+}
+
+// 从app.conf中读取账号锁定策略,没有配置时使用默认值
+func (l *lockoutConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read lockout policy from app.conf failed, use default value.", err)
+		return
+	}
+
+	get := func(key string, def int64) int64 {
+		if n := confInt(conf, key, def); n > 0 {
+			return n
+		}
+		return def
+	}
+
+	l.threshold = get("Hauth.lockout.threshold", l.threshold)
+	l.duration = get("Hauth.lockout.duration", l.duration)
+	l.max_duration = get("Hauth.lockout.max.duration", l.max_duration)
+	if l.max_duration < l.duration {
+		l.max_duration = l.duration
+	}
+}
+
+func init() {
+	lockout.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
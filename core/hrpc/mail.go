@@ -0,0 +1,90 @@
+package hrpc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/mailer"
+)
+
+// 系统邮件
+// 在app.conf中配置了Hauth.mail.smtp.host后,通过SMTP服务器发送邮件,
+// 没有配置时不发送邮件,依赖邮件的功能(例如找回密码)不可用.
+// 测试或者接入其他邮件服务时,可以通过SetMailer替换.
+var mailSender = struct {
+	lock   sync.RWMutex
+	mailer mailer.Mailer
+}{}
+
+// SetMailer 设置发送系统邮件使用的Mailer,为nil时不发送邮件
+func SetMailer(m mailer.Mailer) {
+	mailSender.lock.Lock()
+	defer mailSender.lock.Unlock()
+	mailSender.mailer = m
+}
+
+func getMailer() mailer.Mailer {
+	mailSender.lock.RLock()
+	defer mailSender.lock.RUnlock()
+	return mailSender.mailer
+}
+
+// 发送系统邮件
+func sendMail(msg *mailer.Message) error {
+	m := getMailer()
+	if m == nil {
+		return errors.New("mailer is not configured.")
+	}
+	return m.Send(msg)
+}
+
+// 从app.conf中读取SMTP服务器配置,没有配置时返回nil
+func loadMailer(file string) mailer.Mailer {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		return nil
+	}
+	host, err := conf.Get("Hauth.mail.smtp.host")
+	if err != nil || host == "" {
+		return nil
+	}
+
+	get := func(key string) string {
+		val, _ := conf.Get(key)
+		return val
+	}
+
+	// SMTP密码与数据库密码一样,使用AES加密后配置
+	passwd := get("Hauth.mail.smtp.passwd")
+	if passwd != "" {
+		passwd, err = haes.Decrypt(passwd)
+		if err != nil {
+			logs.Error("decrypt Hauth.mail.smtp.passwd failed, mailer is disabled.", err)
+			return nil
+		}
+	}
+
+	return mailer.NewSmtpMailer(mailer.SmtpConfig{
+		Host:               host,
+		Port:               int(confInt(conf, "Hauth.mail.smtp.port", 25)),
+		Username:           get("Hauth.mail.smtp.username"),
+		Password:           passwd,
+		From:               get("Hauth.mail.from"),
+		TLS:                confBool(conf, "Hauth.mail.smtp.tls", false),
+		InsecureSkipVerify: confBool(conf, "Hauth.mail.smtp.insecure", false),
+		Timeout:            time.Duration(confInt(conf, "Hauth.mail.smtp.timeout", 10)) * time.Second,
+	})
+}
+
+func init() {
+	if m := loadMailer(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf")); m != nil {
+		SetMailer(m)
+		logs.Info("smtp mailer is enabled.")
+	}
+}
@@ -0,0 +1,391 @@
+package hrpc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/totp"
+)
+
+// 两步验证
+// 用户密码校验通过后,如果用户启用了两步验证,或者用户所在的域,拥有的角色要求两步验证,
+// 登录接口不直接签发token,而是返回一个挑战码,
+// 用户提交挑战码与身份验证器中的一次性密码(或者恢复码)后,才签发token.
+//
+// 要求两步验证但是还没有绑定身份验证器的用户,可以使用挑战码获取密钥完成绑定.
+//
+// 密钥使用AES加密后保存,挑战码与恢复码只保存sha256摘要.
+const (
+	mfaStatusPending = "0"
+	mfaStatusEnabled = "1"
+
+	MfaRequireDomain = "0"
+	MfaRequireRole   = "1"
+
+	// 挑战码有效期,单位:秒
+	mfaChallengeLifetime = 300
+	// 每个挑战码最多可以尝试的次数
+	mfaChallengeAttempts = 5
+	// 恢复码个数
+	mfaRecoveryCount = 10
+)
+
+var mfaIssuer = "hauth"
+
+// MfaEnrollment 绑定身份验证器时返回给用户的信息
+type MfaEnrollment struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+// MfaStatus 用户两步验证的状态
+type MfaStatus struct {
+	Enabled       bool `json:"enabled"`
+	Required      bool `json:"required"`
+	Recovery_left int  `json:"recovery_left"`
+}
+
+// MfaLogin 两步验证成功后,签发token需要的用户信息
+// 登录时完成绑定的用户,Recovery_codes中是新生成的恢复码
+type MfaLogin struct {
+	User_id        string
+	Domain_id      string
+	Org_unit_id    string
+	Recovery_codes []string
+}
+
+type mfaInfo struct {
+	secret    string
+	status    string
+	last_step int64
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func getMfaInfo(user_id string) (*mfaInfo, error) {
+	var m mfaInfo
+	err := dbobj.QueryRow(sys_rdbms_hrpc_024, user_id).Scan(&m.secret, &m.status, &m.last_step)
+	if err != nil {
+		return nil, err
+	}
+	m.secret, err = haes.Decrypt(m.secret)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// MfaEnabled 判断用户是否已经启用两步验证
+func MfaEnabled(user_id string) bool {
+	m, err := getMfaInfo(user_id)
+	return err == nil && m.status == mfaStatusEnabled
+}
+
+// GetMfaStatus 查询用户是否启用了两步验证,是否被要求启用两步验证,以及剩余的恢复码个数
+func GetMfaStatus(user_id, domain_id string) MfaStatus {
+	var rst MfaStatus
+	rst.Enabled = MfaEnabled(user_id)
+	rst.Required = MfaRequired(user_id, domain_id)
+	if rst.Enabled {
+		dbobj.QueryRow(sys_rdbms_hrpc_038, user_id).Scan(&rst.Recovery_left)
+	}
+	return rst
+}
+
+// MfaRequired 判断用户所在的域,或者用户拥有的角色是否要求两步验证
+func MfaRequired(user_id, domain_id string) bool {
+	cnt := 0
+	err := dbobj.QueryRow(sys_rdbms_hrpc_025, MfaRequireDomain, domain_id, MfaRequireRole, user_id).Scan(&cnt)
+	if err != nil {
+		logs.Error(err)
+		return false
+	}
+	return cnt > 0
+}
+
+// NewMfaChallenge 密码校验通过后,生成两步验证的挑战码
+func NewMfaChallenge(user_id, domain_id, org_id string) (string, error) {
+	buf := make([]byte, 32)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	challenge := hex.EncodeToString(buf)
+	_, err = dbobj.Exec(sys_rdbms_hrpc_026, sha256Hex(challenge), user_id, domain_id, org_id, 0, time.Now().Unix()+mfaChallengeLifetime)
+	if err != nil {
+		logs.Error(err)
+		return "", err
+	}
+	return challenge, nil
+}
+
+// 查询挑战码对应的用户,并增加一次尝试次数
+func useMfaChallenge(challenge string) (*MfaLogin, string, error) {
+	hash := sha256Hex(challenge)
+	var rst MfaLogin
+	var attempts, expire int64
+	err := dbobj.QueryRow(sys_rdbms_hrpc_027, hash).Scan(&rst.User_id, &rst.Domain_id, &rst.Org_unit_id, &attempts, &expire)
+	if err != nil {
+		return nil, "error_mfa_challenge_invalid", err
+	}
+	if expire <= time.Now().Unix() || attempts >= mfaChallengeAttempts {
+		dbobj.Exec(sys_rdbms_hrpc_029, hash)
+		return nil, "error_mfa_challenge_invalid", errors.New("mfa challenge is expired.")
+	}
+	_, err = dbobj.Exec(sys_rdbms_hrpc_028, hash)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_challenge_invalid", err
+	}
+	return &rst, "success", nil
+}
+
+// VerifyMfaChallenge 校验挑战码与一次性密码,成功后挑战码失效
+// code可以是身份验证器中的一次性密码,也可以是恢复码,恢复码使用后失效.
+// 登录时绑定身份验证器的用户,校验成功后启用两步验证,并生成恢复码.
+func VerifyMfaChallenge(challenge, code string) (*MfaLogin, string, error) {
+	rst, msg, err := useMfaChallenge(challenge)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	m, err := getMfaInfo(rst.User_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_not_enrolled", err
+	}
+
+	switch m.status {
+	case mfaStatusEnabled:
+		if !verifyTotp(rst.User_id, m, code) && !useRecoveryCode(rst.User_id, code) {
+			return nil, "error_mfa_code", errors.New("mfa code is not correct.")
+		}
+	default:
+		codes, msg, err := confirmMfa(rst.User_id, m, code)
+		if err != nil {
+			return nil, msg, err
+		}
+		rst.Recovery_codes = codes
+	}
+
+	dbobj.Exec(sys_rdbms_hrpc_029, sha256Hex(challenge))
+	return rst, "success", nil
+}
+
+// 校验一次性密码,同一个时间窗口的密码只能使用一次
+func verifyTotp(user_id string, m *mfaInfo, code string) bool {
+	step, ok := totp.Validate(m.secret, code, time.Now())
+	if !ok || step <= m.last_step {
+		return false
+	}
+	rst, err := dbobj.Exec(sys_rdbms_hrpc_030, step, user_id, step)
+	if err != nil {
+		logs.Error(err)
+		return false
+	}
+	cnt, err := rst.RowsAffected()
+	return err == nil && cnt == 1
+}
+
+func useRecoveryCode(user_id, code string) bool {
+	code = strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+	if code == "" {
+		return false
+	}
+	rst, err := dbobj.Exec(sys_rdbms_hrpc_031, sha256Hex(code), user_id)
+	if err != nil {
+		logs.Error(err)
+		return false
+	}
+	cnt, err := rst.RowsAffected()
+	return err == nil && cnt == 1
+}
+
+// EnrollMfa 为用户生成新的密钥,用户使用身份验证器扫描二维码后,
+// 需要提交一次性密码确认绑定,确认之前两步验证不生效.
+// 已经启用两步验证的用户,需要管理员重置后才能重新绑定.
+func EnrollMfa(user_id string) (*MfaEnrollment, string, error) {
+	if MfaEnabled(user_id) {
+		return nil, "error_mfa_already_enrolled", errors.New("mfa is already enabled.")
+	}
+
+	secret, err := totp.GenSecret()
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_enroll", err
+	}
+	en, err := haes.Encrypt(secret)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_enroll", err
+	}
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_sql_begin", err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_032, user_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return nil, "error_mfa_enroll", err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_033, user_id, en, mfaStatusPending, 0, time.Now().Unix())
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return nil, "error_mfa_enroll", err
+	}
+	err = tx.Commit()
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_enroll", err
+	}
+
+	return &MfaEnrollment{
+		Secret: secret,
+		Uri:    totp.ProvisioningURI(mfaIssuer, user_id, secret),
+	}, "success", nil
+}
+
+// EnrollMfaByChallenge 登录过程中,要求两步验证但是还没有绑定的用户,使用挑战码绑定身份验证器
+func EnrollMfaByChallenge(challenge string) (*MfaEnrollment, string, error) {
+	rst, msg, err := useMfaChallenge(challenge)
+	if err != nil {
+		return nil, msg, err
+	}
+	return EnrollMfa(rst.User_id)
+}
+
+// ConfirmMfa 用户提交一次性密码,确认绑定身份验证器,返回恢复码
+func ConfirmMfa(user_id, code string) ([]string, string, error) {
+	m, err := getMfaInfo(user_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_not_enrolled", err
+	}
+	if m.status == mfaStatusEnabled {
+		return nil, "error_mfa_already_enrolled", errors.New("mfa is already enabled.")
+	}
+	return confirmMfa(user_id, m, code)
+}
+
+func confirmMfa(user_id string, m *mfaInfo, code string) ([]string, string, error) {
+	if !verifyTotp(user_id, m, code) {
+		return nil, "error_mfa_code", errors.New("mfa code is not correct.")
+	}
+	_, err := dbobj.Exec(sys_rdbms_hrpc_034, mfaStatusEnabled, time.Now().Unix(), user_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_mfa_enroll", err
+	}
+	codes, err := genRecoveryCodes(user_id)
+	if err != nil {
+		return nil, "error_mfa_recovery", err
+	}
+	return codes, "success", nil
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码,原有的恢复码全部失效
+func RegenerateRecoveryCodes(user_id, code string) ([]string, string, error) {
+	m, err := getMfaInfo(user_id)
+	if err != nil || m.status != mfaStatusEnabled {
+		return nil, "error_mfa_not_enrolled", errors.New("mfa is not enabled.")
+	}
+	if !verifyTotp(user_id, m, code) {
+		return nil, "error_mfa_code", errors.New("mfa code is not correct.")
+	}
+	codes, err := genRecoveryCodes(user_id)
+	if err != nil {
+		return nil, "error_mfa_recovery", err
+	}
+	return codes, "success", nil
+}
+
+// 恢复码格式为 xxxxx-xxxxx, 保存时去掉分隔符
+func genRecoveryCodes(user_id string) ([]string, error) {
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	codes := make([]string, 0, mfaRecoveryCount)
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_035, user_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return nil, err
+	}
+	now := time.Now().Unix()
+	for i := 0; i < mfaRecoveryCount; i++ {
+		buf := make([]byte, 10)
+		_, err = rand.Read(buf)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		code := strings.ToLower(enc.EncodeToString(buf))[:10]
+		_, err = tx.Exec(sys_rdbms_hrpc_036, sha256Hex(code), user_id, now)
+		if err != nil {
+			tx.Rollback()
+			logs.Error(err)
+			return nil, err
+		}
+		codes = append(codes, code[:5]+"-"+code[5:])
+	}
+	err = tx.Commit()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	return codes, nil
+}
+
+// ResetMfa 管理员重置用户的两步验证,用户需要重新绑定身份验证器
+func ResetMfa(user_id string) error {
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_032, user_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return err
+	}
+	_, err = tx.Exec(sys_rdbms_hrpc_035, user_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return err
+	}
+	return tx.Commit()
+}
+
+func init() {
+	conf, err := config.GetConfig(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+	if err != nil {
+		return
+	}
+	if issuer, err := conf.Get("Hauth.mfa.issuer"); err == nil && issuer != "" {
+		mfaIssuer = issuer
+	}
+}
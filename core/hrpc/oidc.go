@@ -0,0 +1,479 @@
+package hrpc
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// OpenID Connect 身份提供者
+// 其他应用通过授权码流程或者客户端凭证流程从本系统获取token,
+// 使用/oauth2/jwks中的公钥校验token,不需要共享本系统的JWT密钥.
+// 授权码流程必须使用PKCE,并且只支持S256方式.
+//
+// 应用信息保存在sys_oauth_client中,应用密钥只保存bcrypt摘要.
+// client_type = 0 机密应用,使用密钥认证
+// client_type = 1 公开应用,例如单页应用与移动应用,没有密钥,只能使用授权码流程
+// trusted = 1 的应用不显示授权确认页面
+//
+// 授权码只保存sha256摘要,只能使用一次.
+const (
+	OauthClientConfidential = "0"
+	OauthClientPublic       = "1"
+
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+)
+
+// 支持的授权范围
+var OidcScopes = []string{"openid", "profile", "email"}
+
+// issuer 为空时,根据请求的地址生成,部署在反向代理之后时必须配置
+// code_ttl 授权码有效期,单位:秒
+type oidcConfig struct {
+	issuer   string
+	code_ttl int64
+}
+
+var oidc = &oidcConfig{
+	code_ttl: 60,
+}
+
+// OauthError 授权协议中的错误信息, RFC 6749 4.1.2.1 与 5.2
+type OauthError struct {
+	Status      int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *OauthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func oauthError(code, desc string) *OauthError {
+	status := http.StatusBadRequest
+	if code == "invalid_client" {
+		status = http.StatusUnauthorized
+	}
+	return &OauthError{Status: status, Code: code, Description: desc}
+}
+
+type OauthClient struct {
+	Client_id     string
+	Client_name   string
+	Domain_id     string
+	Client_type   string
+	Trusted       string
+	Redirect_uris []string
+	Grant_types   []string
+	Scopes        []string
+	client_secret string
+}
+
+// OidcTokens token接口的返回值
+type OidcTokens struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	IdToken     string `json:"id_token,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// GetOauthClient 查询有效的应用信息
+func GetOauthClient(client_id string) (*OauthClient, error) {
+	var c OauthClient
+	var uris, grants, scopes string
+	err := dbobj.QueryRow(sys_rdbms_hrpc_054, client_id).Scan(&c.Client_id, &c.Client_name, &c.client_secret,
+		&uris, &grants, &scopes, &c.Client_type, &c.Trusted, &c.Domain_id)
+	if err != nil {
+		return nil, err
+	}
+	c.Redirect_uris = strings.Fields(uris)
+	c.Grant_types = strings.Fields(grants)
+	c.Scopes = strings.Fields(scopes)
+	return &c, nil
+}
+
+func (c *OauthClient) IsPublic() bool {
+	return c.Client_type == OauthClientPublic
+}
+
+func (c *OauthClient) IsTrusted() bool {
+	return c.Trusted == "1"
+}
+
+func (c *OauthClient) allowGrant(grant string) bool {
+	return containsString(c.Grant_types, grant)
+}
+
+// 回调地址必须与注册的地址完全一致
+func (c *OauthClient) allowRedirect(uri string) bool {
+	return containsString(c.Redirect_uris, uri)
+}
+
+// 请求的授权范围必须是应用注册的范围的子集,没有指定时,使用应用注册的全部范围
+func (c *OauthClient) checkScope(scope string) (string, bool) {
+	if strings.TrimSpace(scope) == "" {
+		return strings.Join(c.Scopes, " "), true
+	}
+	var rst []string
+	for _, s := range strings.Fields(scope) {
+		if !containsString(c.Scopes, s) {
+			return "", false
+		}
+		if !containsString(rst, s) {
+			rst = append(rst, s)
+		}
+	}
+	return strings.Join(rst, " "), true
+}
+
+func containsString(list []string, s string) bool {
+	for _, val := range list {
+		if val == s {
+			return true
+		}
+	}
+	return false
+}
+
+func hasScope(scope, s string) bool {
+	return containsString(strings.Fields(scope), s)
+}
+
+// AuthenticateClient token接口中认证应用
+// 支持client_secret_basic与client_secret_post两种方式,公开应用只提交client_id
+func AuthenticateClient(r *http.Request) (*OauthClient, *OauthError) {
+	client_id, secret, basic := r.BasicAuth()
+	if basic {
+		client_id, _ = url.QueryUnescape(client_id)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		client_id = r.FormValue("client_id")
+		secret = r.FormValue("client_secret")
+	}
+	if client_id == "" {
+		return nil, oauthError("invalid_client", "client id is empty.")
+	}
+
+	c, err := GetOauthClient(client_id)
+	if err != nil {
+		logs.Error("query oauth client failed, client id is:", client_id, err)
+		return nil, oauthError("invalid_client", "client is not found.")
+	}
+	if c.IsPublic() {
+		if secret != "" {
+			return nil, oauthError("invalid_client", "public client must not use client secret.")
+		}
+		return c, nil
+	}
+	if ok, _ := hpasswd.Verify(c.client_secret, secret); !ok {
+		return nil, oauthError("invalid_client", "client authentication failed.")
+	}
+	return c, nil
+}
+
+// AuthRequest 授权请求
+type AuthRequest struct {
+	Client         *OauthClient
+	Redirect_uri   string
+	Scope          string
+	State          string
+	Nonce          string
+	Prompt         string
+	Code_challenge string
+}
+
+// ParseAuthRequest 校验授权请求
+// 返回错误时,如果AuthRequest不为nil,需要通过回调地址把错误信息返回给应用,
+// 应用或者回调地址不正确时,AuthRequest为nil,不能重定向到回调地址.
+func ParseAuthRequest(form url.Values) (*AuthRequest, *OauthError) {
+	c, err := GetOauthClient(form.Get("client_id"))
+	if err != nil {
+		logs.Error("query oauth client failed, client id is:", form.Get("client_id"), err)
+		return nil, oauthError("invalid_request", "client is not found.")
+	}
+
+	redirect_uri := form.Get("redirect_uri")
+	if redirect_uri == "" && len(c.Redirect_uris) == 1 {
+		redirect_uri = c.Redirect_uris[0]
+	}
+	if !c.allowRedirect(redirect_uri) {
+		return nil, oauthError("invalid_request", "redirect uri is not registered.")
+	}
+
+	req := &AuthRequest{
+		Client:         c,
+		Redirect_uri:   redirect_uri,
+		State:          form.Get("state"),
+		Nonce:          form.Get("nonce"),
+		Prompt:         form.Get("prompt"),
+		Code_challenge: form.Get("code_challenge"),
+	}
+
+	if form.Get("response_type") != "code" {
+		return req, oauthError("unsupported_response_type", "only code response type is supported.")
+	}
+	if !c.allowGrant(GrantAuthorizationCode) {
+		return req, oauthError("unauthorized_client", "client can not use authorization code grant.")
+	}
+	scope, ok := c.checkScope(form.Get("scope"))
+	if !ok {
+		return req, oauthError("invalid_scope", "scope is not allowed.")
+	}
+	req.Scope = scope
+	if req.Code_challenge == "" {
+		return req, oauthError("invalid_request", "code challenge is required.")
+	}
+	if form.Get("code_challenge_method") != "S256" {
+		return req, oauthError("invalid_request", "only S256 code challenge method is supported.")
+	}
+	return req, nil
+}
+
+// 在回调地址中追加参数,保留回调地址中原有的参数
+func (a *AuthRequest) redirect(params url.Values) string {
+	if a.State != "" {
+		params.Set("state", a.State)
+	}
+	u, err := url.Parse(a.Redirect_uri)
+	if err != nil {
+		return a.Redirect_uri
+	}
+	query := u.Query()
+	for key, val := range params {
+		query[key] = val
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// ErrorRedirect 携带错误信息的回调地址
+func (a *AuthRequest) ErrorRedirect(e *OauthError) string {
+	params := url.Values{"error": {e.Code}}
+	if e.Description != "" {
+		params.Set("error_description", e.Description)
+	}
+	return a.redirect(params)
+}
+
+// IssueCode 用户同意授权后,生成授权码,返回携带授权码的回调地址
+// auth_time 是用户登录的时间
+func (a *AuthRequest) IssueCode(user_id, domain_id, org_id string, auth_time int64) (string, error) {
+	code, err := genRefreshToken()
+	if err != nil {
+		logs.Error(err)
+		return "", err
+	}
+	_, err = dbobj.Exec(sys_rdbms_hrpc_055, hashRefreshToken(code), a.Client.Client_id, user_id, domain_id, org_id,
+		a.Redirect_uri, a.Scope, a.Nonce, a.Code_challenge, auth_time, time.Now().Unix()+oidc.code_ttl)
+	if err != nil {
+		logs.Error(err)
+		return "", err
+	}
+	return a.redirect(url.Values{"code": {code}}), nil
+}
+
+// PKCE S256, BASE64URL(SHA256(code_verifier)) == code_challenge
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// ExchangeCode 使用授权码换取token
+func ExchangeCode(c *OauthClient, issuer, code, redirect_uri, verifier string) (*OidcTokens, *OauthError) {
+	if !c.allowGrant(GrantAuthorizationCode) {
+		return nil, oauthError("unauthorized_client", "client can not use authorization code grant.")
+	}
+
+	hash := hashRefreshToken(code)
+	var client_id, user_id, domain_id, org_id, uri, scope, nonce, challenge string
+	var auth_time, expire_time int64
+	err := dbobj.QueryRow(sys_rdbms_hrpc_056, hash).Scan(&client_id, &user_id, &domain_id, &org_id,
+		&uri, &scope, &nonce, &challenge, &auth_time, &expire_time)
+	if err != nil {
+		return nil, oauthError("invalid_grant", "authorization code is invalid.")
+	}
+
+	// 授权码只能使用一次,并发提交同一个授权码时,只有一个请求能够删除成功
+	rst, err := dbobj.Exec(sys_rdbms_hrpc_057, hash)
+	if err != nil {
+		logs.Error(err)
+		return nil, oauthError("server_error", "")
+	}
+	if cnt, err := rst.RowsAffected(); err != nil || cnt != 1 {
+		return nil, oauthError("invalid_grant", "authorization code has been used.")
+	}
+
+	if expire_time <= time.Now().Unix() {
+		return nil, oauthError("invalid_grant", "authorization code is expired.")
+	}
+	if client_id != c.Client_id || uri != redirect_uri {
+		return nil, oauthError("invalid_grant", "authorization code was issued to another client or redirect uri.")
+	}
+	if !verifyCodeChallenge(challenge, verifier) {
+		return nil, oauthError("invalid_grant", "code verifier is invalid.")
+	}
+
+	// 签发授权码之后,用户可能已经被锁定
+	status := ""
+	if err = dbobj.QueryRow(sys_rdbms_hrpc_050, user_id).Scan(&status); err != nil || status != "0" {
+		return nil, oauthError("invalid_grant", "user is locked or deleted.")
+	}
+
+	access, err := jwt.GenClientToken(issuer, c.Client_id, user_id, domain_id, org_id, scope, session.access)
+	if err != nil {
+		logs.Error(err)
+		return nil, oauthError("server_error", "")
+	}
+	rst_tokens := &OidcTokens{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   session.access,
+		Scope:       scope,
+	}
+
+	if hasScope(scope, "openid") {
+		claims := jwt.IdClaims{
+			Nonce:     nonce,
+			AuthTime:  auth_time,
+			UserId:    user_id,
+			DomainId:  domain_id,
+			OrgUnitId: org_id,
+		}
+		if hasScope(scope, "profile") || hasScope(scope, "email") {
+			var name, email string
+			err = dbobj.QueryRow(sys_rdbms_hrpc_058, user_id).Scan(&name, &email)
+			if err != nil {
+				logs.Error(err)
+			}
+			if hasScope(scope, "profile") {
+				claims.Name = name
+			}
+			if hasScope(scope, "email") {
+				claims.Email = email
+			}
+		}
+		rst_tokens.IdToken, err = jwt.GenIdToken(issuer, c.Client_id, claims, session.access)
+		if err != nil {
+			logs.Error(err)
+			return nil, oauthError("server_error", "")
+		}
+	}
+	return rst_tokens, nil
+}
+
+// ClientCredentials 应用使用自己的身份获取访问token,token中的用户是应用编码
+func ClientCredentials(c *OauthClient, issuer, scope string) (*OidcTokens, *OauthError) {
+	if c.IsPublic() || !c.allowGrant(GrantClientCredentials) {
+		return nil, oauthError("unauthorized_client", "client can not use client credentials grant.")
+	}
+	scope, ok := c.checkScope(scope)
+	if !ok {
+		return nil, oauthError("invalid_scope", "scope is not allowed.")
+	}
+	access, err := jwt.GenClientToken(issuer, c.Client_id, c.Client_id, c.Domain_id, "", scope, session.access)
+	if err != nil {
+		logs.Error(err)
+		return nil, oauthError("server_error", "")
+	}
+	return &OidcTokens{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   session.access,
+		Scope:       scope,
+	}, nil
+}
+
+// OidcIssuer 返回issuer,没有配置时,使用请求的地址
+func OidcIssuer(r *http.Request) string {
+	if oidc.issuer != "" {
+		return oidc.issuer
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// OidcDiscovery /.well-known/openid-configuration 返回的内容
+type OidcDiscovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	JwksUri                           string   `json:"jwks_uri"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+func GetOidcDiscovery(issuer string) OidcDiscovery {
+	var algs []string
+	for _, k := range jwt.PublicKeys().Keys {
+		if !containsString(algs, k.Alg) {
+			algs = append(algs, k.Alg)
+		}
+	}
+	return OidcDiscovery{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/oauth2/authorize",
+		TokenEndpoint:                     issuer + "/oauth2/token",
+		JwksUri:                           issuer + "/oauth2/jwks",
+		IntrospectionEndpoint:             issuer + "/oauth2/introspect",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{GrantAuthorizationCode, GrantClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IdTokenSigningAlgValuesSupported:  algs,
+		ScopesSupported:                   OidcScopes,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported: []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce",
+			"UserId", "DomainId", "OrgUnitId", "name", "email"},
+	}
+}
+
+// 清理过期的授权码
+func purgeAuthCode() {
+	dbobj.Exec(sys_rdbms_hrpc_059, time.Now().Unix())
+}
+
+func (o *oidcConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read oidc config from app.conf failed, use default value.", err)
+		return
+	}
+	if issuer, err := conf.Get("Hauth.oidc.issuer"); err == nil {
+		o.issuer = strings.TrimRight(issuer, "/")
+	}
+	if n := confInt(conf, "Hauth.oidc.code.timeout", o.code_ttl); n > 0 {
+		o.code_ttl = n
+	}
+}
+
+func init() {
+	oidc.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,154 @@
+package hrpc
+
+import (
+	"net/http"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 机构范围
+// 授予角色时可以指定机构范围(org_scope),以及是否包含下级机构(org_scope_sub = '1'),
+// 分支机构的管理员只能查看与维护机构范围内的用户与机构.
+// 用户的机构范围是所有角色授权的机构范围的并集,
+// 用户是超级管理员,或者有任一角色授权没有指定机构范围时,不限制机构.
+// 机构范围只在域权限的基础上进一步限制,不会扩大域权限.
+type OrgScope struct {
+	// 不限制机构
+	All  bool
+	orgs map[string]bool
+}
+
+// Contains 判断机构是否在机构范围内
+func (s *OrgScope) Contains(org_id string) bool {
+	return s.All || s.orgs[org_id]
+}
+
+// ContainsUser 判断用户所属的机构是否在机构范围内
+func (s *OrgScope) ContainsUser(user_id string) bool {
+	if s.All {
+		return true
+	}
+	org_id := ""
+	if err := dbobj.QueryRow(sys_rdbms_hrpc_097, user_id).Scan(&org_id); err != nil {
+		logs.Error(err)
+		return false
+	}
+	return s.orgs[org_id]
+}
+
+// Covers 判断机构是否在机构范围内,sub为true时,所有下级机构也必须在机构范围内
+func (s *OrgScope) Covers(org_id string, sub bool) bool {
+	if s.All {
+		return true
+	}
+	if !s.orgs[org_id] {
+		return false
+	}
+	if !sub {
+		return true
+	}
+	list, err := SubOrgs(org_id)
+	if err != nil {
+		return false
+	}
+	for _, val := range list {
+		if !s.orgs[val] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetOrgScope 返回当前用户的机构范围
+func GetOrgScope(r *http.Request) (*OrgScope, error) {
+	jclaim, err := jwt.GetJwtClaims(r)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	return UserOrgScope(jclaim.UserId)
+}
+
+// UserOrgScope 返回用户的机构范围
+func UserOrgScope(user_id string) (*OrgScope, error) {
+	if IsSuperAdmin(user_id) {
+		return &OrgScope{All: true}, nil
+	}
+
+	rows, err := dbobj.Query(sys_rdbms_hrpc_095, user_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	scope := &OrgScope{orgs: make(map[string]bool)}
+	var subs []string
+	for rows.Next() {
+		var org_id, sub string
+		if err = rows.Scan(&org_id, &sub); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		if org_id == "" {
+			return &OrgScope{All: true}, nil
+		}
+		scope.orgs[org_id] = true
+		if sub == "1" {
+			subs = append(subs, org_id)
+		}
+	}
+
+	for _, org_id := range subs {
+		list, err := SubOrgs(org_id)
+		if err != nil {
+			return nil, err
+		}
+		for _, val := range list {
+			scope.orgs[val] = true
+		}
+	}
+	return scope, nil
+}
+
+// SubOrgs 返回机构以及所有下级机构的编码
+func SubOrgs(org_id string) ([]string, error) {
+	domain_id, err := utils.SplitDomain(org_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	rows, err := dbobj.Query(sys_rdbms_hrpc_096, domain_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	children := make(map[string][]string)
+	for rows.Next() {
+		var id, up_id string
+		if err = rows.Scan(&id, &up_id); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		children[up_id] = append(children[up_id], id)
+	}
+
+	// 按层次遍历下级机构,机构数据有环时每个机构只访问一次
+	rst := []string{org_id}
+	visited := map[string]bool{org_id: true}
+	for i := 0; i < len(rst); i++ {
+		for _, val := range children[rst[i]] {
+			if !visited[val] {
+				visited[val] = true
+				rst = append(rst, val)
+			}
+		}
+	}
+	return rst, nil
+}
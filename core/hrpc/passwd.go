@@ -2,7 +2,11 @@ package hrpc
 
 import (
 	"database/sql"
+	"time"
+
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
+	"github.com/hzwy23/hauth/utils/logs"
 )
 
 type mSysUserSec struct {
@@ -10,38 +14,124 @@ type mSysUserSec struct {
 	User_passwd             string        `json:"user_passwd"`
 	User_status             sql.NullInt64 `json:"user_status"`
 	User_continue_error_cnt sql.NullInt64
+	Passwd_time             sql.NullInt64
+	Force_change            sql.NullString
+	Lock_cnt                sql.NullInt64
+	Unlock_time             sql.NullInt64
 }
 
-// check user's passwd is right.
+// check user's passwd is right, and the passwd is not expired.
+// 密码正确,但是密码已经过期,或者管理员重置密码后还没有修改时,返回407,
+// 用户需要修改密码后才能登录系统.
 func CheckPasswd(user_id, user_passwd string) (bool, int, int64, string) {
-	var sec mSysUserSec
-	err := dbobj.QueryRow(sys_rdbms_hrpc_005, user_id).Scan(&sec.User_id, &sec.User_passwd, &sec.User_status, &sec.User_continue_error_cnt)
+	sec, ok, code, cnt, msg := verifyPasswd(user_id, user_passwd)
+	if !ok {
+		return ok, code, cnt, msg
+	}
+
+	if sec.Force_change.String == "1" {
+		return false, 407, 0, "error_passwd_must_change"
+	}
+
+	domain_id, err := GetDomainId(user_id)
 	if err != nil {
+		logs.Error(err)
 		return false, 402, 0, "error_querydb"
 	}
+	policy := GetPasswdPolicy(domain_id)
+	if policy.Max_age > 0 && sec.Passwd_time.Int64 > 0 &&
+		time.Now().Unix()-sec.Passwd_time.Int64 > int64(policy.Max_age)*86400 {
+		return false, 407, 0, "error_passwd_expired"
+	}
+	return true, 200, 0, ""
+}
+
+// VerifyPasswd 只校验用户密码是否正确,不检查密码是否过期
+// 用户修改已经过期的密码时,使用这个函数校验旧密码
+func VerifyPasswd(user_id, user_passwd string) (bool, int, int64, string) {
+	_, ok, code, cnt, msg := verifyPasswd(user_id, user_passwd)
+	return ok, code, cnt, msg
+}
+
+// user_passwd是用户输入的密码明文,
+// 校验成功后,如果数据库中保存的是旧的AES加密密码,重新计算摘要后保存.
+// 账号被锁定时返回403,第三个返回值是自动解锁的时间.
+func verifyPasswd(user_id, user_passwd string) (*mSysUserSec, bool, int, int64, string) {
+	sec, err := getUserSec(user_id)
+	if err != nil {
+		return nil, false, 402, 0, "error_querydb"
+	}
+	if code, cnt, msg := checkLocked(sec); code != 200 {
+		return nil, false, code, cnt, msg
+	}
+
+	ok, rehash := hpasswd.Verify(sec.User_passwd, user_passwd)
+	if sec.User_id == user_id && ok {
+		passwdSucceeded(sec)
+		if rehash {
+			rehashPasswd(user_id, user_passwd)
+		}
+		return sec, true, 200, 0, ""
+	}
+
+	code, cnt, msg := passwdFailed(sec)
+	return nil, false, code, cnt, msg
+}
+
+// 查询用户的密码与锁定信息,用户不存在时返回sql.ErrNoRows
+func getUserSec(user_id string) (*mSysUserSec, error) {
+	var sec mSysUserSec
+	err := dbobj.QueryRow(sys_rdbms_hrpc_005, user_id).Scan(&sec.User_id, &sec.User_passwd, &sec.User_status,
+		&sec.User_continue_error_cnt, &sec.Passwd_time, &sec.Force_change, &sec.Lock_cnt, &sec.Unlock_time)
+	if err != nil {
+		return nil, err
+	}
+	return &sec, nil
+}
 
+// 判断用户是否被锁定,管理员锁定时返回406,连续输错密码被锁定时返回403与自动解锁的时间
+func checkLocked(sec *mSysUserSec) (int, int64, string) {
 	if sec.User_status.Int64 != 0 {
-		return false, 406, sec.User_status.Int64, "error_maxerror"
+		return 406, sec.User_status.Int64, "error_user_admin_locked"
 	}
+	if sec.Unlock_time.Int64 > time.Now().Unix() {
+		return 403, sec.Unlock_time.Int64, "error_user_locked"
+	}
+	return 200, 0, ""
+}
 
-	if sec.User_continue_error_cnt.Int64 > 6 {
-		forbidUsers(user_id)
-		return false, 403, sec.User_continue_error_cnt.Int64, "error_maxerror"
+// 密码校验成功后,清除连续错误次数与锁定信息
+func passwdSucceeded(sec *mSysUserSec) {
+	if sec.User_continue_error_cnt.Int64 != 0 || sec.Lock_cnt.Int64 != 0 || sec.Unlock_time.Int64 != 0 {
+		resetLockout(sec.User_id)
 	}
+}
 
-	if sec.User_id == user_id && sec.User_passwd == user_passwd {
-		updateContinueErrorCnt(0, user_id)
-		return true, 200, 0, ""
-	} else {
-		updateContinueErrorCnt(sec.User_continue_error_cnt.Int64+1, user_id)
-		return false, 405, sec.User_continue_error_cnt.Int64 + 1, "error_password"
+// 记录一次密码错误,连续错误次数达到阈值时锁定用户并返回403与自动解锁的时间,
+// 否则返回405与连续错误次数
+func passwdFailed(sec *mSysUserSec) (int, int64, string) {
+	cnt := sec.User_continue_error_cnt.Int64 + 1
+	if cnt >= lockout.threshold {
+		unlock := lockUser(sec.User_id, sec.Lock_cnt.Int64+1)
+		return 403, unlock, "error_user_locked"
 	}
+	updateContinueErrorCnt(cnt, sec.User_id)
+	return 405, cnt, "error_password"
 }
 
 func updateContinueErrorCnt(cnt int64, user_id string) {
 	dbobj.Exec(sys_rdbms_hrpc_007, cnt, user_id)
 }
 
-func forbidUsers(user_id string) {
-	dbobj.Exec(sys_rdbms_hrpc_008, user_id)
+// 重新计算密码摘要,失败时不影响用户登录,下次登录时再次尝试
+func rehashPasswd(user_id, user_passwd string) {
+	hash, err := hpasswd.Hash(user_passwd)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	_, err = dbobj.Exec(sys_rdbms_hrpc_018, hash, user_id)
+	if err != nil {
+		logs.Error("rehash password failed, user id is:", user_id, err)
+	}
 }
@@ -0,0 +1,205 @@
+package hrpc
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 密码策略
+// 全局密码策略在app.conf中配置,域可以在sys_passwd_policy表中配置自己的密码策略,
+// 域配置了密码策略后,这个域中的用户使用域的密码策略,否则使用全局密码策略.
+//
+// Min_length      密码最小长度
+// Require_upper   必须包含大写字母
+// Require_lower   必须包含小写字母
+// Require_digit   必须包含数字
+// Require_special 必须包含特殊字符
+// Forbid_user_id  密码中不能包含用户账号
+// History_cnt     新密码不能与最近History_cnt次使用过的密码相同,0表示不限制
+// Max_age         密码最长有效天数,过期后登录时必须修改密码,0表示永不过期
+// Reset_change    管理员重置密码后,用户下次登录时必须修改密码
+type PasswdPolicy struct {
+	Domain_id       string `json:"domain_id"`
+	Min_length      int    `json:"min_length"`
+	Require_upper   bool   `json:"require_upper"`
+	Require_lower   bool   `json:"require_lower"`
+	Require_digit   bool   `json:"require_digit"`
+	Require_special bool   `json:"require_special"`
+	Forbid_user_id  bool   `json:"forbid_user_id"`
+	History_cnt     int    `json:"history_cnt"`
+	Max_age         int    `json:"max_age"`
+	Reset_change    bool   `json:"reset_change"`
+}
+
+var globalPasswdPolicy = PasswdPolicy{
+	Min_length: 6,
+}
+
+// GetGlobalPasswdPolicy 返回app.conf中配置的全局密码策略
+func GetGlobalPasswdPolicy() PasswdPolicy {
+	return globalPasswdPolicy
+}
+
+// GetPasswdPolicy 返回域的密码策略,域没有配置密码策略时,返回全局密码策略
+func GetPasswdPolicy(domain_id string) PasswdPolicy {
+	var p PasswdPolicy
+	var upper, lower, digit, special, forbid, reset string
+	err := dbobj.QueryRow(sys_rdbms_hrpc_019, domain_id).Scan(&p.Min_length, &upper, &lower, &digit,
+		&special, &forbid, &p.History_cnt, &p.Max_age, &reset)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			logs.Error(err)
+		}
+		p = globalPasswdPolicy
+		p.Domain_id = domain_id
+		return p
+	}
+	p.Domain_id = domain_id
+	p.Require_upper = upper == "1"
+	p.Require_lower = lower == "1"
+	p.Require_digit = digit == "1"
+	p.Require_special = special == "1"
+	p.Forbid_user_id = forbid == "1"
+	p.Reset_change = reset == "1"
+	return p
+}
+
+// CheckPasswdPolicy 校验新密码是否满足域的密码策略
+// 返回值中的string是错误信息的i18n编码
+func CheckPasswdPolicy(domain_id, user_id, passwd string) (string, error) {
+	p := GetPasswdPolicy(domain_id)
+
+	if len(passwd) < p.Min_length {
+		return "error_passwd_policy_length", errors.New("password is shorter than " + strconv.Itoa(p.Min_length))
+	}
+	if len(passwd) > hpasswd.MaxLength {
+		return "error_passwd_policy_too_long", errors.New("password is longer than " + strconv.Itoa(hpasswd.MaxLength) + " bytes")
+	}
+
+	var upper, lower, digit, special bool
+	for _, c := range passwd {
+		switch {
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsDigit(c):
+			digit = true
+		default:
+			special = true
+		}
+	}
+	if p.Require_upper && !upper {
+		return "error_passwd_policy_upper", errors.New("password must contain upper case letter")
+	}
+	if p.Require_lower && !lower {
+		return "error_passwd_policy_lower", errors.New("password must contain lower case letter")
+	}
+	if p.Require_digit && !digit {
+		return "error_passwd_policy_digit", errors.New("password must contain digit")
+	}
+	if p.Require_special && !special {
+		return "error_passwd_policy_special", errors.New("password must contain special character")
+	}
+
+	if p.Forbid_user_id && user_id != "" && strings.Contains(strings.ToLower(passwd), strings.ToLower(user_id)) {
+		return "error_passwd_policy_user_id", errors.New("password can not contain user id")
+	}
+
+	if p.History_cnt > 0 && usedPasswd(user_id, passwd, p.History_cnt) {
+		return "error_passwd_policy_history", errors.New("password was used recently")
+	}
+	return "success", nil
+}
+
+// 判断密码是否与当前密码,或者最近cnt次使用过的密码相同
+func usedPasswd(user_id, passwd string, cnt int) bool {
+	var current string
+	err := dbobj.QueryRow(sys_rdbms_hrpc_023, user_id).Scan(&current)
+	if err == nil {
+		if ok, _ := hpasswd.Verify(current, passwd); ok {
+			return true
+		}
+	}
+
+	rows, err := dbobj.Query(sys_rdbms_hrpc_020, user_id)
+	if err != nil {
+		logs.Error(err)
+		return false
+	}
+	defer rows.Close()
+	for i := 0; i < cnt && rows.Next(); i++ {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			logs.Error(err)
+			return false
+		}
+		if ok, _ := hpasswd.Verify(hash, passwd); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPasswd 保存用户的新密码,并记录到密码历史中
+// passwd是密码明文,force_change为true时,用户下次登录时必须修改密码
+func SetPasswd(user_id, passwd string, force_change bool) error {
+	hash, err := hpasswd.Hash(passwd)
+	if err != nil {
+		return err
+	}
+	force := "0"
+	if force_change {
+		force = "1"
+	}
+	now := time.Now().Unix()
+	_, err = dbobj.Exec(sys_rdbms_hrpc_021, hash, now, force, user_id)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	return SavePasswdHistory(user_id, hash)
+}
+
+// SavePasswdHistory 记录用户使用过的密码摘要
+func SavePasswdHistory(user_id, hash string) error {
+	_, err := dbobj.Exec(sys_rdbms_hrpc_022, user_id, hash, time.Now().Unix())
+	if err != nil {
+		logs.Error(err)
+	}
+	return err
+}
+
+// 从app.conf中读取全局密码策略,没有配置时使用默认值
+func loadPasswdPolicy(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read password policy from app.conf failed, use default value.", err)
+		return
+	}
+	p := &globalPasswdPolicy
+	p.Min_length = int(confInt(conf, "Hauth.passwd.min.length", int64(p.Min_length)))
+	p.Require_upper = confBool(conf, "Hauth.passwd.require.upper", p.Require_upper)
+	p.Require_lower = confBool(conf, "Hauth.passwd.require.lower", p.Require_lower)
+	p.Require_digit = confBool(conf, "Hauth.passwd.require.digit", p.Require_digit)
+	p.Require_special = confBool(conf, "Hauth.passwd.require.special", p.Require_special)
+	p.Forbid_user_id = confBool(conf, "Hauth.passwd.forbid.userid", p.Forbid_user_id)
+	p.History_cnt = int(confInt(conf, "Hauth.passwd.history", int64(p.History_cnt)))
+	p.Max_age = int(confInt(conf, "Hauth.passwd.max.age", int64(p.Max_age)))
+	p.Reset_change = confBool(conf, "Hauth.passwd.reset.change", p.Reset_change)
+}
+
+func init() {
+	loadPasswdPolicy(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,164 @@
+package hrpc
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/mailer"
+)
+
+// 找回密码
+// 用户忘记密码时,输入账号申请重置密码,系统向用户的邮箱发送重置密码链接,
+// 链接中的重置token只能使用一次,有效期为ttl秒,数据库中只保存token的摘要.
+// 用户使用token设置新密码后,用户所有未使用的重置token,访问token与刷新token全部失效.
+//
+// 无论账号是否存在,是否配置了邮箱,申请重置密码都返回成功,并在后台发送邮件,
+// 避免通过返回结果或者响应时间判断账号是否存在.
+// 管理员锁定的用户,服务账号,通过LDAP自动创建的用户不能找回密码.
+//
+// 重置密码链接使用配置的系统地址(Hauth.passwd.reset.url)生成,不使用请求中的Host,
+// 避免攻击者伪造Host,让用户收到指向其他网站的有效重置链接. 没有配置系统地址时不能找回密码.
+type passwdResetConfig struct {
+	ttl int64
+	// 系统地址,例如 https://hauth.example.com
+	base string
+}
+
+var passwdReset = &passwdResetConfig{
+	ttl: 1800,
+}
+
+// PasswdResetEnabled 是否配置了邮件服务器与系统地址,没有配置时不能找回密码
+func PasswdResetEnabled() bool {
+	return passwdReset.base != "" && getMailer() != nil
+}
+
+// RequestPasswdReset 申请重置密码,向用户的邮箱发送重置密码链接
+func RequestPasswdReset(r *http.Request, user_id string) (string, error) {
+	if !PasswdResetEnabled() {
+		return "error_passwd_reset_disabled", errors.New("mailer or reset url is not configured, password reset is disabled.")
+	}
+
+	var status_id, account_type, user_owner, email, domain_id string
+	err := dbobj.QueryRow(sys_rdbms_hrpc_080, user_id).Scan(&status_id, &account_type, &user_owner, &email, &domain_id)
+	if err != nil {
+		logs.Warn("password reset is requested for unknown user:", user_id)
+		return "success", nil
+	}
+	if status_id != "0" || account_type != AccountTypeUser || user_owner == ldapUserOwner || email == "" {
+		logs.Warn("user can not reset password by email, user id is:", user_id)
+		return "success", nil
+	}
+
+	token, err := genRefreshToken()
+	if err != nil {
+		logs.Error(err)
+		return "error_passwd_reset", err
+	}
+	now := time.Now().Unix()
+	_, err = dbobj.Exec(sys_rdbms_hrpc_081, hashRefreshToken(token), user_id, ClientIP(r), now, now+passwdReset.ttl)
+	if err != nil {
+		logs.Error(err)
+		return "error_passwd_reset", err
+	}
+
+	link := passwdReset.base + "/?reset_token=" + url.QueryEscape(token)
+	msg := &mailer.Message{
+		To:      []string{email},
+		Subject: "重置密码",
+		Body: "您好 " + user_id + ",\r\n\r\n" +
+			"我们收到了重置您账号密码的申请,请在" + strconv.FormatInt(passwdReset.ttl/60, 10) + "分钟内打开下面的链接设置新密码,链接只能使用一次:\r\n\r\n" +
+			link + "\r\n\r\n" +
+			"如果这不是您本人的操作,请忽略这封邮件,您的密码不会被修改.\r\n",
+	}
+	go func() {
+		if err := sendMail(msg); err != nil {
+			logs.Error("send password reset mail failed, user id is:", user_id, err)
+		}
+	}()
+	logs.Info("password reset is requested, user id is:", user_id)
+	return "success", nil
+}
+
+// ResetPasswd 使用重置token设置新密码,新密码必须满足域的密码策略
+func ResetPasswd(token, passwd string) (string, error) {
+	hash := hashRefreshToken(token)
+	var user_id string
+	var expire_time int64
+	err := dbobj.QueryRow(sys_rdbms_hrpc_082, hash).Scan(&user_id, &expire_time)
+	if err != nil || expire_time <= time.Now().Unix() {
+		return "error_passwd_reset_token", errors.New("password reset token is invalid or expired.")
+	}
+
+	var status_id, account_type, user_owner, email, domain_id string
+	err = dbobj.QueryRow(sys_rdbms_hrpc_080, user_id).Scan(&status_id, &account_type, &user_owner, &email, &domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_passwd_reset_token", err
+	}
+	if status_id != "0" {
+		return "error_user_admin_locked", errors.New("user is locked.")
+	}
+
+	if msg, err := CheckPasswdPolicy(domain_id, user_id, passwd); err != nil {
+		return msg, err
+	}
+
+	// 先删除token,删除成功才能使用,同一个token并发提交时只有一个请求能够成功
+	rst, err := dbobj.Exec(sys_rdbms_hrpc_083, hash)
+	if err != nil {
+		logs.Error(err)
+		return "error_passwd_reset", err
+	}
+	if cnt, err := rst.RowsAffected(); err != nil || cnt != 1 {
+		return "error_passwd_reset_token", errors.New("password reset token has been used.")
+	}
+
+	if err = SetPasswd(user_id, passwd, false); err != nil {
+		return "error_passwd_reset", err
+	}
+	if _, err = dbobj.Exec(sys_rdbms_hrpc_084, user_id); err != nil {
+		logs.Error(err)
+	}
+	resetLockout(user_id)
+	RevokeUserTokens(user_id, user_id)
+	logs.Info("password is reset by email, user id is:", user_id)
+	return "success", nil
+}
+
+// 清理过期的重置token
+func purgePasswdReset() {
+	dbobj.Exec(sys_rdbms_hrpc_085, time.Now().Unix())
+}
+
+func (p *passwdResetConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read password reset config from app.conf failed, use default value.", err)
+		return
+	}
+	if n := confInt(conf, "Hauth.passwd.reset.timeout", p.ttl); n > 0 {
+		p.ttl = n
+	}
+	if base, err := conf.Get("Hauth.passwd.reset.url"); err == nil && base != "" {
+		u, err := url.Parse(base)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			logs.Error("Hauth.passwd.reset.url must be an absolute http or https url, password reset is disabled. url is:", base)
+			return
+		}
+		p.base = strings.TrimRight(base, "/")
+	}
+}
+
+func init() {
+	passwdReset.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,344 @@
+package hrpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/groupcache"
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/routematch"
+)
+
+// 权限缓存
+// BasicAuth 校验用户是否有权限访问API时,缓存用户可以访问的API列表,不再每次查询数据库.
+// API列表中的方法集合与路径模式编译成routematch.Table后缓存,请求时只做匹配.
+//
+// 角色授权,用户角色,用户主题,资源与主题配置发生变化时,调用InvalidatePermissions清除缓存,
+// 同时更新数据库中的权限版本号(sys_perm_version),其他实例定时同步版本号,版本号变化时清除所有缓存.
+// 缓存项超过ttl秒后也会重新加载,用于兜底直接修改数据库等没有通知缓存的情况.
+//
+// 配置了Hauth.permcache.peers时,通过groupcache在多个实例之间共享缓存,
+// 缓存的key带有权限版本号,版本号变化后不会再读取旧的缓存.
+//
+// 用户是否是超级管理员也缓存在API列表中,使用superAdminKey表示.
+// 在groupcache中,API列表每行是 资源编码\t方法集合\t路径模式.
+const (
+	permCacheGroup = "HAUTHPERMISSIONS"
+	superAdminKey  = "*"
+)
+
+type permCacheConfig struct {
+	enable bool
+	ttl    int64
+	// groupcache中本实例的地址,例如 http://10.0.0.1:8080
+	self  string
+	peers []string
+}
+
+var permCacheConf = &permCacheConfig{
+	enable: true,
+	ttl:    300,
+}
+
+type permEntry struct {
+	routes *userRoutes
+	expire int64
+}
+
+// 用户可以访问的API
+type userRoutes struct {
+	super bool
+	table *routematch.Table
+}
+
+// 判断是否可以访问请求的方法与路径,超级管理员可以访问所有的API
+func (u *userRoutes) allow(method, path string) bool {
+	return u.super || u.table.Allow(method, path)
+}
+
+// 把API列表编译成userRoutes,方法集合或者路径模式错误的API会被忽略
+func newUserRoutes(list []string) *userRoutes {
+	u := &userRoutes{table: routematch.NewTable()}
+	for _, line := range list {
+		if line == superAdminKey {
+			u.super = true
+			continue
+		}
+		val := strings.SplitN(line, "\t", 3)
+		if len(val) != 3 {
+			continue
+		}
+		if err := u.table.Add(val[0], val[1], val[2]); err != nil {
+			logs.Warn("invalid api route, resource id is:", val[0], ", method is:", val[1], ", url is:", val[2], err)
+		}
+	}
+	return u
+}
+
+var permCache = struct {
+	lock    sync.RWMutex
+	list    map[string]*permEntry
+	version int64
+	// 每次清除缓存时加1,用于判断加载期间缓存是否被清除
+	gen   int64
+	group *groupcache.Group
+	pool  *groupcache.HTTPPool
+}{list: make(map[string]*permEntry)}
+
+// 命中,未命中,清除次数与从groupcache加载的次数
+var permStats struct {
+	hits          int64
+	misses        int64
+	invalidations int64
+	peer_loads    int64
+}
+
+// PermCacheStats 权限缓存的统计信息
+type PermCacheStats struct {
+	Enable        bool     `json:"enable"`
+	Size          int      `json:"size"`
+	Version       int64    `json:"version"`
+	Hits          int64    `json:"hits"`
+	Misses        int64    `json:"misses"`
+	Hit_ratio     float64  `json:"hit_ratio"`
+	Invalidations int64    `json:"invalidations"`
+	Peer_loads    int64    `json:"peer_loads"`
+	Ttl           int64    `json:"ttl"`
+	Peers         []string `json:"peers"`
+}
+
+// GetPermCacheStats 返回权限缓存的统计信息
+func GetPermCacheStats() PermCacheStats {
+	permCache.lock.RLock()
+	size, version := len(permCache.list), permCache.version
+	permCache.lock.RUnlock()
+
+	rst := PermCacheStats{
+		Enable:        permCacheConf.enable,
+		Size:          size,
+		Version:       version,
+		Hits:          atomic.LoadInt64(&permStats.hits),
+		Misses:        atomic.LoadInt64(&permStats.misses),
+		Invalidations: atomic.LoadInt64(&permStats.invalidations),
+		Peer_loads:    atomic.LoadInt64(&permStats.peer_loads),
+		Ttl:           permCacheConf.ttl,
+		Peers:         permCacheConf.peers,
+	}
+	if total := rst.Hits + rst.Misses; total > 0 {
+		rst.Hit_ratio = float64(rst.Hits) / float64(total)
+	}
+	return rst
+}
+
+// 返回用户可以访问的API
+func userPermissions(user_id string) (*userRoutes, error) {
+	if !permCacheConf.enable {
+		list, err := queryRoutes(user_id)
+		if err != nil {
+			return nil, err
+		}
+		return newUserRoutes(list), nil
+	}
+
+	now := time.Now().Unix()
+	permCache.lock.RLock()
+	entry, ok := permCache.list[user_id]
+	version, gen := permCache.version, permCache.gen
+	permCache.lock.RUnlock()
+	if ok && entry.expire > now {
+		atomic.AddInt64(&permStats.hits, 1)
+		return entry.routes, nil
+	}
+
+	atomic.AddInt64(&permStats.misses, 1)
+	list, err := loadPermissions(user_id, version)
+	if err != nil {
+		return nil, err
+	}
+	routes := newUserRoutes(list)
+	permCache.lock.Lock()
+	// 加载期间缓存被清除,不保存加载的结果
+	if permCache.gen == gen {
+		permCache.list[user_id] = &permEntry{routes: routes, expire: now + permCacheConf.ttl}
+	}
+	permCache.lock.Unlock()
+	return routes, nil
+}
+
+// 加载用户可以访问的API列表,配置了groupcache时从groupcache中读取
+func loadPermissions(user_id string, version int64) ([]string, error) {
+	if permCache.group == nil {
+		return queryRoutes(user_id)
+	}
+
+	var val string
+	err := permCache.group.Get(context.Background(), strconv.FormatInt(version, 10)+":"+user_id, groupcache.StringSink(&val))
+	if err != nil {
+		logs.Warn("load permissions from groupcache failed, query database.", err)
+		return queryRoutes(user_id)
+	}
+	atomic.AddInt64(&permStats.peer_loads, 1)
+	var list []string
+	for _, line := range strings.Split(val, "\n") {
+		if line != "" {
+			list = append(list, line)
+		}
+	}
+	return list, nil
+}
+
+// 查询用户可以访问的API列表,用户是超级管理员时,列表中包含superAdminKey
+func queryRoutes(user_id string) ([]string, error) {
+	rows, err := dbobj.Query(sys_rdbms_hrpc_088, user_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []string
+	for rows.Next() {
+		var res_id, res_method, res_url string
+		if err = rows.Scan(&res_id, &res_method, &res_url); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		list = append(list, res_id+"\t"+res_method+"\t"+res_url)
+	}
+
+	cnt := 0
+	if err = dbobj.QueryRow(sys_rdbms_hrpc_091, user_id).Scan(&cnt); err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	if cnt > 0 {
+		list = append(list, superAdminKey)
+	}
+	return list, nil
+}
+
+// InvalidatePermissions 用户的权限发生变化后,清除权限缓存
+// 没有指定用户时,清除所有用户的缓存,例如角色的资源发生变化,会影响拥有这个角色的所有用户
+func InvalidatePermissions(user_id ...string) {
+	atomic.AddInt64(&permStats.invalidations, 1)
+
+	// 通知其他实例
+	_, err := dbobj.Exec(sys_rdbms_hrpc_089)
+	if err != nil {
+		logs.Error(err)
+	}
+	var version int64
+	if err == nil {
+		err = dbobj.QueryRow(sys_rdbms_hrpc_090).Scan(&version)
+	}
+
+	permCache.lock.Lock()
+	defer permCache.lock.Unlock()
+	permCache.gen++
+	// 版本号只增加了1,说明期间没有其他实例修改权限,只清除指定用户的缓存
+	if err != nil || len(user_id) == 0 || version != permCache.version+1 {
+		permCache.list = make(map[string]*permEntry)
+	} else {
+		for _, u := range user_id {
+			delete(permCache.list, u)
+		}
+	}
+	if err == nil {
+		permCache.version = version
+	}
+}
+
+// 同步数据库中的权限版本号,版本号变化时清除所有缓存
+func syncPermVersion() {
+	var version int64
+	if err := dbobj.QueryRow(sys_rdbms_hrpc_090).Scan(&version); err != nil {
+		logs.Error(err)
+		return
+	}
+	permCache.lock.Lock()
+	defer permCache.lock.Unlock()
+	if version != permCache.version {
+		permCache.version = version
+		permCache.gen++
+		permCache.list = make(map[string]*permEntry)
+	}
+}
+
+// PermCachePeerHandler 返回groupcache实例之间通信的接口,没有配置peers时返回nil
+// 只接受peers中的地址发起的请求
+func PermCachePeerHandler() http.Handler {
+	if permCache.pool == nil {
+		return nil
+	}
+	allowed := make(map[string]bool)
+	for _, p := range permCacheConf.peers {
+		host := p
+		if i := strings.Index(host, "://"); i >= 0 {
+			host = host[i+3:]
+		}
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		allowed[host] = true
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil || !allowed[host] {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		permCache.pool.ServeHTTP(w, r)
+	})
+}
+
+func (p *permCacheConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read permission cache config from app.conf failed, use default value.", err)
+		return
+	}
+	p.enable = confBool(conf, "Hauth.permcache.enable", p.enable)
+	if n := confInt(conf, "Hauth.permcache.ttl", p.ttl); n > 0 {
+		p.ttl = n
+	}
+	if self, err := conf.Get("Hauth.permcache.self"); err == nil {
+		p.self = strings.TrimRight(self, "/")
+	}
+	if peers, err := conf.Get("Hauth.permcache.peers"); err == nil {
+		for _, peer := range strings.Split(peers, ",") {
+			if peer = strings.TrimRight(strings.TrimSpace(peer), "/"); peer != "" {
+				p.peers = append(p.peers, peer)
+			}
+		}
+	}
+}
+
+func init() {
+	permCacheConf.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+	if !permCacheConf.enable || permCacheConf.self == "" || len(permCacheConf.peers) == 0 {
+		return
+	}
+
+	permCache.pool = groupcache.NewHTTPPoolOpts(permCacheConf.self, &groupcache.HTTPPoolOptions{BasePath: "/_groupcache/"})
+	permCache.pool.Set(permCacheConf.peers...)
+	permCache.group = groupcache.NewGroup(permCacheGroup, 16<<20, groupcache.GetterFunc(func(ctx context.Context, key string, dest groupcache.Sink) error {
+		// key是 版本号:用户
+		user_id := key[strings.Index(key, ":")+1:]
+		list, err := queryRoutes(user_id)
+		if err != nil {
+			return err
+		}
+		return dest.SetBytes([]byte(strings.Join(list, "\n")))
+	}))
+	logs.Info("permission cache is shared with peers:", permCacheConf.peers)
+}
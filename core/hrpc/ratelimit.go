@@ -0,0 +1,187 @@
+package hrpc
+
+import (
+	"database/sql"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 登录限流
+// 使用令牌桶算法,分别按照客户端IP与用户账号限制登录请求的频率.
+// 每个桶最多保存capacity个令牌,每分钟补充rate个令牌,每次登录请求消耗一个令牌,
+// 桶中没有令牌时拒绝请求,并返回需要等待的秒数.
+//
+// 令牌桶保存在sys_login_throttle表中,多个实例共享同一份限流状态,
+// 令牌数量放大1000倍后以整数保存.
+const throttleScale = 1000
+
+type tokenBucket struct {
+	capacity int64
+	rate     int64
+}
+
+type rateLimitConfig struct {
+	enable      bool
+	trust_proxy bool
+	ip          tokenBucket
+	user        tokenBucket
+}
+
+var loginLimit = &rateLimitConfig{
+	enable: true,
+	ip:     tokenBucket{capacity: 30, rate: 30},
+	user:   tokenBucket{capacity: 10, rate: 5},
+}
+
+// AllowLogin 判断是否允许这次登录请求,
+// 不允许时,第二个返回值是客户端需要等待的秒数.
+// 先检查客户端IP,再检查用户账号,IP被限流时不消耗账号的令牌.
+func AllowLogin(ip, user_id string) (bool, int64) {
+	if !loginLimit.enable {
+		return true, 0
+	}
+	if ok, retry := takeToken("ip:"+ip, loginLimit.ip); !ok {
+		logs.Warn("login is throttled, client ip is:", ip)
+		return false, retry
+	}
+	if user_id == "" {
+		return true, 0
+	}
+	if ok, retry := takeToken("user:"+user_id, loginLimit.user); !ok {
+		logs.Warn("login is throttled, user id is:", user_id)
+		return false, retry
+	}
+	return true, 0
+}
+
+// ClientIP 返回客户端IP
+// 只有配置了Hauth.ratelimit.trust.proxy时才读取X-Forwarded-For头部,
+// 否则客户端可以伪造这个头部绕过限流.
+func ClientIP(r *http.Request) string {
+	if loginLimit.trust_proxy {
+		if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+			return strings.TrimSpace(strings.Split(fwd, ",")[0])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// 新建令牌桶冲突时重试的次数
+const throttleRetry = 3
+
+var errThrottleInsert = errors.New("insert throttle bucket failed")
+
+// 从令牌桶中取出一个令牌
+// 数据库操作失败时拒绝登录请求,限流表异常时不能绕过限流.
+// 并发请求同时新建同一个桶时,插入失败的请求重新锁定已经插入的桶再取令牌.
+func takeToken(key string, b tokenBucket) (bool, int64) {
+	for i := 0; i < throttleRetry; i++ {
+		ok, retry, err := tryTakeToken(key, b)
+		if err == errThrottleInsert {
+			continue
+		}
+		if err != nil {
+			logs.Error(err)
+			return false, 1
+		}
+		return ok, retry
+	}
+	logs.Error("take token from throttle bucket failed, key is:", key)
+	return false, 1
+}
+
+func tryTakeToken(key string, b tokenBucket) (bool, int64, error) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	capacity := b.capacity * throttleScale
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		return false, 0, err
+	}
+
+	var tokens, update int64
+	err = tx.QueryRow(sys_rdbms_hrpc_040, key).Scan(&tokens, &update)
+	if err == sql.ErrNoRows {
+		_, err = tx.Exec(sys_rdbms_hrpc_041, key, capacity-throttleScale, now)
+		if err != nil {
+			// 并发请求同时插入同一个桶,重试
+			tx.Rollback()
+			logs.Warn("insert throttle bucket failed, retry. key is:", key, ", error is:", err)
+			return false, 0, errThrottleInsert
+		}
+		return true, 0, tx.Commit()
+	} else if err != nil {
+		tx.Rollback()
+		return false, 0, err
+	}
+
+	// 补充令牌, rate是每分钟补充的令牌数
+	if now > update {
+		tokens += (now - update) * b.rate * throttleScale / 60000
+	}
+	if tokens > capacity {
+		tokens = capacity
+	}
+
+	if tokens < throttleScale {
+		tx.Rollback()
+		wait := (throttleScale - tokens) * 60 / (b.rate * throttleScale)
+		return false, wait + 1, nil
+	}
+
+	_, err = tx.Exec(sys_rdbms_hrpc_042, tokens-throttleScale, now, key)
+	if err != nil {
+		tx.Rollback()
+		return false, 0, err
+	}
+	return true, 0, tx.Commit()
+}
+
+// 桶中的令牌补满后,记录与新建的桶相同,可以删除
+func purgeThrottle() {
+	full := loginLimit.ip.capacity * 60 / loginLimit.ip.rate
+	if n := loginLimit.user.capacity * 60 / loginLimit.user.rate; n > full {
+		full = n
+	}
+	dbobj.Exec(sys_rdbms_hrpc_043, (time.Now().Unix()-full-1)*1000)
+}
+
+// 从app.conf中读取登录限流配置,没有配置时使用默认值
+func (l *rateLimitConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read login rate limit from app.conf failed, use default value.", err)
+		return
+	}
+
+	get := func(key string, def int64) int64 {
+		if n := confInt(conf, key, def); n > 0 {
+			return n
+		}
+		return def
+	}
+
+	l.enable = confBool(conf, "Hauth.ratelimit.enable", l.enable)
+	l.trust_proxy = confBool(conf, "Hauth.ratelimit.trust.proxy", l.trust_proxy)
+	l.ip.capacity = get("Hauth.ratelimit.ip.capacity", l.ip.capacity)
+	l.ip.rate = get("Hauth.ratelimit.ip.rate", l.ip.rate)
+	l.user.capacity = get("Hauth.ratelimit.user.capacity", l.user.capacity)
+	l.user.rate = get("Hauth.ratelimit.user.rate", l.user.rate)
+}
+
+func init() {
+	loginLimit.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,289 @@
+package hrpc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/uuid"
+)
+
+// 刷新token
+// 用户登录后,签发一个短期有效的访问token和一个刷新token,
+// 访问token过期前,客户端使用刷新token换取新的访问token与刷新token,旧的刷新token随即失效.
+// 同一次登录产生的所有刷新token属于同一个family,
+// 如果已经使用过的刷新token被再次提交,说明刷新token已经泄露,整个family全部吊销.
+//
+// 数据库中只保存刷新token的sha256摘要,不保存明文.
+//
+// status = 0 有效
+// status = 1 已经使用过,被新的刷新token替换
+// status = 2 已吊销
+const (
+	refreshStatusValid   = "0"
+	refreshStatusRotated = "1"
+	refreshStatusRevoked = "2"
+)
+
+// 会话超时时间,单位:秒
+// access   访问token有效期
+// idle     空闲超时,超过这个时间没有刷新token,需要重新登录
+// absolute 绝对超时,从登录开始计算,超过这个时间,无论是否活跃,都需要重新登录
+// max_count 每个用户最多同时存在的会话数量,超过时结束最早的会话,0表示不限制
+type sessionConfig struct {
+	access    int64
+	idle      int64
+	absolute  int64
+	max_count int64
+}
+
+var session = &sessionConfig{
+	access:   900,
+	idle:     1800,
+	absolute: 86400,
+}
+
+type TokenPair struct {
+	AccessToken   string `json:"access_token"`
+	RefreshToken  string `json:"refresh_token"`
+	AccessMaxAge  int64  `json:"expires_in"`
+	RefreshMaxAge int64  `json:"refresh_expires_in"`
+	CsrfToken     string `json:"csrf_token"`
+}
+
+type refreshToken struct {
+	family_id    string
+	user_id      string
+	domain_id    string
+	org_unit_id  string
+	status       string
+	session_time int64
+	expire_time  int64
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func genRefreshToken() (string, error) {
+	buf := make([]byte, 32)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// 签发访问token,并在family中新增一个刷新token
+// 刷新token的过期时间取空闲超时与绝对超时中较早的一个
+func issueTokens(family_id, user_id, domain_id, org_id string, session_time int64) (*TokenPair, error) {
+	now := time.Now().Unix()
+	expire := now + session.idle
+	if expire > session_time+session.absolute {
+		expire = session_time + session.absolute
+	}
+
+	refresh, err := genRefreshToken()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	_, err = dbobj.Exec(sys_rdbms_hrpc_012, hashRefreshToken(refresh), family_id, user_id, domain_id, org_id, refreshStatusValid, session_time, expire, now)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	// 每次签发访问token时,重新生成CSRF token
+	csrf, err := genRefreshToken()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	// 每次签发访问token时,重新查询用户的授权信息
+	user_grants, err := LoadGrants(user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	access := jwt.GenSessionToken(user_id, domain_id, org_id, family_id, sha256Hex(csrf), user_grants, session.access)
+	if access == "" {
+		return nil, errors.New("generate access token failed.")
+	}
+
+	return &TokenPair{
+		AccessToken:   access,
+		RefreshToken:  refresh,
+		AccessMaxAge:  session.access,
+		RefreshMaxAge: expire - now,
+		CsrfToken:     csrf,
+	}, nil
+}
+
+// IssueTokens 用户登录成功后,签发访问token与刷新token,并记录登录会话
+func IssueTokens(r *http.Request, user_id, domain_id, org_id string) (*TokenPair, error) {
+	family_id, now := uuid.GenUUID(), time.Now().Unix()
+	pair, err := issueTokens(family_id, user_id, domain_id, org_id, now)
+	if err != nil {
+		return nil, err
+	}
+	startSession(r, family_id, user_id, domain_id, now, now+pair.RefreshMaxAge)
+	return pair, nil
+}
+
+// RefreshTokens 使用刷新token换取新的访问token与刷新token
+// 返回值中的string是错误信息的i18n编码
+func RefreshTokens(refresh string) (*TokenPair, string, error) {
+	if refresh == "" {
+		return nil, "error_refresh_token_invalid", errors.New("refresh token is empty.")
+	}
+
+	hash := hashRefreshToken(refresh)
+	var rt refreshToken
+	err := dbobj.QueryRow(sys_rdbms_hrpc_013, hash).Scan(&rt.family_id, &rt.user_id, &rt.domain_id,
+		&rt.org_unit_id, &rt.status, &rt.session_time, &rt.expire_time)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_refresh_token_invalid", err
+	}
+
+	switch rt.status {
+	case refreshStatusValid:
+	case refreshStatusRotated:
+		// 已经使用过的刷新token被再次提交,吊销整个family
+		logs.Warn("refresh token reused, revoke token family. user is:", rt.user_id, ", family is:", rt.family_id)
+		revokeTokenFamily(&rt)
+		return nil, "error_refresh_token_reused", errors.New("refresh token has been used.")
+	default:
+		return nil, "error_refresh_token_invalid", errors.New("refresh token has been revoked.")
+	}
+
+	now := time.Now().Unix()
+	if rt.expire_time <= now || rt.session_time+session.absolute <= now {
+		return nil, "error_refresh_token_expired", errors.New("refresh token is expired.")
+	}
+
+	// 用户被禁用或者锁定后,不能继续刷新token
+	sec, err := getUserSec(rt.user_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_querydb", err
+	}
+	if code, _, msg := checkLocked(sec); code != 200 {
+		return nil, msg, errors.New("user is locked, user id is: " + rt.user_id)
+	}
+
+	// 只有状态仍然是有效的刷新token才能被替换,
+	// 并发提交同一个刷新token时,只有一个请求能够成功,其余请求视为重放.
+	rst, err := dbobj.Exec(sys_rdbms_hrpc_014, refreshStatusRotated, hash, refreshStatusValid)
+	if err != nil {
+		logs.Error(err)
+		return nil, "error_refresh_token_invalid", err
+	}
+	if cnt, err := rst.RowsAffected(); err != nil || cnt != 1 {
+		logs.Warn("refresh token reused, revoke token family. user is:", rt.user_id, ", family is:", rt.family_id)
+		revokeTokenFamily(&rt)
+		return nil, "error_refresh_token_reused", errors.New("refresh token has been used.")
+	}
+
+	pair, err := issueTokens(rt.family_id, rt.user_id, rt.domain_id, rt.org_unit_id, rt.session_time)
+	if err != nil {
+		return nil, "error_refresh_token_issue", err
+	}
+	if _, err = dbobj.Exec(sys_rdbms_hrpc_073, now, now+pair.RefreshMaxAge, rt.family_id); err != nil {
+		logs.Error(err)
+	}
+	return pair, "success", nil
+}
+
+// RevokeRefreshToken 吊销刷新token所在的family,用户退出系统时调用
+func RevokeRefreshToken(refresh string) error {
+	if refresh == "" {
+		return nil
+	}
+	var rt refreshToken
+	err := dbobj.QueryRow(sys_rdbms_hrpc_013, hashRefreshToken(refresh)).Scan(&rt.family_id, &rt.user_id, &rt.domain_id,
+		&rt.org_unit_id, &rt.status, &rt.session_time, &rt.expire_time)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	return revokeRefreshFamily(rt.family_id)
+}
+
+// 刷新token被重放时,吊销整个family,包括family中已经签发的访问token
+func revokeTokenFamily(rt *refreshToken) {
+	if err := revokeRefreshFamily(rt.family_id); err != nil {
+		return
+	}
+	revokeSessionTokens(rt.family_id, rt.user_id, rt.user_id)
+}
+
+// 吊销刷新token family,同时结束对应的会话
+func revokeRefreshFamily(family_id string) error {
+	_, err := dbobj.Exec(sys_rdbms_hrpc_015, refreshStatusRevoked, family_id)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	_, err = dbobj.Exec(sys_rdbms_hrpc_077, family_id)
+	if err != nil {
+		logs.Error(err)
+	}
+	return err
+}
+
+// 吊销用户所有的刷新token
+func revokeUserRefreshTokens(user_id string) error {
+	_, err := dbobj.Exec(sys_rdbms_hrpc_016, refreshStatusRevoked, user_id)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	_, err = dbobj.Exec(sys_rdbms_hrpc_078, user_id)
+	if err != nil {
+		logs.Error(err)
+	}
+	return err
+}
+
+// 从app.conf中读取会话超时时间,没有配置时使用默认值
+func (s *sessionConfig) load(file string) {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		logs.Warn("read session timeout from app.conf failed, use default value.", err)
+		return
+	}
+
+	// 超时时间必须大于0
+	get := func(key string, def int64) int64 {
+		if n := confInt(conf, key, def); n > 0 {
+			return n
+		}
+		return def
+	}
+
+	s.access = get("Hauth.session.access.timeout", s.access)
+	s.idle = get("Hauth.session.idle.timeout", s.idle)
+	s.absolute = get("Hauth.session.absolute.timeout", s.absolute)
+	if n := confInt(conf, "Hauth.session.max.count", s.max_count); n >= 0 {
+		s.max_count = n
+	}
+}
+
+func init() {
+	session.load(filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf"))
+}
@@ -0,0 +1,216 @@
+package hrpc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// token吊销信息
+// 吊销信息保存在sys_token_revoke表中,同时在内存中缓存一份,
+// 每隔revokeSyncInterval从数据库中同步一次,保证多个实例之间吊销信息一致.
+//
+// revoke_type = 0 表示吊销单个token, jti是token的唯一编码
+// revoke_type = 1 表示吊销用户在revoke_time之前签发的所有token
+// revoke_type = 2 表示吊销会话中签发的所有token, jti是会话编码
+// revoke_time 是吊销时间,单位:毫秒,同一秒内吊销后重新登录签发的token不会被误判为已吊销.
+const (
+	revokeTypeToken   = "0"
+	revokeTypeUser    = "1"
+	revokeTypeSession = "2"
+
+	revokeSyncInterval = time.Second * 10
+)
+
+type revokeStore struct {
+	lock *sync.RWMutex
+	// key 是 jti, value 是token过期时间
+	tokens map[string]int64
+	// key 是 user_id, value 是吊销时间(毫秒),在这之前签发的token全部无效
+	users map[string]int64
+	// key 是会话编码, value 是吊销信息过期时间
+	sessions map[string]int64
+}
+
+var revokes = &revokeStore{
+	lock:     new(sync.RWMutex),
+	tokens:   make(map[string]int64),
+	users:    make(map[string]int64),
+	sessions: make(map[string]int64),
+}
+
+// 访问token的最长有效期,用户与会话级别的吊销信息至少保留这么长时间,
+// 否则吊销信息过期后,之前签发的还没有过期的token会重新生效.
+// 登录,刷新与OIDC签发的token有效期是session.access, 模拟登录token的有效期是impersonate.ttl.
+func tokenMaxAge() int64 {
+	if impersonate.ttl > session.access {
+		return impersonate.ttl
+	}
+	return session.access
+}
+
+func (r *revokeStore) isRevoked(jti, user_id, sid string, issuedAt int64) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if _, ok := r.tokens[jti]; ok {
+		return true
+	}
+	if _, ok := r.sessions[sid]; ok && sid != "" {
+		return true
+	}
+	if before, ok := r.users[user_id]; ok && issuedAt < before {
+		return true
+	}
+	return false
+}
+
+func (r *revokeStore) addToken(jti string, expire int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.tokens[jti] = expire
+}
+
+func (r *revokeStore) addSession(sid string, expire int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.sessions[sid] = expire
+}
+
+func (r *revokeStore) addUser(user_id string, before int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.users[user_id] < before {
+		r.users[user_id] = before
+	}
+}
+
+// 从数据库中重新加载没有过期的吊销信息
+func (r *revokeStore) sync() {
+	now := time.Now().Unix()
+	rows, err := dbobj.Query(sys_rdbms_hrpc_010, now)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	defer rows.Close()
+
+	tokens := make(map[string]int64)
+	users := make(map[string]int64)
+	sessions := make(map[string]int64)
+	for rows.Next() {
+		var revoke_type, jti, user_id string
+		var expire_time, revoke_time int64
+		err := rows.Scan(&revoke_type, &jti, &user_id, &expire_time, &revoke_time)
+		if err != nil {
+			logs.Error(err)
+			return
+		}
+		switch revoke_type {
+		case revokeTypeToken:
+			tokens[jti] = expire_time
+		case revokeTypeUser:
+			if users[user_id] < revoke_time {
+				users[user_id] = revoke_time
+			}
+		case revokeTypeSession:
+			sessions[jti] = expire_time
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	// 保留本实例中新增,但同步时还没有读取到的吊销信息
+	for jti, expire := range r.tokens {
+		if _, ok := tokens[jti]; !ok && expire > now {
+			tokens[jti] = expire
+		}
+	}
+	for user_id, before := range r.users {
+		if users[user_id] < before && before/1000+tokenMaxAge() > now {
+			users[user_id] = before
+		}
+	}
+	for sid, expire := range r.sessions {
+		if _, ok := sessions[sid]; !ok && expire > now {
+			sessions[sid] = expire
+		}
+	}
+	r.tokens = tokens
+	r.users = users
+	r.sessions = sessions
+}
+
+// IsRevoked 判断token是否已经被吊销
+// 没有jti的token,无法被单独吊销,视为已吊销
+// 没有毫秒签发时间的旧token,按照签发那一秒的开始时间判断
+func IsRevoked(jclaim *jwt.JwtClaims) bool {
+	if jclaim.StandardClaims == nil || jclaim.Id == "" {
+		return true
+	}
+	issued := jclaim.IssuedAtMs
+	if issued == 0 {
+		issued = jclaim.IssuedAt * 1000
+	}
+	return revokes.isRevoked(jclaim.Id, jclaim.UserId, jclaim.SessionId, issued)
+}
+
+// 当前时间,单位:毫秒
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// RevokeToken 吊销单个token,用户退出系统时调用
+func RevokeToken(jclaim *jwt.JwtClaims, handle_user string) error {
+	if jclaim.StandardClaims == nil || jclaim.Id == "" {
+		return nil
+	}
+	_, err := dbobj.Exec(sys_rdbms_hrpc_009, revokeTypeToken, jclaim.Id, jclaim.UserId, jclaim.ExpiresAt, nowMillis(), handle_user)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	revokes.addToken(jclaim.Id, jclaim.ExpiresAt)
+	return nil
+}
+
+// RevokeUserTokens 吊销用户当前所有的token,包括刷新token
+// 用户被禁用,或者密码被重置时调用,用户需要重新登录系统
+// 用户级别的吊销信息保留到访问token全部过期之后
+func RevokeUserTokens(user_id string, handle_user string) error {
+	now := nowMillis()
+	_, err := dbobj.Exec(sys_rdbms_hrpc_009, revokeTypeUser, "", user_id, now/1000+tokenMaxAge(), now, handle_user)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	revokes.addUser(user_id, now)
+	return revokeUserRefreshTokens(user_id)
+}
+
+// 定时同步吊销信息与权限版本号,并清理已经过期的吊销信息,刷新token,两步验证挑战码,登录限流记录,授权码,外部登录state,会话与重置密码token
+func revokeSync() {
+	revokes.sync()
+	syncPermVersion()
+	for {
+		select {
+		case <-time.After(revokeSyncInterval):
+			dbobj.Exec(sys_rdbms_hrpc_011, time.Now().Unix())
+			dbobj.Exec(sys_rdbms_hrpc_017, time.Now().Unix())
+			dbobj.Exec(sys_rdbms_hrpc_037, time.Now().Unix())
+			purgeThrottle()
+			purgeAuthCode()
+			purgeFederationState()
+			purgeSession()
+			purgePasswdReset()
+			syncPermVersion()
+			revokes.sync()
+		}
+	}
+}
+
+func init() {
+	go revokeSync()
+}
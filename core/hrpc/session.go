@@ -0,0 +1,141 @@
+package hrpc
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 登录会话
+// 用户每登录一次,产生一个会话,会话编码就是这次登录产生的刷新token family,
+// 会话信息保存在sys_user_session中,访问token的sid字段是会话编码.
+//
+// 结束会话时,吊销会话中的刷新token,并在sys_token_revoke中记录会话吊销信息(revoke_type = 2),
+// 会话中已经签发的访问token随即失效.
+// 会话的status = 0 表示有效, status = 1 表示已结束.
+const (
+	// 最后活动时间的更新间隔,单位:秒
+	sessionTouchInterval = 60
+	// 保存的客户端信息最大长度
+	sessionUserAgentMax = 500
+)
+
+// 会话最后活动时间的更新时间,key 是会话编码
+var sessionTouch = struct {
+	lock sync.Mutex
+	list map[string]int64
+}{list: make(map[string]int64)}
+
+// 记录新的会话,超过用户最大会话数量时,结束最早的会话
+func startSession(r *http.Request, session_id, user_id, domain_id string, login_time, expire_time int64) {
+	agent := r.UserAgent()
+	if len(agent) > sessionUserAgentMax {
+		agent = agent[:sessionUserAgentMax]
+	}
+	_, err := dbobj.Exec(sys_rdbms_hrpc_072, session_id, user_id, domain_id, ClientIP(r), agent, login_time, login_time, expire_time)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	if session.max_count <= 0 {
+		return
+	}
+
+	rows, err := dbobj.Query(sys_rdbms_hrpc_075, user_id, login_time)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	var list []string
+	for rows.Next() {
+		sid := ""
+		if err = rows.Scan(&sid); err != nil {
+			logs.Error(err)
+			break
+		}
+		list = append(list, sid)
+	}
+	rows.Close()
+
+	// 按照登录时间倒序排列,保留最新的max_count个会话
+	for i := int(session.max_count); i < len(list); i++ {
+		if list[i] == session_id {
+			continue
+		}
+		logs.Info("user ", user_id, " has too many sessions, end session ", list[i])
+		EndSession(list[i], user_id)
+	}
+}
+
+// TouchSession 更新会话的最后活动时间,每个会话在sessionTouchInterval内最多更新一次
+func TouchSession(jclaim *jwt.JwtClaims) {
+	if jclaim.SessionId == "" {
+		return
+	}
+	now := time.Now().Unix()
+	sessionTouch.lock.Lock()
+	if now-sessionTouch.list[jclaim.SessionId] < sessionTouchInterval {
+		sessionTouch.lock.Unlock()
+		return
+	}
+	sessionTouch.list[jclaim.SessionId] = now
+	sessionTouch.lock.Unlock()
+
+	if _, err := dbobj.Exec(sys_rdbms_hrpc_074, now, jclaim.SessionId); err != nil {
+		logs.Error(err)
+	}
+}
+
+// GetSessionOwner 查询有效会话所属的用户与域
+func GetSessionOwner(session_id string) (string, string, error) {
+	var user_id, domain_id string
+	err := dbobj.QueryRow(sys_rdbms_hrpc_076, session_id).Scan(&user_id, &domain_id)
+	if err != nil {
+		return "", "", errors.New("session is not found or has been ended, session id is: " + session_id)
+	}
+	return user_id, domain_id, nil
+}
+
+// EndSession 结束会话,会话中的刷新token与访问token全部失效
+func EndSession(session_id string, handle_user string) error {
+	user_id, _, err := GetSessionOwner(session_id)
+	if err != nil {
+		return err
+	}
+	if err = revokeRefreshFamily(session_id); err != nil {
+		return err
+	}
+	return revokeSessionTokens(session_id, user_id, handle_user)
+}
+
+// 记录会话吊销信息,会话中已经签发的访问token随即失效
+func revokeSessionTokens(session_id, user_id, handle_user string) error {
+	// 模拟登录token的sid是管理员的会话编码,有效期可能比session.access长
+	expire := time.Now().Unix() + tokenMaxAge()
+	_, err := dbobj.Exec(sys_rdbms_hrpc_009, revokeTypeSession, session_id, user_id, expire, nowMillis(), handle_user)
+	if err != nil {
+		logs.Error(err)
+		return err
+	}
+	revokes.addSession(session_id, expire)
+	return nil
+}
+
+// 清理已经过期的会话与最后活动时间缓存
+func purgeSession() {
+	now := time.Now().Unix()
+	dbobj.Exec(sys_rdbms_hrpc_079, now)
+
+	sessionTouch.lock.Lock()
+	defer sessionTouch.lock.Unlock()
+	for sid, t := range sessionTouch.list {
+		if now-t >= sessionTouchInterval {
+			delete(sessionTouch.list, sid)
+		}
+	}
+}
@@ -8,9 +8,97 @@ func init() {
 		sys_rdbms_hrpc_002 = `select t.domain_id from sys_org_info t where t.org_unit_id  = :1`
 		sys_rdbms_hrpc_003 = `select i.domain_id from sys_user_info t inner join sys_org_info i on t.org_unit_id = i.org_unit_id where t.user_id = :1`
 		sys_rdbms_hrpc_004 = `select domain_id from sys_role_info where role_id = :1`
-		sys_rdbms_hrpc_005 = `select user_id,user_passwd,status_id,continue_error_cnt from sys_sec_user where user_id = :1`
-		sys_rdbms_hrpc_006 = `select count(*) from sys_user_info t inner join sys_role_user_relation r on t.user_id = r.user_id inner join sys_role_resource_relat e on r.role_id = e.role_id inner join sys_theme_value v on e.res_id = v.res_id inner join sys_user_theme m on v.theme_id = m.theme_id and t.user_id = m.user_id where t.user_id = :1 and v.res_url = :2`
+		sys_rdbms_hrpc_005 = `select user_id,user_passwd,status_id,continue_error_cnt,passwd_time,force_change,lock_cnt,unlock_time from sys_sec_user where user_id = :1`
 		sys_rdbms_hrpc_007 = `update sys_sec_user set continue_error_cnt = :1 where user_id = :2`
-		sys_rdbms_hrpc_008 = `update sys_sec_user set status_id = 1 where user_id = :1`
+		sys_rdbms_hrpc_008 = `update sys_sec_user set continue_error_cnt = 0, lock_cnt = :1, lock_reason = :2, lock_time = :3, unlock_time = :4 where user_id = :5`
+		sys_rdbms_hrpc_009 = `insert into sys_token_revoke(uuid,revoke_type,jti,user_id,expire_time,revoke_time,revoke_user) values(sys_guid(),:1,:2,:3,:4,:5,:6)`
+		sys_rdbms_hrpc_010 = `select revoke_type,jti,user_id,expire_time,revoke_time from sys_token_revoke where expire_time > :1`
+		sys_rdbms_hrpc_011 = `delete from sys_token_revoke where expire_time <= :1`
+		sys_rdbms_hrpc_012 = `insert into sys_token_refresh(token_hash,family_id,user_id,domain_id,org_unit_id,status,session_time,expire_time,create_time) values(:1,:2,:3,:4,:5,:6,:7,:8,:9)`
+		sys_rdbms_hrpc_013 = `select family_id,user_id,domain_id,org_unit_id,status,session_time,expire_time from sys_token_refresh where token_hash = :1`
+		sys_rdbms_hrpc_014 = `update sys_token_refresh set status = :1 where token_hash = :2 and status = :3`
+		sys_rdbms_hrpc_015 = `update sys_token_refresh set status = :1 where family_id = :2`
+		sys_rdbms_hrpc_016 = `update sys_token_refresh set status = :1 where user_id = :2`
+		sys_rdbms_hrpc_017 = `delete from sys_token_refresh where expire_time <= :1`
+		sys_rdbms_hrpc_018 = `update sys_sec_user set user_passwd = :1 where user_id = :2`
+		sys_rdbms_hrpc_019 = `select min_length,require_upper,require_lower,require_digit,require_special,forbid_user_id,history_cnt,max_age,reset_change from sys_passwd_policy where domain_id = :1`
+		sys_rdbms_hrpc_020 = `select user_passwd from sys_passwd_history where user_id = :1 order by create_time desc`
+		sys_rdbms_hrpc_021 = `update sys_sec_user set user_passwd = :1, passwd_time = :2, force_change = :3 where user_id = :4`
+		sys_rdbms_hrpc_022 = `insert into sys_passwd_history(uuid,user_id,user_passwd,create_time) values(sys_guid(),:1,:2,:3)`
+		sys_rdbms_hrpc_023 = `select user_passwd from sys_sec_user where user_id = :1`
+		sys_rdbms_hrpc_024 = `select secret,status,last_step from sys_user_mfa where user_id = :1`
+		sys_rdbms_hrpc_025 = `select count(*) from sys_mfa_require where (require_type = :1 and target_id = :2) or (require_type = :3 and target_id in (select role_id from sys_role_user_relation where user_id = :4))`
+		sys_rdbms_hrpc_026 = `insert into sys_mfa_challenge(challenge_hash,user_id,domain_id,org_unit_id,attempts,expire_time) values(:1,:2,:3,:4,:5,:6)`
+		sys_rdbms_hrpc_027 = `select user_id,domain_id,org_unit_id,attempts,expire_time from sys_mfa_challenge where challenge_hash = :1`
+		sys_rdbms_hrpc_028 = `update sys_mfa_challenge set attempts = attempts + 1 where challenge_hash = :1`
+		sys_rdbms_hrpc_029 = `delete from sys_mfa_challenge where challenge_hash = :1`
+		sys_rdbms_hrpc_030 = `update sys_user_mfa set last_step = :1 where user_id = :2 and last_step < :3`
+		sys_rdbms_hrpc_031 = `delete from sys_user_mfa_recovery where code_hash = :1 and user_id = :2`
+		sys_rdbms_hrpc_032 = `delete from sys_user_mfa where user_id = :1`
+		sys_rdbms_hrpc_033 = `insert into sys_user_mfa(user_id,secret,status,last_step,create_time) values(:1,:2,:3,:4,:5)`
+		sys_rdbms_hrpc_034 = `update sys_user_mfa set status = :1, enable_time = :2 where user_id = :3`
+		sys_rdbms_hrpc_035 = `delete from sys_user_mfa_recovery where user_id = :1`
+		sys_rdbms_hrpc_036 = `insert into sys_user_mfa_recovery(code_hash,user_id,create_time) values(:1,:2,:3)`
+		sys_rdbms_hrpc_037 = `delete from sys_mfa_challenge where expire_time <= :1`
+		sys_rdbms_hrpc_038 = `select count(*) from sys_user_mfa_recovery where user_id = :1`
+		sys_rdbms_hrpc_039 = `update sys_sec_user set continue_error_cnt = 0, lock_cnt = 0, lock_reason = :1, lock_time = 0, unlock_time = 0 where user_id = :2`
+		sys_rdbms_hrpc_040 = `select tokens,update_time from sys_login_throttle where throttle_key = :1 for update`
+		sys_rdbms_hrpc_041 = `insert into sys_login_throttle(throttle_key,tokens,update_time) values(:1,:2,:3)`
+		sys_rdbms_hrpc_042 = `update sys_login_throttle set tokens = :1, update_time = :2 where throttle_key = :3`
+		sys_rdbms_hrpc_043 = `delete from sys_login_throttle where update_time <= :1`
+		sys_rdbms_hrpc_044 = `select count(*) from sys_user_info where user_id = :1`
+		sys_rdbms_hrpc_045 = `select count(*) from sys_org_info where org_unit_id = :1 and domain_id = :2`
+		sys_rdbms_hrpc_046 = `update sys_user_info set user_name = coalesce(:1, user_name), user_email = coalesce(:2, user_email), user_maintance_date = sysdate, user_maintance_user = :3 where user_id = :4`
+		sys_rdbms_hrpc_047 = `insert into sys_user_info (user_id,user_name,user_create_date,user_owner,user_email,user_phone,org_unit_id,user_maintance_date,user_maintance_user) values(:1,:2,sysdate,:3,:4,:5,:6,sysdate,:7)`
+		sys_rdbms_hrpc_048 = `insert into sys_sec_user(user_id,user_passwd,status_id,passwd_time,force_change) values(:1,:2,:3,:4,:5)`
+		sys_rdbms_hrpc_049 = `insert into sys_user_theme(user_id,theme_id) values(:1,:2)`
+		sys_rdbms_hrpc_050 = `select status_id from sys_sec_user where user_id = :1`
+		sys_rdbms_hrpc_051 = `select count(*) from sys_role_user_relation where user_id = :1 and role_id = :2`
+		sys_rdbms_hrpc_052 = `insert into sys_role_user_relation(uuid,role_id,user_id,maintance_date,maintance_user) values(:1,:2,:3,sysdate,:4)`
+		sys_rdbms_hrpc_053 = `delete from sys_role_user_relation where user_id = :1 and role_id = :2`
+		sys_rdbms_hrpc_054 = `select client_id,client_name,coalesce(client_secret,''),coalesce(redirect_uris,''),grant_types,coalesce(scopes,''),client_type,trusted,domain_id from sys_oauth_client where client_id = :1 and status = '0'`
+		sys_rdbms_hrpc_055 = `insert into sys_oauth_code(code_hash,client_id,user_id,domain_id,org_unit_id,redirect_uri,scope,nonce,code_challenge,auth_time,expire_time) values(:1,:2,:3,:4,:5,:6,:7,:8,:9,:10,:11)`
+		sys_rdbms_hrpc_056 = `select client_id,user_id,domain_id,org_unit_id,redirect_uri,scope,coalesce(nonce,''),code_challenge,auth_time,expire_time from sys_oauth_code where code_hash = :1`
+		sys_rdbms_hrpc_057 = `delete from sys_oauth_code where code_hash = :1`
+		sys_rdbms_hrpc_058 = `select user_name,coalesce(user_email,'') from sys_user_info where user_id = :1`
+		sys_rdbms_hrpc_059 = `delete from sys_oauth_code where expire_time <= :1`
+		sys_rdbms_hrpc_060 = `select issuer,client_id,client_secret,coalesce(scopes,''),link_email from sys_domain_idp where domain_id = :1 and status = '0'`
+		sys_rdbms_hrpc_061 = `insert into sys_idp_login_state(state_hash,domain_id,nonce,code_verifier,expire_time) values(:1,:2,:3,:4,:5)`
+		sys_rdbms_hrpc_062 = `select domain_id,nonce,code_verifier,expire_time from sys_idp_login_state where state_hash = :1`
+		sys_rdbms_hrpc_063 = `delete from sys_idp_login_state where state_hash = :1`
+		sys_rdbms_hrpc_064 = `delete from sys_idp_login_state where expire_time <= :1`
+		sys_rdbms_hrpc_065 = `select user_id from sys_user_identity where identity_id = :1`
+		sys_rdbms_hrpc_066 = `select u.user_id from sys_user_info u inner join sys_org_info o on u.org_unit_id = o.org_unit_id where o.domain_id = :1 and u.user_email = :2`
+		sys_rdbms_hrpc_067 = `insert into sys_user_identity(identity_id,issuer,subject,user_id,domain_id,create_time) values(:1,:2,:3,:4,:5,:6)`
+		sys_rdbms_hrpc_068 = `select account_type from sys_sec_user where user_id = :1`
+		sys_rdbms_hrpc_069 = `select k.key_hash,k.user_id,coalesce(k.scopes,''),k.expire_time,k.last_used_time,s.status_id,s.account_type,u.org_unit_id,o.domain_id from sys_api_key k inner join sys_sec_user s on k.user_id = s.user_id inner join sys_user_info u on k.user_id = u.user_id inner join sys_org_info o on u.org_unit_id = o.org_unit_id where k.key_id = :1 and k.status = '0'`
+		sys_rdbms_hrpc_070 = `update sys_api_key set last_used_time = :1 where key_id = :2`
+		sys_rdbms_hrpc_072 = `insert into sys_user_session(session_id,user_id,domain_id,client_ip,user_agent,login_time,last_active,expire_time,status) values(:1,:2,:3,:4,:5,:6,:7,:8,'0')`
+		sys_rdbms_hrpc_073 = `update sys_user_session set last_active = :1, expire_time = :2 where session_id = :3`
+		sys_rdbms_hrpc_074 = `update sys_user_session set last_active = :1 where session_id = :2`
+		sys_rdbms_hrpc_075 = `select session_id from sys_user_session where user_id = :1 and status = '0' and expire_time > :2 order by login_time desc`
+		sys_rdbms_hrpc_076 = `select user_id,domain_id from sys_user_session where session_id = :1 and status = '0'`
+		sys_rdbms_hrpc_077 = `update sys_user_session set status = '1' where session_id = :1`
+		sys_rdbms_hrpc_078 = `update sys_user_session set status = '1' where user_id = :1`
+		sys_rdbms_hrpc_079 = `delete from sys_user_session where expire_time <= :1`
+		sys_rdbms_hrpc_080 = `select s.status_id,s.account_type,coalesce(u.user_owner,''),coalesce(u.user_email,''),o.domain_id from sys_sec_user s inner join sys_user_info u on s.user_id = u.user_id inner join sys_org_info o on u.org_unit_id = o.org_unit_id where s.user_id = :1`
+		sys_rdbms_hrpc_081 = `insert into sys_passwd_reset(token_hash,user_id,client_ip,create_time,expire_time) values(:1,:2,:3,:4,:5)`
+		sys_rdbms_hrpc_082 = `select user_id,expire_time from sys_passwd_reset where token_hash = :1`
+		sys_rdbms_hrpc_083 = `delete from sys_passwd_reset where token_hash = :1`
+		sys_rdbms_hrpc_084 = `delete from sys_passwd_reset where user_id = :1`
+		sys_rdbms_hrpc_085 = `delete from sys_passwd_reset where expire_time <= :1`
+		sys_rdbms_hrpc_086 = `select role_id from sys_role_user_relation where user_id = :1 order by role_id`
+		sys_rdbms_hrpc_087 = `select distinct e.res_id from sys_role_user_relation r inner join sys_role_ancestor_relat s on r.role_id = s.role_id inner join sys_role_resource_relat e on s.ancestor_id = e.role_id where r.user_id = :1 order by e.res_id`
+		sys_rdbms_hrpc_088 = `select distinct e.res_id,coalesce(v.res_method,'') as res_method,v.res_url from sys_role_user_relation r inner join sys_role_ancestor_relat s on r.role_id = s.role_id inner join sys_role_resource_relat e on s.ancestor_id = e.role_id inner join sys_theme_value v on e.res_id = v.res_id inner join sys_user_theme m on v.theme_id = m.theme_id and r.user_id = m.user_id where r.user_id = :1`
+		sys_rdbms_hrpc_089 = `update sys_perm_version set version = version + 1 where id = '0'`
+		sys_rdbms_hrpc_090 = `select version from sys_perm_version where id = '0'`
+		sys_rdbms_hrpc_091 = `select count(*) from sys_role_user_relation r inner join sys_role_info i on r.role_id = i.role_id where r.user_id = :1 and i.super_admin = '1'`
+		sys_rdbms_hrpc_092 = `select count(*) from sys_role_info where role_id = :1 and super_admin = '1'`
+		sys_rdbms_hrpc_093 = `select r.user_id,r.role_id from sys_role_user_relation r inner join sys_role_info i on r.role_id = i.role_id where i.super_admin = '1'`
+		sys_rdbms_hrpc_094 = `insert into sys_super_admin_audit(uuid,user_id,role_id,action,handle_user,handle_time,client_ip) values(:1,:2,:3,:4,:5,:6,:7)`
+		sys_rdbms_hrpc_095 = `select coalesce(org_scope,'') as org_scope,coalesce(org_scope_sub,'0') as org_scope_sub from sys_role_user_relation where user_id = :1`
+		sys_rdbms_hrpc_096 = `select org_unit_id,up_org_id from sys_org_info where domain_id = :1`
+		sys_rdbms_hrpc_097 = `select org_unit_id from sys_user_info where user_id = :1`
+		sys_rdbms_hrpc_098 = `insert into sys_impersonate_audit(uuid,admin_id,user_id,session_id,action,handle_time,client_ip) values(:1,:2,:3,:4,:5,:6,:7)`
 	}
 }
@@ -0,0 +1,136 @@
+package hrpc
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/uuid"
+)
+
+// 超级管理员
+// 角色信息中super_admin = '1'的角色是超级管理员角色,拥有超级管理员角色的用户是超级管理员,
+// 超级管理员可以访问所有的API与所有的域,新增的资源自动授权给超级管理员角色.
+// 超级管理员角色可以授予多个用户,只有超级管理员能够授予或者移除超级管理员角色,
+// 每次授予与移除都记录到sys_super_admin_audit中,并且不能移除最后一个超级管理员.
+// 超级管理员角色不能通过LDAP组映射同步,也不能被删除.
+const (
+	SuperAdminGrant  = "grant"
+	SuperAdminRevoke = "revoke"
+)
+
+// RoleGrant 用户与角色的授权关系
+type RoleGrant struct {
+	User_id string
+	Role_id string
+}
+
+// IsSuperAdmin 判断用户是否是超级管理员
+func IsSuperAdmin(user_id string) bool {
+	routes, err := userPermissions(user_id)
+	if err != nil {
+		logs.Error(err)
+		return false
+	}
+	return routes.super
+}
+
+// IsSuperAdminRole 判断角色是否是超级管理员角色
+func IsSuperAdminRole(role_id string) bool {
+	cnt := 0
+	if err := dbobj.QueryRow(sys_rdbms_hrpc_092, role_id).Scan(&cnt); err != nil {
+		logs.Error(err)
+		return false
+	}
+	return cnt > 0
+}
+
+// CheckSuperAdminChange 检查授予或者移除角色是否涉及超级管理员角色,
+// 涉及时只有超级管理员可以操作,移除时不能移除最后一个超级管理员.
+// 返回实际会发生变化的超级管理员角色授权,用于记录审计日志.
+func CheckSuperAdminChange(r *http.Request, list []RoleGrant, action string) ([]RoleGrant, string, error) {
+	current, err := superAdminGrants()
+	if err != nil {
+		return nil, "error_super_admin_query", err
+	}
+	exists := make(map[RoleGrant]bool)
+	for _, val := range current {
+		exists[val] = true
+	}
+
+	var changes []RoleGrant
+	for _, val := range list {
+		switch action {
+		case SuperAdminGrant:
+			if !exists[val] && IsSuperAdminRole(val.Role_id) {
+				changes = append(changes, val)
+			}
+		case SuperAdminRevoke:
+			if exists[val] {
+				changes = append(changes, val)
+			}
+		}
+	}
+	if len(changes) == 0 {
+		return nil, "success", nil
+	}
+
+	jclaim, err := jwt.GetJwtClaims(r)
+	if err != nil {
+		return nil, "error_super_admin_forbid", err
+	}
+	if jclaim.Impersonator != "" || !IsSuperAdmin(jclaim.UserId) {
+		logs.Warn("super admin role can only be changed by super admin, user id is:", jclaim.UserId)
+		return nil, "error_super_admin_forbid", errors.New("super admin role can only be changed by super admin.")
+	}
+
+	if action == SuperAdminRevoke {
+		for _, val := range changes {
+			delete(exists, val)
+		}
+		if len(exists) == 0 {
+			return nil, "error_super_admin_last", errors.New("can not revoke the last super admin.")
+		}
+	}
+	return changes, "success", nil
+}
+
+// AuditSuperAdmin 记录超级管理员角色的授予与移除
+func AuditSuperAdmin(r *http.Request, list []RoleGrant, action string) {
+	handle_user := ""
+	if jclaim, err := jwt.GetJwtClaims(r); err == nil {
+		handle_user = jclaim.UserId
+	}
+	now := time.Now().Unix()
+	for _, val := range list {
+		_, err := dbobj.Exec(sys_rdbms_hrpc_094, uuid.GenUUID(), val.User_id, val.Role_id, action, handle_user, now, ClientIP(r))
+		if err != nil {
+			logs.Error("write super admin audit failed.", err)
+		}
+		logs.Warn("super admin role is changed, action is:", action, ", user id is:", val.User_id, ", role id is:", val.Role_id, ", handle user is:", handle_user)
+	}
+}
+
+// 当前所有的超级管理员角色授权
+func superAdminGrants() ([]RoleGrant, error) {
+	rows, err := dbobj.Query(sys_rdbms_hrpc_093)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []RoleGrant
+	for rows.Next() {
+		var val RoleGrant
+		if err = rows.Scan(&val.User_id, &val.Role_id); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		rst = append(rst, val)
+	}
+	return rst, nil
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/validator"
+)
+
+type ApiKeyModel struct {
+}
+
+type ApiKeyData struct {
+	Key_id         string `json:"key_id"`
+	Key_name       string `json:"key_name"`
+	User_id        string `json:"user_id"`
+	Scopes         string `json:"scopes"`
+	Expire_time    string `json:"expire_time"`
+	Last_used_time string `json:"last_used_time"`
+	Status         string `json:"status"`
+	Create_user    string `json:"create_user"`
+	Create_date    string `json:"create_date"`
+}
+
+// API key的默认有效期与最长有效期,单位:天
+const (
+	apiKeyDefaultDays = 90
+	apiKeyMaxDays     = 3650
+)
+
+// 查询服务账号的API key,不返回key的摘要
+func (ApiKeyModel) Get(user_id string) ([]ApiKeyData, error) {
+	rows, err := dbobj.Query(sys_rdbms_122, user_id)
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []ApiKeyData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 为服务账号创建API key,返回API key明文,明文只在创建时返回一次
+// scopes是空格分隔的资源编码,必须是服务账号已经被授权的资源
+func (ApiKeyModel) Post(user_id, key_name, scopes, expire_days, create_user string) (string, string, error) {
+	if !hrpc.IsServiceAccount(user_id) {
+		return "", "error_api_key_user", errors.New("error_api_key_user")
+	}
+
+	key_name = strings.TrimSpace(key_name)
+	if validator.IsEmpty(key_name) || len(key_name) > 100 {
+		return "", "error_api_key_name", errors.New("error_api_key_name")
+	}
+
+	days := apiKeyDefaultDays
+	if expire_days != "" {
+		n, err := strconv.Atoi(expire_days)
+		if err != nil || n < 1 || n > apiKeyMaxDays {
+			return "", "error_api_key_expire", errors.New("error_api_key_expire")
+		}
+		days = n
+	}
+
+	list := strings.Fields(scopes)
+	for _, res_id := range list {
+		cnt := 0
+		err := dbobj.QueryRow(sys_rdbms_125, user_id, res_id).Scan(&cnt)
+		if err != nil || cnt == 0 {
+			return "", "error_api_key_scope", errors.New("resource is not granted to service account: " + res_id)
+		}
+	}
+	scopes = strings.Join(list, " ")
+	if len(scopes) > 2000 {
+		return "", "error_api_key_scope", errors.New("error_api_key_scope")
+	}
+
+	key_id, key, hash, err := hrpc.GenApiKey()
+	if err != nil {
+		logs.Error(err)
+		return "", "error_api_key_post", err
+	}
+
+	expire_time := time.Now().AddDate(0, 0, days).Unix()
+	_, err = dbobj.Exec(sys_rdbms_123, key_id, hash, key_name, user_id, scopes, expire_time, create_user)
+	if err != nil {
+		logs.Error(err)
+		return "", "error_api_key_post", err
+	}
+	return key, "success", nil
+}
+
+// 删除API key,删除后立即失效
+func (ApiKeyModel) Delete(user_id, key_id string) (string, error) {
+	_, err := dbobj.Exec(sys_rdbms_124, key_id, user_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_api_key_delete", err
+	}
+	return "success", nil
+}
@@ -1,61 +1,19 @@
 package models
 
 import (
-	"database/sql"
-
 	"github.com/astaxie/beego/context"
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/hret"
 	"github.com/hzwy23/hauth/utils/i18n"
 	"github.com/hzwy23/hauth/utils/jwt"
 	"github.com/hzwy23/hauth/utils/logs"
 )
 
-const (
-	error_querydb  string = "can not found user info in system."
-	error_maxerror string = "user was forbided, you have continued type password error 6 times."
-	error_password string = "user's password error.please check your password"
-)
-
-type mSysUserSec struct {
-	User_id                 string        `json:"user_id"`
-	User_passwd             string        `json:"user_passwd"`
-	User_status             sql.NullInt64 `json:"user_status"`
-	User_continue_error_cnt sql.NullInt64
-}
-
-func updateContinueErrorCnt(cnt int64, user_id string) {
-	dbobj.Exec(sys_rdbms_098, cnt, user_id)
-}
-
-func forbidUsers(user_id string) {
-	dbobj.Exec(sys_rdbms_099, user_id)
-}
-
 // check user's passwd is right.
+// 账号锁定与密码过期的处理与hrpc.CheckPasswd相同
 func CheckPasswd(user_id, user_passwd string) (bool, int, int64, string) {
-	var sec mSysUserSec
-	err := dbobj.QueryRow(sys_rdbms_010, user_id).Scan(&sec.User_id, &sec.User_passwd, &sec.User_status, &sec.User_continue_error_cnt)
-	if err != nil {
-		return false, 402, 0, error_querydb
-	}
-
-	if sec.User_status.Int64 != 0 {
-		return false, 406, sec.User_status.Int64, error_maxerror
-	}
-
-	if sec.User_continue_error_cnt.Int64 > 6 {
-		forbidUsers(user_id)
-		return false, 403, sec.User_continue_error_cnt.Int64, error_maxerror
-	}
-
-	if sec.User_id == user_id && sec.User_passwd == user_passwd {
-		updateContinueErrorCnt(0, user_id)
-		return true, 200, 0, ""
-	} else {
-		updateContinueErrorCnt(sec.User_continue_error_cnt.Int64+1, user_id)
-		return false, 405, sec.User_continue_error_cnt.Int64 + 1, error_password
-	}
+	return hrpc.CheckPasswd(user_id, user_passwd)
 }
 
 // check the user wheather handle the domain
@@ -91,25 +49,9 @@ func CheckDomainByRoleId(role_id string) (string, error) {
 	return domain_id, err
 }
 
+// 与hrpc.BasicAuth相同,没有权限时返回错误信息
 func BasicAuth(ctx *context.Context) bool {
-
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
-	if err != nil {
-		logs.Error(err)
-		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "as_of_date_no_auth"))
-		return false
-	}
-	if jclaim.UserId == "admin" {
-		return true
-	}
-	cnt := 0
-	err = dbobj.QueryRow(sys_rdbms_022, jclaim.UserId, ctx.Request.URL.Path).Scan(&cnt)
-	if err != nil {
-		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "as_of_date_no_auth"))
-		return false
-	}
-	if cnt == 0 {
+	if !hrpc.BasicAuth(ctx.Request) {
 		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "as_of_date_no_auth"))
 		return false
 	}
@@ -121,16 +63,15 @@ func BasicAuth(ctx *context.Context) bool {
 // 返回值是2 表示有读写权限
 func DomainAuth(ctx *context.Context, domain_id string) int {
 	level := -1
-	cookie, _ := ctx.Request.Cookie("Authorization")
-	jclaim, err := jwt.ParseJwt(cookie.Value)
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
 	if err != nil {
 		logs.Error(err)
 		return level
 	}
 
-	// if the user is not admin, and user_id is not owner this domain_id
+	// if the user is not super admin, and user_id is not owner this domain_id
 	// check share info. or not
-	if jclaim.UserId != "admin" && jclaim.DomainId != domain_id {
+	if jclaim.DomainId != domain_id && !hrpc.IsSuperAdmin(jclaim.UserId) {
 		level = CheckDomainRights(jclaim.UserId, domain_id)
 		return level
 	} else {
@@ -0,0 +1,205 @@
+package models
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/validator"
+)
+
+type DomainIdpModel struct {
+}
+
+type DomainIdpData struct {
+	Domain_id      string `json:"domain_id"`
+	Issuer         string `json:"issuer"`
+	Client_id      string `json:"client_id"`
+	Scopes         string `json:"scopes"`
+	Link_email     string `json:"link_email"`
+	Status         string `json:"status"`
+	Create_user    string `json:"create_user"`
+	Create_date    string `json:"create_date"`
+	Maintance_user string `json:"maintance_user"`
+	Maintance_date string `json:"maintance_date"`
+}
+
+type UserIdentityData struct {
+	Identity_id string `json:"identity_id"`
+	Issuer      string `json:"issuer"`
+	Subject     string `json:"subject"`
+	User_id     string `json:"user_id"`
+	Domain_id   string `json:"domain_id"`
+	Create_time string `json:"create_time"`
+}
+
+// 查询域配置的身份提供者,不返回应用密钥
+func (DomainIdpModel) Get(domain_id string) ([]DomainIdpData, error) {
+	rows, err := dbobj.Query(sys_rdbms_114, domain_id)
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []DomainIdpData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 配置域的身份提供者,没有配置时新增,已经配置时修改
+// 修改时client_secret为空表示不修改应用密钥
+func (this DomainIdpModel) Put(arg DomainIdpData, client_secret string, user_id string) (string, error) {
+	msg, err := this.check(&arg)
+	if err != nil {
+		return msg, err
+	}
+
+	cnt := 0
+	err = dbobj.QueryRow(sys_rdbms_115, arg.Domain_id).Scan(&cnt)
+	if err != nil {
+		logs.Error(err)
+		return "error_idp_update", err
+	}
+	if cnt == 0 && client_secret == "" {
+		return "error_idp_client_secret", errors.New("error_idp_client_secret")
+	}
+
+	secret := ""
+	if client_secret != "" {
+		secret, err = haes.Encrypt(client_secret)
+		if err != nil {
+			logs.Error(err)
+			return "error_idp_update", err
+		}
+	}
+
+	if cnt == 0 {
+		_, err = dbobj.Exec(sys_rdbms_116, arg.Domain_id, arg.Issuer, arg.Client_id, secret, arg.Scopes,
+			arg.Link_email, arg.Status, user_id, user_id)
+		if err != nil {
+			logs.Error(err)
+			return "error_idp_update", err
+		}
+		return "success", nil
+	}
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return "error_sql_begin", err
+	}
+	_, err = tx.Exec(sys_rdbms_117, arg.Issuer, arg.Client_id, arg.Scopes, arg.Link_email, arg.Status, user_id, arg.Domain_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return "error_idp_update", err
+	}
+	if secret != "" {
+		_, err = tx.Exec(sys_rdbms_118, secret, user_id, arg.Domain_id)
+		if err != nil {
+			tx.Rollback()
+			logs.Error(err)
+			return "error_idp_update", err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		logs.Error(err)
+		return "error_idp_update", err
+	}
+	return "success", nil
+}
+
+func (DomainIdpModel) Delete(domain_id string) (string, error) {
+	_, err := dbobj.Exec(sys_rdbms_119, domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_idp_delete", err
+	}
+	return "success", nil
+}
+
+// 查询用户绑定的外部身份
+func (DomainIdpModel) GetIdentity(user_id string) ([]UserIdentityData, error) {
+	rows, err := dbobj.Query(sys_rdbms_120, user_id)
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []UserIdentityData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 把身份提供者中的用户绑定到本系统的用户, subject是ID token中的sub
+func (DomainIdpModel) PostIdentity(user_id, domain_id, issuer, subject string) (string, error) {
+	if !isIdpIssuer(issuer) {
+		return "error_idp_issuer", errors.New("error_idp_issuer")
+	}
+	if validator.IsEmpty(subject) || len(subject) > 255 {
+		return "error_idp_subject", errors.New("error_idp_subject")
+	}
+	err := hrpc.LinkIdentity(issuer, subject, user_id, domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_idp_link", err
+	}
+	return "success", nil
+}
+
+func (DomainIdpModel) DeleteIdentity(user_id, identity_id string) (string, error) {
+	_, err := dbobj.Exec(sys_rdbms_121, identity_id, user_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_idp_unlink", err
+	}
+	return "success", nil
+}
+
+// 校验身份提供者配置,并把授权范围整理成空格分隔的格式
+func (DomainIdpModel) check(arg *DomainIdpData) (string, error) {
+	arg.Issuer = strings.TrimRight(strings.TrimSpace(arg.Issuer), "/")
+	if !isIdpIssuer(arg.Issuer) {
+		return "error_idp_issuer", errors.New("error_idp_issuer")
+	}
+	if validator.IsEmpty(arg.Client_id) || len(arg.Client_id) > 200 {
+		return "error_idp_client_id", errors.New("error_idp_client_id")
+	}
+	if !validator.IsIn(arg.Link_email, "0", "1") {
+		return "error_idp_link_email", errors.New("error_idp_link_email")
+	}
+	if !validator.IsIn(arg.Status, "0", "1") {
+		return "error_idp_status", errors.New("error_idp_status")
+	}
+
+	scopes := strings.Fields(strings.Replace(arg.Scopes, ",", " ", -1))
+	// 配置了授权范围时,openid必须出现在授权范围中
+	if len(scopes) > 0 && !validator.IsIn("openid", scopes...) {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+	arg.Scopes = strings.Join(scopes, " ")
+	if len(arg.Scopes) > 200 {
+		return "error_idp_scope", errors.New("error_idp_scope")
+	}
+	return "success", nil
+}
+
+// issuer必须是https地址,本机测试时允许http
+func isIdpIssuer(issuer string) bool {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" || len(issuer) > 300 {
+		return false
+	}
+	if u.Scheme == "https" {
+		return true
+	}
+	host := u.Hostname()
+	return u.Scheme == "http" && (host == "localhost" || host == "127.0.0.1")
+}
@@ -10,14 +10,16 @@ type HandleLogMode struct {
 }
 
 type handleLogs struct {
-	Uuid        string `json:"uuid"`
-	User_id     string `json:"user_id"`
-	Handle_time string `json:"handle_time" dateType:"YYYY-MM-DD HH24:MM:SS"`
-	Client_ip   string `json:"client_ip"`
-	Status_code string `json:"status_code"`
-	Method      string `json:"method"`
-	Url         string `json:"url"`
-	Data        string `json:"data"`
+	Uuid         string `json:"uuid"`
+	User_id      string `json:"user_id"`
+	Handle_time  string `json:"handle_time" dateType:"YYYY-MM-DD HH24:MM:SS"`
+	Client_ip    string `json:"client_ip"`
+	Status_code  string `json:"status_code"`
+	Method       string `json:"method"`
+	Url          string `json:"url"`
+	Data         string `json:"data"`
+	Api_key      string `json:"api_key"`
+	Impersonator string `json:"impersonator"`
 }
 
 func (this HandleLogMode) Download(domain_id string) ([]handleLogs, error) {
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type MfaModel struct {
+}
+
+type MfaRequireData struct {
+	Require_type string `json:"require_type"`
+	Target_id    string `json:"target_id"`
+	Create_user  string `json:"create_user"`
+	Create_date  string `json:"create_date"`
+}
+
+// 查询域,以及域中的角色两步验证要求
+func (MfaModel) GetRequire(domain_id string) ([]MfaRequireData, error) {
+	rows, err := dbobj.Query(sys_rdbms_105, hrpc.MfaRequireDomain, domain_id, hrpc.MfaRequireRole, domain_id)
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []MfaRequireData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 要求域中的用户,或者拥有某个角色的用户启用两步验证
+// require_type为0时,target_id是域编码,为1时,target_id是角色编码,角色必须属于domain_id这个域
+func (this MfaModel) PostRequire(domain_id, require_type, target_id, user_id string) (string, error) {
+	msg, err := this.checkTarget(domain_id, require_type, target_id)
+	if err != nil {
+		return msg, err
+	}
+	_, err = dbobj.Exec(sys_rdbms_106, require_type, target_id, user_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_mfa_require_post", err
+	}
+	return "success", nil
+}
+
+// 取消两步验证要求
+func (this MfaModel) DeleteRequire(domain_id, require_type, target_id string) (string, error) {
+	msg, err := this.checkTarget(domain_id, require_type, target_id)
+	if err != nil {
+		return msg, err
+	}
+	_, err = dbobj.Exec(sys_rdbms_107, require_type, target_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_mfa_require_delete", err
+	}
+	return "success", nil
+}
+
+func (MfaModel) checkTarget(domain_id, require_type, target_id string) (string, error) {
+	switch require_type {
+	case hrpc.MfaRequireDomain:
+		if target_id != domain_id {
+			return "error_mfa_require_target", errors.New("error_mfa_require_target")
+		}
+	case hrpc.MfaRequireRole:
+		did, err := utils.SplitDomain(target_id)
+		if err != nil || did != domain_id {
+			return "error_mfa_require_target", errors.New("error_mfa_require_target")
+		}
+	default:
+		return "error_mfa_require_type", errors.New("error_mfa_require_type")
+	}
+	return "success", nil
+}
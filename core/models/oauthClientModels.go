@@ -0,0 +1,193 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/validator"
+)
+
+type OauthClientModel struct {
+}
+
+type OauthClientData struct {
+	Client_id      string `json:"client_id"`
+	Client_name    string `json:"client_name"`
+	Redirect_uris  string `json:"redirect_uris"`
+	Grant_types    string `json:"grant_types"`
+	Scopes         string `json:"scopes"`
+	Client_type    string `json:"client_type"`
+	Trusted        string `json:"trusted"`
+	Status         string `json:"status"`
+	Domain_id      string `json:"domain_id"`
+	Create_user    string `json:"create_user"`
+	Create_date    string `json:"create_date"`
+	Maintance_user string `json:"maintance_user"`
+	Maintance_date string `json:"maintance_date"`
+}
+
+// 查询域中注册的应用,不返回应用密钥
+func (OauthClientModel) Get(domain_id string) ([]OauthClientData, error) {
+	rows, err := dbobj.Query(sys_rdbms_108, domain_id)
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []OauthClientData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 注册应用,机密应用返回生成的应用密钥,密钥只在注册与重置时返回一次
+func (this OauthClientModel) Post(arg OauthClientData, user_id string) (string, string, error) {
+	if !validator.IsWord(arg.Client_id) || len(arg.Client_id) > 64 {
+		return "", "error_oauth_client_id", errors.New("error_oauth_client_id")
+	}
+	if !validator.IsIn(arg.Client_type, hrpc.OauthClientConfidential, hrpc.OauthClientPublic) {
+		return "", "error_oauth_client_type", errors.New("error_oauth_client_type")
+	}
+	msg, err := this.check(&arg)
+	if err != nil {
+		return "", msg, err
+	}
+
+	var secret, hash string
+	if arg.Client_type == hrpc.OauthClientConfidential {
+		secret, hash, err = genClientSecret()
+		if err != nil {
+			logs.Error(err)
+			return "", "error_oauth_client_post", err
+		}
+	}
+
+	_, err = dbobj.Exec(sys_rdbms_109, arg.Client_id, arg.Client_name, hash, arg.Redirect_uris, arg.Grant_types,
+		arg.Scopes, arg.Client_type, arg.Trusted, "0", arg.Domain_id, user_id, user_id)
+	if err != nil {
+		logs.Error(err)
+		return "", "error_oauth_client_post", err
+	}
+	return secret, "success", nil
+}
+
+// 修改应用信息,应用类型不能修改
+func (this OauthClientModel) Put(arg OauthClientData, user_id string) (string, error) {
+	if !validator.IsIn(arg.Status, "0", "1") {
+		return "error_oauth_client_status", errors.New("error_oauth_client_status")
+	}
+	err := dbobj.QueryRow(sys_rdbms_113, arg.Client_id, arg.Domain_id).Scan(&arg.Client_type)
+	if err != nil {
+		logs.Error(err)
+		return "error_oauth_client_query", err
+	}
+	msg, err := this.check(&arg)
+	if err != nil {
+		return msg, err
+	}
+
+	_, err = dbobj.Exec(sys_rdbms_110, arg.Client_name, arg.Redirect_uris, arg.Grant_types, arg.Scopes,
+		arg.Trusted, arg.Status, user_id, arg.Client_id, arg.Domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_oauth_client_update", err
+	}
+	return "success", nil
+}
+
+func (OauthClientModel) Delete(domain_id, client_id string) (string, error) {
+	_, err := dbobj.Exec(sys_rdbms_111, client_id, domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_oauth_client_delete", err
+	}
+	return "success", nil
+}
+
+// 重置机密应用的密钥,旧密钥立即失效
+func (OauthClientModel) ResetSecret(domain_id, client_id, user_id string) (string, string, error) {
+	secret, hash, err := genClientSecret()
+	if err != nil {
+		logs.Error(err)
+		return "", "error_oauth_client_secret", err
+	}
+	rst, err := dbobj.Exec(sys_rdbms_112, hash, user_id, client_id, domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "", "error_oauth_client_secret", err
+	}
+	if cnt, err := rst.RowsAffected(); err != nil || cnt != 1 {
+		return "", "error_oauth_client_secret", errors.New("client is not found, or client is public.")
+	}
+	return secret, "success", nil
+}
+
+// 校验应用信息,并把回调地址,授权方式与授权范围整理成空格分隔的格式
+func (OauthClientModel) check(arg *OauthClientData) (string, error) {
+	if validator.IsEmpty(arg.Client_name) {
+		return "error_oauth_client_name", errors.New("error_oauth_client_name")
+	}
+	if !validator.IsIn(arg.Trusted, "0", "1") {
+		return "error_oauth_client_trusted", errors.New("error_oauth_client_trusted")
+	}
+
+	grants := strings.Fields(strings.Replace(arg.Grant_types, ",", " ", -1))
+	if len(grants) == 0 {
+		return "error_oauth_grant_type", errors.New("error_oauth_grant_type")
+	}
+	code_flow := false
+	for _, g := range grants {
+		switch g {
+		case hrpc.GrantAuthorizationCode:
+			code_flow = true
+		case hrpc.GrantClientCredentials:
+			// 公开应用没有密钥,不能使用客户端凭证流程
+			if arg.Client_type == hrpc.OauthClientPublic {
+				return "error_oauth_grant_type", errors.New("error_oauth_grant_type")
+			}
+		default:
+			return "error_oauth_grant_type", errors.New("error_oauth_grant_type")
+		}
+	}
+
+	uris := strings.Fields(strings.Replace(arg.Redirect_uris, ",", " ", -1))
+	if code_flow && len(uris) == 0 {
+		return "error_oauth_redirect_uri", errors.New("error_oauth_redirect_uri")
+	}
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.Fragment != "" {
+			return "error_oauth_redirect_uri", errors.New("error_oauth_redirect_uri")
+		}
+	}
+
+	scopes := strings.Fields(strings.Replace(arg.Scopes, ",", " ", -1))
+	for _, s := range scopes {
+		if !validator.IsIn(s, hrpc.OidcScopes...) {
+			return "error_oauth_scope", errors.New("error_oauth_scope")
+		}
+	}
+
+	arg.Grant_types = strings.Join(grants, " ")
+	arg.Redirect_uris = strings.Join(uris, " ")
+	arg.Scopes = strings.Join(scopes, " ")
+	return "success", nil
+}
+
+// 生成应用密钥,返回密钥明文与bcrypt摘要
+func genClientSecret() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(buf)
+	hash, err := hpasswd.Hash(secret)
+	return secret, hash, err
+}
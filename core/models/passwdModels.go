@@ -4,19 +4,32 @@ import (
 	"errors"
 
 	"github.com/hzwy23/hauth/core/hrpc"
-	"github.com/hzwy23/dbobj"
 	"github.com/hzwy23/hauth/utils/logs"
 )
 
 type PasswdModels struct {
 }
 
-func (r PasswdModels) UpdateMyPasswd(newPd, User_id, oriEn string) (string, error) {
-	flag, _, _, _ := hrpc.CheckPasswd(User_id, oriEn)
+// 用户修改自己的密码,newPd与oriPd都是密码明文
+// 密码过期的用户也可以修改密码,所以这里只校验旧密码是否正确
+func (r PasswdModels) UpdateMyPasswd(newPd, User_id, oriPd string) (string, error) {
+	flag, _, _, _ := hrpc.VerifyPasswd(User_id, oriPd)
 	if !flag {
 		return "error_old_passwd", errors.New("error_old_passwd")
 	}
-	_, err := dbobj.Exec(sys_rdbms_014, newPd, User_id, oriEn)
+
+	domain_id, err := hrpc.GetDomainId(User_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_user_no_domain", err
+	}
+
+	msg, err := hrpc.CheckPasswdPolicy(domain_id, User_id, newPd)
+	if err != nil {
+		return msg, err
+	}
+
+	err = r.UpdateUserPasswd(newPd, User_id, false)
 	if err != nil {
 		logs.Error(err)
 		return "error_passwd_modify", err
@@ -24,7 +37,8 @@ func (r PasswdModels) UpdateMyPasswd(newPd, User_id, oriEn string) (string, erro
 	return "success", nil
 }
 
-func (r PasswdModels) UpdateUserPasswd(newPd, userid string) error {
-	_, err := dbobj.Exec(sys_rdbms_015, newPd, userid)
-	return err
+// 更新用户密码,newPd是密码明文,保存前计算摘要
+// force_change为true时,用户下次登录时必须修改密码
+func (r PasswdModels) UpdateUserPasswd(newPd, userid string, force_change bool) error {
+	return hrpc.SetPasswd(userid, newPd, force_change)
 }
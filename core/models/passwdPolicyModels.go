@@ -0,0 +1,87 @@
+package models
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/validator"
+)
+
+type PasswdPolicyModel struct {
+}
+
+// 查询域的密码策略,域没有配置时,返回全局密码策略
+func (PasswdPolicyModel) Get(domain_id string) hrpc.PasswdPolicy {
+	return hrpc.GetPasswdPolicy(domain_id)
+}
+
+// 新增或者更新域的密码策略
+func (PasswdPolicyModel) Put(data url.Values, user_id string) (string, error) {
+	domain_id := data.Get("domain_id")
+	if validator.IsEmpty(domain_id) {
+		return "error_passwd_policy_domain", errors.New("error_passwd_policy_domain")
+	}
+
+	var nums = make(map[string]int)
+	for _, key := range []string{"min_length", "history_cnt", "max_age"} {
+		n, err := strconv.Atoi(data.Get(key))
+		if err != nil || n < 0 {
+			return "error_passwd_policy_number", errors.New("error_passwd_policy_number")
+		}
+		nums[key] = n
+	}
+	if nums["min_length"] < 6 || nums["min_length"] > hpasswd.MaxLength {
+		return "error_passwd_short", errors.New("error_passwd_short")
+	}
+
+	flag := func(key string) string {
+		if data.Get(key) == "1" || data.Get(key) == "true" {
+			return "1"
+		}
+		return "0"
+	}
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return "error_sql_begin", err
+	}
+
+	_, err = tx.Exec(sys_rdbms_103, domain_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return "error_passwd_policy_update", err
+	}
+
+	_, err = tx.Exec(sys_rdbms_104, domain_id, nums["min_length"], flag("require_upper"), flag("require_lower"),
+		flag("require_digit"), flag("require_special"), flag("forbid_user_id"), nums["history_cnt"],
+		nums["max_age"], flag("reset_change"), user_id)
+	if err != nil {
+		tx.Rollback()
+		logs.Error(err)
+		return "error_passwd_policy_update", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logs.Error(err)
+		return "error_passwd_policy_update", err
+	}
+	return "success", nil
+}
+
+// 删除域的密码策略,删除后,域中的用户使用全局密码策略
+func (PasswdPolicyModel) Delete(domain_id string) (string, error) {
+	_, err := dbobj.Exec(sys_rdbms_103, domain_id)
+	if err != nil {
+		logs.Error(err)
+		return "error_passwd_policy_delete", err
+	}
+	return "success", nil
+}
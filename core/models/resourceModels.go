@@ -6,7 +6,9 @@ import (
 	"net/url"
 
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/routematch"
 	"github.com/hzwy23/hauth/utils/validator"
 )
 
@@ -75,6 +77,7 @@ func (this *ResourceModel) Post(data url.Values) (string, error) {
 	group_id := data.Get("group_id")
 	sort_id := data.Get("sort_id")
 	res_open_type := data.Get("res_open_type")
+	res_method := data.Get("res_method")
 
 	res_attr := "1"
 	if res_type == "0" || res_type == "4" {
@@ -180,6 +183,12 @@ func (this *ResourceModel) Post(data url.Values) (string, error) {
 			logs.Error("菜单路由地址不能为空")
 			return "error_resource_route_uri", errors.New("error_resource_route_uri")
 		}
+
+		// 路由地址可以包含占位符与通配符
+		if _, err := routematch.Compile(res_method, res_url); err != nil {
+			logs.Error("API路由地址或者请求方法格式错误", err)
+			return "error_resource_route_pattern", err
+		}
 		sort_id = "0"
 		res_img = ""
 		group_id = ""
@@ -201,6 +210,7 @@ func (this *ResourceModel) Post(data url.Values) (string, error) {
 			logs.Error(err)
 			return "error_resource_exec", errors.New("error_resource_exec")
 		}
+		hrpc.InvalidatePermissions()
 		return "success", nil
 	default:
 		return "error_resource_type", errors.New("error_resource_type")
@@ -221,15 +231,15 @@ func (this *ResourceModel) Post(data url.Values) (string, error) {
 	}
 
 	// update sys_theme_value
-	_, err = tx.Exec(sys_rdbms_073, theme_id, res_id, res_url, res_open_type, res_bg_color, res_class, group_id, res_img, sort_id)
+	_, err = tx.Exec(sys_rdbms_073, theme_id, res_id, res_url, res_open_type, res_bg_color, res_class, group_id, res_img, sort_id, res_method)
 	if err != nil {
 		logs.Error(err)
 		tx.Rollback()
 		return "error_resource_theme_add", err
 	}
 
-	// 将新增资源授权给admin
-	_, err = tx.Exec(sys_rdbms_074, "vertex_root_join_sysadmin", res_id)
+	// 将新增资源授权给超级管理员角色
+	_, err = tx.Exec(sys_rdbms_128, res_id)
 	if err != nil {
 		logs.Error(err)
 		tx.Rollback()
@@ -239,6 +249,7 @@ func (this *ResourceModel) Post(data url.Values) (string, error) {
 		logs.Error(err)
 		return "error_resource_commit", err
 	}
+	hrpc.InvalidatePermissions()
 	return "success", nil
 }
 
@@ -255,7 +266,7 @@ func (this ResourceModel) addButtonMenu(res_id, res_name, res_attr, res_up_id, r
 		tx.Rollback()
 		return err
 	}
-	_, err = tx.Exec(sys_rdbms_074, "vertex_root_join_sysadmin", res_id)
+	_, err = tx.Exec(sys_rdbms_128, res_id)
 	if err != nil {
 		logs.Error(err)
 		tx.Rollback()
@@ -318,7 +329,11 @@ func (this *ResourceModel) Delete(res_id string) (string, error) {
 		}
 
 	}
-	return "error_resource_commit", tx.Commit()
+	err = tx.Commit()
+	if err == nil {
+		hrpc.InvalidatePermissions()
+	}
+	return "error_resource_commit", err
 }
 
 func (this *ResourceModel) Update(res_id, res_name string) (string, error) {
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/logs"
 )
 
@@ -19,6 +20,7 @@ type themeData struct {
 	Group_id     string `json:"group_id"`
 	Res_img      string `json:"res_img"`
 	Sort_id      string `json:"sort_id"`
+	Res_method   string `json:"res_method"`
 }
 
 func (this ThemeResourceModel) Get(theme_id string) ([]themeData, error) {
@@ -44,15 +46,20 @@ func (this ThemeResourceModel) GetDetails(res_id string, theme_id string) ([]the
 	return rst, err
 }
 
-func (this ThemeResourceModel) Update(res_url, res_by_color, res_class, res_img, res_group_id, res_sort_id, theme_id, res_id, res_open_type string) error {
-	_, err := dbobj.Exec(sys_rdbms_009, res_url, res_by_color, res_class, res_img, res_group_id, res_sort_id, res_open_type, theme_id, res_id)
+func (this ThemeResourceModel) Update(res_url, res_by_color, res_class, res_img, res_group_id, res_sort_id, theme_id, res_id, res_open_type, res_method string) error {
+	_, err := dbobj.Exec(sys_rdbms_009, res_url, res_by_color, res_class, res_img, res_group_id, res_sort_id, res_open_type, res_method, theme_id, res_id)
+	if err == nil {
+		hrpc.InvalidatePermissions()
+	}
 	return err
 }
 
-func (this ThemeResourceModel) Post(theme_id, res_id, res_url, res_class, res_img, res_by_color, res_group_id, res_sort_id, res_type string) (string, error) {
-
-	_, err := dbobj.Exec(sys_rdbms_008, theme_id, res_id, res_url, res_type, res_by_color, res_class, res_group_id, res_img, res_sort_id)
+func (this ThemeResourceModel) Post(theme_id, res_id, res_url, res_class, res_img, res_by_color, res_group_id, res_sort_id, res_type, res_method string) (string, error) {
 
+	_, err := dbobj.Exec(sys_rdbms_008, theme_id, res_id, res_url, res_type, res_by_color, res_class, res_group_id, res_img, res_sort_id, res_method)
+	if err == nil {
+		hrpc.InvalidatePermissions()
+	}
 	return "error_resource_addTheme", err
 
 }
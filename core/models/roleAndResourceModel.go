@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/logs"
 )
 
@@ -14,6 +15,12 @@ type RoleResourceRelData struct {
 	Res_id  string `json:"res_id"`
 }
 
+// 角色拥有的资源,From_role不为空时,表示资源是从这个上级角色继承的
+type roleResData struct {
+	resData
+	From_role string `json:"from_role"`
+}
+
 func (this RoleAndResourceModel) Delete(role_id, res_id string) error {
 
 	var rst []resData
@@ -54,7 +61,12 @@ func (this RoleAndResourceModel) Delete(role_id, res_id string) error {
 			return err
 		}
 	}
-	return tx.Commit()
+	if err = tx.Commit(); err != nil {
+		logs.Error(err)
+		return err
+	}
+	hrpc.InvalidatePermissions()
+	return nil
 }
 
 func (this RoleAndResourceModel) Post(role_id, res_id string) error {
@@ -132,7 +144,12 @@ func (this RoleAndResourceModel) Post(role_id, res_id string) error {
 			return err
 		}
 	}
-	return tx.Commit()
+	if err = tx.Commit(); err != nil {
+		logs.Error(err)
+		return err
+	}
+	hrpc.InvalidatePermissions()
+	return nil
 }
 
 // 查询没有获取到的资源信息
@@ -140,8 +157,8 @@ func (this RoleAndResourceModel) UnGetted(role_id string) ([]resData, error) {
 
 	var rst []resData
 
-	// 获取角色已经拥有了的资源id
-	role_res, err := this.get(role_id)
+	// 获取角色已经拥有了的资源id,包括从上级角色继承的资源
+	role_res, err := this.effective(role_id)
 	if err != nil {
 		logs.Error(err)
 		return nil, err
@@ -177,7 +194,7 @@ func (this RoleAndResourceModel) UnGetted(role_id string) ([]resData, error) {
 	return rst, nil
 }
 
-// 查询角色已经拥有的资源信息
+// 查询角色自身被授予的资源信息,不包括从上级角色继承的资源
 func (this RoleAndResourceModel) Get(role_id string) ([]resData, error) {
 
 	var rst []resData
@@ -209,13 +226,56 @@ func (this RoleAndResourceModel) Get(role_id string) ([]resData, error) {
 	return rst, nil
 }
 
-// 获取某些角色,指定资源的所有下级资源
+// 查询角色拥有的资源信息,包括从上级角色继承的资源
+func (this RoleAndResourceModel) GetAll(role_id string) ([]roleResData, error) {
+
+	var rst []roleResData
+
+	role_res, err := this.effective(role_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	rst_res, err := this.mres.Get()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	// 角色自身的授权排在前面,同一个资源既是自身授权又是继承的授权时,作为自身授权
+	from := make(map[string]string)
+	for _, val := range role_res {
+		if _, ok := from[val.Res_id]; ok {
+			continue
+		}
+		if val.Role_id == role_id {
+			from[val.Res_id] = ""
+		} else {
+			from[val.Res_id] = val.Role_id
+		}
+	}
+
+	for _, res := range rst_res {
+		if role, ok := from[res.Res_id]; ok {
+			var one roleResData
+			one.Res_id = res.Res_id
+			one.Res_name = res.Res_name
+			one.Res_up_id = res.Res_up_id
+			one.From_role = role
+			rst = append(rst, one)
+		}
+	}
+	return rst, nil
+}
+
+// 获取某些角色,指定资源的所有下级资源,包括从上级角色继承的资源
 func (this RoleAndResourceModel) Gets(roles []string, res_id ...string) ([]resData, error) {
 
 	var rst []resData
 	var role_res map[string]string = make(map[string]string)
 	for _, val := range roles {
-		tmp, err := this.get(val)
+		tmp, err := this.effective(val)
 		if err != nil {
 			logs.Error(err)
 			return nil, err
@@ -293,6 +353,25 @@ func (this RoleAndResourceModel) search(rst, all []resData) []resData {
 	return tmp
 }
 
+// 获取指定角色以及所有上级角色拥有的资源ID列表,Role_id是授权资源的角色,按照角色的层次排序
+func (this RoleAndResourceModel) effective(role_id string) ([]RoleResourceRelData, error) {
+
+	var rst []RoleResourceRelData
+	rows, err := dbobj.Query(sys_rdbms_134, role_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	err = dbobj.Scan(rows, &rst)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	return rst, nil
+}
+
 // 获取指定角色拥有的资源ID列表
 func (this RoleAndResourceModel) get(role_id string) ([]RoleResourceRelData, error) {
 
@@ -1,10 +1,12 @@
 package models
 
 import (
+	"database/sql"
 	"errors"
 	"net/url"
 
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils"
 	"github.com/hzwy23/hauth/utils/logs"
 	"github.com/hzwy23/hauth/utils/validator"
@@ -25,6 +27,7 @@ type RoleInfo struct {
 	Role_maintance_date string `json:"modify_date"`
 	Role_maintance_user string `json:"modify_user"`
 	Role_id             string `json:"role_id"`
+	Parent_role_id      string `json:"parent_role_id"`
 }
 
 // 查询某一个角色的具体信息
@@ -67,6 +70,7 @@ func (RoleModel) Post(data url.Values, user_id string) (string, error) {
 	roleid := data.Get("role_id")
 	rolename := data.Get("role_name")
 	rolestatus := data.Get("role_status")
+	parent_role_id := data.Get("parent_role_id")
 	id := utils.JoinCode(domainid, roleid)
 
 	//校验
@@ -86,14 +90,43 @@ func (RoleModel) Post(data url.Values, user_id string) (string, error) {
 		return "error_role_status", errors.New("error_role_status")
 	}
 
-	_, err := dbobj.Exec(sys_rdbms_026, id, rolename, user_id, rolestatus, domainid, user_id, roleid)
+	if msg, err := checkParentRole(id, parent_role_id); err != nil {
+		return msg, err
+	}
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return "error_sql_begin", err
+	}
+	if msg, err := lockParentRole(tx, id, parent_role_id); err != nil {
+		tx.Rollback()
+		return msg, err
+	}
+	_, err = tx.Exec(sys_rdbms_026, id, rolename, user_id, rolestatus, domainid, user_id, roleid, nullString(parent_role_id))
 	if err != nil {
+		logs.Error(err)
+		tx.Rollback()
+		return "error_role_add_failed", err
+	}
+	_, err = tx.Exec(sys_rdbms_133, id, id, 0)
+	if err != nil {
+		logs.Error(err)
+		tx.Rollback()
+		return "error_role_add_failed", err
+	}
+	if err = moveRoleAncestors(tx, id, parent_role_id); err != nil {
+		tx.Rollback()
+		return "error_role_add_failed", err
+	}
+	if err = tx.Commit(); err != nil {
 		logs.Error(err)
 		return "error_role_add_failed", err
 	}
 	return "success", nil
 }
 
+// 角色的祖先关系通过外键级联删除,有下级角色的角色不能删除
 func (RoleModel) Delete(allrole []RoleInfo) (string, error) {
 	tx, err := dbobj.Begin()
 	if err != nil {
@@ -115,13 +148,17 @@ func (RoleModel) Delete(allrole []RoleInfo) (string, error) {
 		logs.Error(err)
 		return "error_role_delete_failed", err
 	}
+	hrpc.InvalidatePermissions()
 	return "success", nil
 }
 
-func (RoleModel) Update(data url.Values, user_id string) (string, error) {
+// 表单中没有上级角色时,不修改上级角色
+func (this RoleModel) Update(data url.Values, user_id string) (string, error) {
 	Role_id := data.Get("Role_id")
 	Role_name := data.Get("Role_name")
 	Role_status := data.Get("Role_status")
+	Parent_role_id := data.Get("Parent_role_id")
+	_, set_parent := data["Parent_role_id"]
 
 	if !validator.IsWord(Role_id) {
 		return "error_role_id_format", errors.New("error_role_id_format")
@@ -135,10 +172,216 @@ func (RoleModel) Update(data url.Values, user_id string) (string, error) {
 		return "error_role_status", errors.New("error_role_status")
 	}
 
-	_, err := dbobj.Exec(sys_rdbms_050, Role_name, Role_status, user_id, Role_id)
+	if set_parent {
+		row, err := this.GetRow(Role_id)
+		if err != nil {
+			return "error_role_update_failed", err
+		}
+		set_parent = row.Parent_role_id != Parent_role_id
+	}
+	if set_parent {
+		if msg, err := checkParentRole(Role_id, Parent_role_id); err != nil {
+			return msg, err
+		}
+	}
+
+	tx, err := dbobj.Begin()
+	if err != nil {
+		logs.Error(err)
+		return "error_sql_begin", err
+	}
+	if set_parent {
+		if msg, err := lockParentRole(tx, Role_id, Parent_role_id); err != nil {
+			tx.Rollback()
+			return msg, err
+		}
+	}
+	_, err = tx.Exec(sys_rdbms_050, Role_name, Role_status, user_id, Role_id)
 	if err != nil {
 		logs.Error(err)
+		tx.Rollback()
 		return "error_role_update_failed", errors.New("error_role_update_failed")
 	}
+	if set_parent {
+		_, err = tx.Exec(sys_rdbms_138, nullString(Parent_role_id), Role_id)
+		if err != nil {
+			logs.Error(err)
+			tx.Rollback()
+			return "error_role_update_failed", err
+		}
+		if err = moveRoleAncestors(tx, Role_id, Parent_role_id); err != nil {
+			tx.Rollback()
+			return "error_role_update_failed", err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Error(err)
+		return "error_role_update_failed", err
+	}
+	if set_parent {
+		// 上级角色变化后,拥有这个角色以及下级角色的用户权限都会变化
+		hrpc.InvalidatePermissions()
+	}
 	return "success", nil
 }
+
+// HasChildren 判断角色是否有下级角色
+func (RoleModel) HasChildren(role_id string) (bool, error) {
+	cnt := 0
+	if err := dbobj.QueryRow(sys_rdbms_135, role_id).Scan(&cnt); err != nil {
+		logs.Error(err)
+		return false, err
+	}
+	return cnt > 0, nil
+}
+
+// 查询所有角色的上级角色
+func roleParents() (map[string]string, error) {
+	rows, err := dbobj.Query(sys_rdbms_131)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	parents := make(map[string]string)
+	for rows.Next() {
+		var role_id, parent_role_id string
+		if err = rows.Scan(&role_id, &parent_role_id); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		parents[role_id] = parent_role_id
+	}
+	return parents, nil
+}
+
+// 校验上级角色:上级角色必须是同一个域中已经存在的角色,不能是超级管理员角色,
+// 并且设置上级角色后不能形成环
+func checkParentRole(role_id, parent_role_id string) (string, error) {
+	if parent_role_id == "" {
+		return "success", nil
+	}
+	if parent_role_id == role_id {
+		return "error_role_parent_cycle", errors.New("error_role_parent_cycle")
+	}
+
+	did, _ := utils.SplitDomain(role_id)
+	pdid, err := utils.SplitDomain(parent_role_id)
+	if err != nil || did != pdid {
+		return "error_role_parent_domain", errors.New("error_role_parent_domain")
+	}
+
+	if hrpc.IsSuperAdminRole(parent_role_id) {
+		return "error_role_parent_super_admin", errors.New("error_role_parent_super_admin")
+	}
+
+	parents, err := roleParents()
+	if err != nil {
+		return "error_role_parent_query", err
+	}
+	if _, ok := parents[parent_role_id]; !ok {
+		return "error_role_parent_not_exists", errors.New("error_role_parent_not_exists")
+	}
+
+	// 从上级角色向上查找,找到角色自身说明形成了环
+	visited := make(map[string]bool)
+	for id := parent_role_id; id != "" && !visited[id]; id = parents[id] {
+		if id == role_id {
+			return "error_role_parent_cycle", errors.New("error_role_parent_cycle")
+		}
+		visited[id] = true
+	}
+	return "success", nil
+}
+
+// checkParentRole 在事务之外校验,并发修改上级角色时可能都通过校验后形成环.
+// 修改上级角色的事务先锁定域中的所有角色,再根据祖先关系重新校验上级角色.
+func lockParentRole(tx *sql.Tx, role_id, parent_role_id string) (string, error) {
+	if parent_role_id == "" {
+		return "success", nil
+	}
+	did, _ := utils.SplitDomain(role_id)
+	rows, err := tx.Query(sys_rdbms_139, did)
+	if err != nil {
+		logs.Error(err)
+		return "error_role_parent_query", err
+	}
+	rows.Close()
+
+	ancestors, err := roleDepths(tx, sys_rdbms_137, parent_role_id)
+	if err != nil {
+		return "error_role_parent_query", err
+	}
+	// 每个角色都有一条指向自身的祖先关系,没有祖先关系说明上级角色已经被删除
+	if len(ancestors) == 0 {
+		return "error_role_parent_not_exists", errors.New("error_role_parent_not_exists")
+	}
+	if _, ok := ancestors[role_id]; ok {
+		return "error_role_parent_cycle", errors.New("error_role_parent_cycle")
+	}
+	return "success", nil
+}
+
+// 设置角色的上级角色后,更新角色以及所有下级角色的祖先关系(sys_role_ancestor_relat).
+// 每个角色包含自身(depth = 0)以及所有的上级角色,授权查询通过祖先关系继承上级角色的资源.
+// 只删除这些角色到原上级角色的祖先关系,再添加到新上级角色的祖先关系,其他角色不受影响.
+func moveRoleAncestors(tx *sql.Tx, role_id, parent_role_id string) error {
+	// 下级角色与角色之间的深度,下级角色的祖先中深度更大的都在角色之外
+	subtree, err := roleDepths(tx, sys_rdbms_136, role_id)
+	if err != nil {
+		return err
+	}
+	for id, depth := range subtree {
+		if _, err = tx.Exec(sys_rdbms_132, id, depth); err != nil {
+			logs.Error(err)
+			return err
+		}
+	}
+	if parent_role_id == "" {
+		return nil
+	}
+
+	ancestors, err := roleDepths(tx, sys_rdbms_137, parent_role_id)
+	if err != nil {
+		return err
+	}
+	for id, depth := range subtree {
+		for ancestor_id, adepth := range ancestors {
+			if _, err = tx.Exec(sys_rdbms_133, id, ancestor_id, depth+adepth+1); err != nil {
+				logs.Error(err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 查询祖先关系,返回角色编码与深度
+func roleDepths(tx *sql.Tx, query string, role_id string) (map[string]int, error) {
+	rows, err := tx.Query(query, role_id)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	rst := make(map[string]int)
+	for rows.Next() {
+		id, depth := "", 0
+		if err = rows.Scan(&id, &depth); err != nil {
+			logs.Error(err)
+			return nil, err
+		}
+		rst[id] = depth
+	}
+	return rst, nil
+}
+
+// 空字符串保存为NULL
+func nullString(val string) interface{} {
+	if val == "" {
+		return nil
+	}
+	return val
+}
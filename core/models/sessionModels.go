@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type SessionModel struct {
+}
+
+type SessionData struct {
+	Session_id  string `json:"session_id"`
+	User_id     string `json:"user_id"`
+	Domain_id   string `json:"domain_id"`
+	Client_ip   string `json:"client_ip"`
+	User_agent  string `json:"user_agent"`
+	Login_time  string `json:"login_time"`
+	Last_active string `json:"last_active"`
+	Expire_time string `json:"expire_time"`
+	Current     bool   `json:"current"`
+}
+
+// 查询用户有效的会话
+func (SessionModel) Get(user_id string) ([]SessionData, error) {
+	rows, err := dbobj.Query(sys_rdbms_126, user_id, time.Now().Unix())
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []SessionData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 查询域中所有用户有效的会话
+func (SessionModel) GetDomain(domain_id string) ([]SessionData, error) {
+	rows, err := dbobj.Query(sys_rdbms_127, domain_id, time.Now().Unix())
+	defer rows.Close()
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+
+	var rst []SessionData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
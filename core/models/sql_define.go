@@ -8,41 +8,37 @@ var (
 	sys_rdbms_005 = `update sys_resource_info set res_name = ? where res_id = ?`
 	sys_rdbms_006 = `select count(*) from sys_theme_value where theme_id = ? and res_id = ?`
 	sys_rdbms_007 = `delete from sys_user_info where user_id = ? and org_unit_id = ?`
-	sys_rdbms_008 = `insert into sys_theme_value(uuid,theme_id,res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id) value(uuid(),?,?,?,?,?,?,?,?,?)`
-	sys_rdbms_009 = `update sys_theme_value set res_url = ?, res_bg_color = ?, res_class = ?, res_img = ?, group_id = ?, sort_id = ?, res_type = ? where theme_id = ? and res_id = ?`
-	sys_rdbms_010 = `select user_id,user_passwd,status_id,continue_error_cnt from sys_sec_user where user_id = ?`
+	sys_rdbms_008 = `insert into sys_theme_value(uuid,theme_id,res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id,res_method) value(uuid(),?,?,?,?,?,?,?,?,?,?)`
+	sys_rdbms_009 = `update sys_theme_value set res_url = ?, res_bg_color = ?, res_class = ?, res_img = ?, group_id = ?, sort_id = ?, res_type = ?, res_method = ? where theme_id = ? and res_id = ?`
 	sys_rdbms_011 = `select distinct t2.res_url from sys_user_theme t1 inner join sys_theme_value t2 on t1.theme_id = t2.theme_id inner join sys_resource_info t3 on t2.res_id = t3.res_id where t1.user_id = ? and t2.res_id = ? and t3.res_type = '0'`
-	sys_rdbms_012 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? order by handle_time desc`
+	sys_rdbms_012 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? order by handle_time desc`
 	sys_rdbms_013 = `select res_type from sys_resource_info where res_id = ?`
-	sys_rdbms_014 = `update sys_sec_user set user_passwd = ? where user_id = ? and user_passwd = ?`
-	sys_rdbms_015 = `update sys_sec_user set user_passwd = ? where user_id = ?`
-	sys_rdbms_016 = `update sys_sec_user set status_id = ? ,continue_error_cnt = '0' where user_id = ?`
-	sys_rdbms_017 = `select t.user_id,t.user_name,a.status_desc,t.user_create_date, t.user_owner,t.user_email,t.user_phone,i.org_unit_id,i.org_unit_desc, di.domain_id,di.domain_name,t.user_maintance_date,t.user_maintance_user,u.status_id from sys_user_info t inner join sys_sec_user u on t.user_id = u.user_id inner join sys_user_status_attr a on u.status_id = a.status_id inner join sys_org_info i on i.org_unit_id = t.org_unit_id inner join sys_domain_info di on i.domain_id = di.domain_id where di.domain_id = ?`
+	sys_rdbms_016 = `update sys_sec_user set status_id = ?, continue_error_cnt = 0, lock_cnt = 0, lock_reason = ?, lock_time = ?, unlock_time = 0 where user_id = ?`
+	sys_rdbms_017 = `select t.user_id,t.user_name,a.status_desc,t.user_create_date, t.user_owner,t.user_email,t.user_phone,i.org_unit_id,i.org_unit_desc, di.domain_id,di.domain_name,t.user_maintance_date,t.user_maintance_user,u.status_id,u.lock_reason,u.lock_time,u.unlock_time,u.account_type from sys_user_info t inner join sys_sec_user u on t.user_id = u.user_id inner join sys_user_status_attr a on u.status_id = a.status_id inner join sys_org_info i on i.org_unit_id = t.org_unit_id inner join sys_domain_info di on i.domain_id = di.domain_id where di.domain_id = ?`
 	sys_rdbms_018 = `insert into sys_user_info (user_id,user_name,user_create_date,user_owner,user_email,user_phone,org_unit_id,user_maintance_date,user_maintance_user) values(?,?,now(),?,?,?,?,now(),?)`
-	sys_rdbms_019 = `insert into sys_sec_user(user_id,user_passwd,status_id) values(?,?,?)`
-	sys_rdbms_020 = `update sys_sec_user set user_passwd = ? where user_id = ?`
+	sys_rdbms_019 = `insert into sys_sec_user(user_id,user_passwd,status_id,passwd_time,force_change,account_type) values(?,?,?,?,?,?)`
 	sys_rdbms_021 = `update sys_user_info t set t.user_name = ?, t.user_phone = ?, t.user_email = ? ,t.user_maintance_date = now(), t.user_maintance_user = ?,t.org_unit_id = ? where t.user_id = ?`
-	sys_rdbms_022 = `select count(*) from sys_role_user_relation r inner join sys_role_resource_relat e on r.role_id = e.role_id inner join sys_theme_value v on e.res_id = v.res_id inner join sys_user_theme m on v.theme_id = m.theme_id and r.user_id = m.user_id where r.user_id = ? and v.res_url = ?`
-	sys_rdbms_023 = `select t.user_id,t.user_name,a.status_desc,t.user_create_date, t.user_owner,t.user_email,t.user_phone,i.org_unit_id,i.org_unit_desc,di.domain_id,di.domain_name,t.user_maintance_date,t.user_maintance_user,u.status_id from sys_user_info t inner join sys_sec_user u on t.user_id = u.user_id inner join sys_user_status_attr a on u.status_id = a.status_id inner join sys_org_info i on i.org_unit_id = t.org_unit_id inner join sys_domain_info di on i.domain_id = di.domain_id where t.user_id = ?`
+	sys_rdbms_022 = `select count(*) from sys_role_user_relation r inner join sys_role_ancestor_relat s on r.role_id = s.role_id inner join sys_role_resource_relat e on s.ancestor_id = e.role_id inner join sys_theme_value v on e.res_id = v.res_id inner join sys_user_theme m on v.theme_id = m.theme_id and r.user_id = m.user_id where r.user_id = ? and v.res_url = ?`
+	sys_rdbms_023 = `select t.user_id,t.user_name,a.status_desc,t.user_create_date, t.user_owner,t.user_email,t.user_phone,i.org_unit_id,i.org_unit_desc,di.domain_id,di.domain_name,t.user_maintance_date,t.user_maintance_user,u.status_id,u.lock_reason,u.lock_time,u.unlock_time,u.account_type from sys_user_info t inner join sys_sec_user u on t.user_id = u.user_id inner join sys_user_status_attr a on u.status_id = a.status_id inner join sys_org_info i on i.org_unit_id = t.org_unit_id inner join sys_domain_info di on i.domain_id = di.domain_id where t.user_id = ?`
 	sys_rdbms_024 = `update sys_user_theme set theme_id = ? where user_id = ?`
 	sys_rdbms_025 = `select t.domain_id as project_id, t.domain_name as project_name, s.domain_status_name  as status_name, t.domain_create_date  as maintance_date, t.domain_owner as user_id,t.domain_maintance_date,t.domain_maintance_user,t.domain_status_id from sys_domain_info t inner join sys_domain_status_attr s on t.domain_status_id = s.domain_status_id`
-	sys_rdbms_026 = `insert into sys_role_info(role_id,role_name,role_owner,role_create_date,role_status_id,domain_id,role_maintance_date,role_maintance_user,code_number) values(?,?,?,now(),?,?,now(),?,?)`
+	sys_rdbms_026 = `insert into sys_role_info(role_id,role_name,role_owner,role_create_date,role_status_id,domain_id,role_maintance_date,role_maintance_user,code_number,parent_role_id) values(?,?,?,now(),?,?,now(),?,?,?)`
 	sys_rdbms_027 = `delete from sys_role_info where role_id = ? and domain_id = ?`
-	sys_rdbms_028 = `select t.code_number,t.role_name,t.role_owner,t.role_create_date,a.role_status_desc,a.role_status_id,t.domain_id,o.domain_name,t.role_maintance_date,t.role_maintance_user,t.role_id from sys_role_info t inner join sys_role_status_attr a on t.role_status_id = a.role_status_id inner join sys_domain_info o on t.domain_id = o.domain_id where t.domain_id = ?`
-	sys_rdbms_029 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? order by handle_time desc limit ?,?`
+	sys_rdbms_028 = `select t.code_number,t.role_name,t.role_owner,t.role_create_date,a.role_status_desc,a.role_status_id,t.domain_id,o.domain_name,t.role_maintance_date,t.role_maintance_user,t.role_id,coalesce(t.parent_role_id,'') as parent_role_id from sys_role_info t inner join sys_role_status_attr a on t.role_status_id = a.role_status_id inner join sys_domain_info o on t.domain_id = o.domain_id where t.domain_id = ?`
+	sys_rdbms_029 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? order by handle_time desc limit ?,?`
 	sys_rdbms_030 = `select count(*) from sys_handle_logs t where t.domain_id = ?`
-	sys_rdbms_031 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? and user_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') and handle_time < str_to_date(?,'%Y-%m-%d') order by handle_time desc`
-	sys_rdbms_032 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? and user_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') order by handle_time desc`
-	sys_rdbms_033 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') and handle_time < str_to_date(?,'%Y-%m-%d') order by handle_time desc`
+	sys_rdbms_031 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? and user_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') and handle_time < str_to_date(?,'%Y-%m-%d') order by handle_time desc`
+	sys_rdbms_032 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? and user_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') order by handle_time desc`
+	sys_rdbms_033 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') and handle_time < str_to_date(?,'%Y-%m-%d') order by handle_time desc`
 	sys_rdbms_034 = `select domain_id from sys_domain_share_info t where t.target_domain_id = ?`
-	sys_rdbms_035 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') order by handle_time desc`
+	sys_rdbms_035 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? and handle_time >= str_to_date(?,'%Y-%m-%d') order by handle_time desc`
 	sys_rdbms_036 = `insert into sys_domain_info(domain_id,domain_name,domain_status_id,domain_create_date,domain_owner,domain_maintance_date,domain_maintance_user) values(?,?,?,now(),?,now(),?)`
 	sys_rdbms_037 = `delete from sys_domain_info where domain_id = ?`
 	sys_rdbms_038 = `update sys_domain_info set domain_name = ?, domain_status_id = ?, domain_maintance_date = now(), domain_maintance_user = ? where domain_id = ?`
-	sys_rdbms_039 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? and handle_time < str_to_date(?,'%Y-%m-%d') order by handle_time desc`
-	sys_rdbms_040 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? and user_id = ? order by handle_time desc`
+	sys_rdbms_039 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? and handle_time < str_to_date(?,'%Y-%m-%d') order by handle_time desc`
+	sys_rdbms_040 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? and user_id = ? order by handle_time desc`
 	sys_rdbms_041 = `select org_unit_id,org_unit_desc,up_org_id,t.domain_id,create_date,maintance_date,create_user,maintance_user,code_number from sys_org_info t where t.domain_id = ?`
-	sys_rdbms_042 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data from sys_handle_logs t where t.domain_id = ? order by user_id,handle_time desc`
+	sys_rdbms_042 = `select uuid,user_id,handle_time,client_ip,status_code,method,url,data,coalesce(api_key,'') as api_key,coalesce(impersonator,'') as impersonator from sys_handle_logs t where t.domain_id = ? order by user_id,handle_time desc`
 	sys_rdbms_043 = `insert into sys_org_info(code_number,org_unit_desc,up_org_id,domain_id,create_date,maintance_date,create_user,maintance_user,org_unit_id) values(?,?,?,?,now(),now(),?,?,?)`
 	sys_rdbms_044 = `delete from sys_org_info where org_unit_id = ? and domain_id = ?`
 	sys_rdbms_045 = `insert into sys_user_theme(user_id,theme_id) values(?,?)`
@@ -51,10 +47,10 @@ var (
 	sys_rdbms_048 = `insert into sys_role_user_relation(uuid,role_id,user_id,maintance_date,maintance_user) values(uuid(),?,?,now(),?)`
 	sys_rdbms_050 = `update sys_role_info t set t.role_name = ? ,t.role_status_id = ?, role_maintance_date = now(), role_maintance_user = ? where t.role_id = ?`
 	sys_rdbms_069 = `update sys_org_info set org_unit_desc = ? ,up_org_id = ?, maintance_date = now(),maintance_user=? where org_unit_id = ?`
-	sys_rdbms_070 = `select t.theme_id,i.theme_desc, res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id from sys_theme_value t left join sys_theme_info i on t.theme_id = i.theme_id where t.theme_id = ? and t.res_id = ?`
+	sys_rdbms_070 = `select t.theme_id,i.theme_desc, res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id,coalesce(res_method,'') as res_method from sys_theme_value t left join sys_theme_info i on t.theme_id = i.theme_id where t.theme_id = ? and t.res_id = ?`
 	sys_rdbms_071 = `select t.res_id,t.res_name,t.res_attr, a.res_attr_desc,t.res_up_id,t.res_type,r.res_type_desc,t.sys_flag from sys_resource_info t inner join sys_resource_info_attr a on t.res_attr = a.res_attr inner join sys_resource_type_attr r on t.res_type = r.res_type`
 	sys_rdbms_072 = `insert into sys_resource_info(res_id,res_name,res_attr,res_up_id,res_type) values(?,?,?,?,?)`
-	sys_rdbms_073 = `insert into sys_theme_value(uuid,theme_id,res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id) values(uuid(),?,?,?,?,?,?,?,?,?)`
+	sys_rdbms_073 = `insert into sys_theme_value(uuid,theme_id,res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id,res_method) values(uuid(),?,?,?,?,?,?,?,?,?,?)`
 	sys_rdbms_074 = `insert into sys_role_resource_relat(uuid,role_id,res_id) values(uuid(),?,?)`
 	sys_rdbms_075 = `delete from sys_role_resource_relat where res_id = ?`
 	sys_rdbms_076 = `delete from sys_theme_value where res_id = ?`
@@ -70,13 +66,48 @@ var (
 	sys_rdbms_088 = `update sys_domain_share_info set authorization_level = ?,modify_user = ? , modify_date = now() where uuid = ?`
 	sys_rdbms_089 = `select t.res_id,t.res_name,t.res_attr, a.res_attr_desc,t.res_up_id,t.res_type,r.res_type_desc from sys_resource_info t inner join sys_resource_info_attr a on t.res_attr = a.res_attr inner join sys_resource_type_attr r on t.res_type = r.res_type where res_id = ?`
 	sys_rdbms_093 = `delete from sys_role_resource_relat where role_id = ? and res_id = ?`
-	sys_rdbms_094 = `select r.user_id, t.role_id, t.code_number,t.role_name,t.role_status_id from sys_role_info t inner join sys_role_user_relation r on t.role_id = r.role_id where r.user_id = ? and t.role_status_id = '0'`
+	sys_rdbms_094 = `select r.user_id, t.role_id, t.code_number,t.role_name,t.role_status_id,coalesce(r.org_scope,'') as org_scope,coalesce(r.org_scope_sub,'0') as org_scope_sub from sys_role_info t inner join sys_role_user_relation r on t.role_id = r.role_id where r.user_id = ? and t.role_status_id = '0'`
 	sys_rdbms_095 = `select '', t.role_id,t.code_number,t.role_name from sys_user_info i inner join sys_org_info o on i.org_unit_id = o.org_unit_id inner join sys_role_info t on o.domain_id = t.domain_id where i.user_id = ? and t.role_status_id = '0' and  not exists ( select 1 from sys_role_user_relation r where i.user_id = r.user_id and r.role_id = t.role_id )`
-	sys_rdbms_096 = `insert into sys_role_user_relation(uuid,role_id,user_id,maintance_date,maintance_user) values(?,?,?,now(),?)`
+	sys_rdbms_096 = `insert into sys_role_user_relation(uuid,role_id,user_id,maintance_date,maintance_user,org_scope,org_scope_sub) values(?,?,?,now(),?,?,?)`
 	sys_rdbms_097 = `delete from sys_role_user_relation where user_id = ? and role_id = ?`
-	sys_rdbms_098 = `update sys_sec_user set continue_error_cnt = ? where user_id = ?`
-	sys_rdbms_099 = `update sys_sec_user set status_id = 1 where user_id = ?`
 	sys_rdbms_100 = `select role_id,res_id from sys_role_resource_relat where role_id = ?`
-	sys_rdbms_101 = `select t.theme_id,i.theme_desc,res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id from sys_theme_value t inner join sys_theme_info i on t.theme_id = i.theme_id where t.theme_id = ? order by group_id,sort_id asc`
+	sys_rdbms_101 = `select t.theme_id,i.theme_desc,res_id,res_url,res_type,res_bg_color,res_class,group_id,res_img,sort_id,coalesce(res_method,'') as res_method from sys_theme_value t inner join sys_theme_info i on t.theme_id = i.theme_id where t.theme_id = ? order by group_id,sort_id asc`
 	sys_rdbms_102 = `select t.user_id,t.theme_id,i.theme_desc from sys_user_theme t inner join sys_theme_info i on t.theme_id = i.theme_id where t.user_id = ?`
+	sys_rdbms_103 = `delete from sys_passwd_policy where domain_id = ?`
+	sys_rdbms_104 = `insert into sys_passwd_policy(domain_id,min_length,require_upper,require_lower,require_digit,require_special,forbid_user_id,history_cnt,max_age,reset_change,modify_user,modify_date) values(?,?,?,?,?,?,?,?,?,?,?,now())`
+	sys_rdbms_105 = `select require_type,target_id,create_user,create_date from sys_mfa_require where (require_type = ? and target_id = ?) or (require_type = ? and target_id in (select role_id from sys_role_info where domain_id = ?)) order by require_type,target_id`
+	sys_rdbms_106 = `insert into sys_mfa_require(require_type,target_id,create_user,create_date) values(?,?,?,now())`
+	sys_rdbms_107 = `delete from sys_mfa_require where require_type = ? and target_id = ?`
+	sys_rdbms_108 = `select client_id,client_name,coalesce(redirect_uris,'') as redirect_uris,grant_types,coalesce(scopes,'') as scopes,client_type,trusted,status,domain_id,create_user,create_date,maintance_user,maintance_date from sys_oauth_client where domain_id = ? order by client_id`
+	sys_rdbms_109 = `insert into sys_oauth_client(client_id,client_name,client_secret,redirect_uris,grant_types,scopes,client_type,trusted,status,domain_id,create_user,create_date,maintance_user,maintance_date) values(?,?,?,?,?,?,?,?,?,?,?,now(),?,now())`
+	sys_rdbms_110 = `update sys_oauth_client set client_name = ?, redirect_uris = ?, grant_types = ?, scopes = ?, trusted = ?, status = ?, maintance_user = ?, maintance_date = now() where client_id = ? and domain_id = ?`
+	sys_rdbms_111 = `delete from sys_oauth_client where client_id = ? and domain_id = ?`
+	sys_rdbms_112 = `update sys_oauth_client set client_secret = ?, maintance_user = ?, maintance_date = now() where client_id = ? and domain_id = ? and client_type = '0'`
+	sys_rdbms_113 = `select client_type from sys_oauth_client where client_id = ? and domain_id = ?`
+	sys_rdbms_114 = `select domain_id,issuer,client_id,coalesce(scopes,'') as scopes,link_email,status,create_user,create_date,maintance_user,maintance_date from sys_domain_idp where domain_id = ?`
+	sys_rdbms_115 = `select count(*) from sys_domain_idp where domain_id = ?`
+	sys_rdbms_116 = `insert into sys_domain_idp(domain_id,issuer,client_id,client_secret,scopes,link_email,status,create_user,create_date,maintance_user,maintance_date) values(?,?,?,?,?,?,?,?,now(),?,now())`
+	sys_rdbms_117 = `update sys_domain_idp set issuer = ?, client_id = ?, scopes = ?, link_email = ?, status = ?, maintance_user = ?, maintance_date = now() where domain_id = ?`
+	sys_rdbms_118 = `update sys_domain_idp set client_secret = ?, maintance_user = ?, maintance_date = now() where domain_id = ?`
+	sys_rdbms_119 = `delete from sys_domain_idp where domain_id = ?`
+	sys_rdbms_120 = `select identity_id,issuer,subject,user_id,domain_id,create_time from sys_user_identity where user_id = ? order by create_time`
+	sys_rdbms_121 = `delete from sys_user_identity where identity_id = ? and user_id = ?`
+	sys_rdbms_122 = `select key_id,key_name,user_id,coalesce(scopes,'') as scopes,expire_time,last_used_time,status,create_user,create_date from sys_api_key where user_id = ? order by create_date`
+	sys_rdbms_123 = `insert into sys_api_key(key_id,key_hash,key_name,user_id,scopes,expire_time,last_used_time,status,create_user,create_date) values(?,?,?,?,?,?,0,'0',?,now())`
+	sys_rdbms_124 = `delete from sys_api_key where key_id = ? and user_id = ?`
+	sys_rdbms_125 = `select count(*) from sys_role_user_relation r inner join sys_role_ancestor_relat s on r.role_id = s.role_id inner join sys_role_resource_relat e on s.ancestor_id = e.role_id where r.user_id = ? and e.res_id = ?`
+	sys_rdbms_126 = `select session_id,user_id,domain_id,client_ip,coalesce(user_agent,'') as user_agent,login_time,last_active,expire_time from sys_user_session where user_id = ? and status = '0' and expire_time > ? order by login_time desc`
+	sys_rdbms_127 = `select session_id,user_id,domain_id,client_ip,coalesce(user_agent,'') as user_agent,login_time,last_active,expire_time from sys_user_session where domain_id = ? and status = '0' and expire_time > ? order by login_time desc`
+	sys_rdbms_128 = `insert into sys_role_resource_relat(uuid,role_id,res_id) select uuid(),role_id,? from sys_role_info where super_admin = '1'`
+	sys_rdbms_129 = `select r.user_id,coalesce(u.user_name,'') as user_name,r.role_id,i.role_name,r.maintance_user from sys_role_user_relation r inner join sys_role_info i on r.role_id = i.role_id left join sys_user_info u on r.user_id = u.user_id where i.super_admin = '1' order by r.user_id,r.role_id`
+	sys_rdbms_130 = `select user_id,role_id,action,handle_user,handle_time,client_ip from sys_super_admin_audit order by handle_time desc`
+	sys_rdbms_131 = `select role_id,coalesce(parent_role_id,'') as parent_role_id from sys_role_info`
+	sys_rdbms_132 = `delete from sys_role_ancestor_relat where role_id = ? and depth > ?`
+	sys_rdbms_133 = `insert into sys_role_ancestor_relat(role_id,ancestor_id,depth) values(?,?,?)`
+	sys_rdbms_134 = `select s.ancestor_id as role_id,e.res_id from sys_role_ancestor_relat s inner join sys_role_resource_relat e on s.ancestor_id = e.role_id where s.role_id = ? order by s.depth`
+	sys_rdbms_135 = `select count(*) from sys_role_info where parent_role_id = ?`
+	sys_rdbms_136 = `select role_id,depth from sys_role_ancestor_relat where ancestor_id = ?`
+	sys_rdbms_137 = `select ancestor_id,depth from sys_role_ancestor_relat where role_id = ?`
+	sys_rdbms_138 = `update sys_role_info set parent_role_id = ? where role_id = ?`
+	sys_rdbms_139 = `select role_id from sys_role_info where domain_id = ? for update`
 )
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+type SuperAdminModel struct {
+}
+
+type SuperAdminData struct {
+	User_id        string `json:"user_id"`
+	User_name      string `json:"user_name"`
+	Role_id        string `json:"role_id"`
+	Role_name      string `json:"role_name"`
+	Maintance_user string `json:"maintance_user"`
+}
+
+type SuperAdminAudit struct {
+	User_id     string `json:"user_id"`
+	Role_id     string `json:"role_id"`
+	Action      string `json:"action"`
+	Handle_user string `json:"handle_user"`
+	Handle_time string `json:"handle_time"`
+	Client_ip   string `json:"client_ip"`
+}
+
+// 查询拥有超级管理员角色的用户
+func (SuperAdminModel) Get() ([]SuperAdminData, error) {
+	rows, err := dbobj.Query(sys_rdbms_129)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []SuperAdminData
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
+
+// 查询超级管理员角色的授予与移除记录
+func (SuperAdminModel) Audit() ([]SuperAdminAudit, error) {
+	rows, err := dbobj.Query(sys_rdbms_130)
+	if err != nil {
+		logs.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []SuperAdminAudit
+	err = dbobj.Scan(rows, &rst)
+	return rst, err
+}
@@ -3,11 +3,12 @@ package models
 import (
 	"errors"
 	"net/url"
-	"strings"
+	"time"
 
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils"
-	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"github.com/hzwy23/hauth/utils/crypto/hpasswd"
 	"github.com/hzwy23/hauth/utils/logs"
 	"github.com/hzwy23/hauth/utils/validator"
 )
@@ -31,6 +32,10 @@ type UserInfo struct {
 	User_maintance_date string `json:"modify_date"`
 	User_maintance_user string `json:"modify_user"`
 	User_status_id      string `json:"status_cd"`
+	Lock_reason         string `json:"lock_reason"`
+	Lock_time           string `json:"lock_time"`
+	Unlock_time         string `json:"unlock_time"`
+	Account_type        string `json:"account_type"`
 }
 
 // 查询用户自己的详细信息
@@ -71,6 +76,10 @@ func (UserModel) Post(data url.Values, user_id string) (string, error) {
 	userPhone := data.Get("userPhone")
 	userOrgUnitId := data.Get("userOrgUnitId")
 	domain_id := data.Get("domainId")
+	account_type := data.Get("userType")
+	if account_type == "" {
+		account_type = hrpc.AccountTypeUser
+	}
 
 	if !validator.IsWord(userId) {
 		return "error_user_id_check", errors.New("error_user_id_check")
@@ -81,26 +90,23 @@ func (UserModel) Post(data url.Values, user_id string) (string, error) {
 		return "error_user_name_check", errors.New("error_user_name_check")
 	}
 
-	if validator.IsEmpty(password) {
-		return "error_user_passwd_check", errors.New("error_user_passwd_check")
-	}
-
-	if validator.IsEmpty(surepassword) {
-		return "error_passwd_empty", errors.New("error_passwd_empty")
-	}
-
-	if password != surepassword {
-		return "error_passwd_confirm_failed", errors.New("error_passwd_confirm_failed")
-	}
-
-	if len(strings.TrimSpace(password)) < 6 {
-		return "error_passwd_short", errors.New("error_passwd_short")
-	}
-
-	userPasswd, err := haes.Encrypt(password)
-	if err != nil {
-		logs.Error(err)
-		return "error_user_passwd_encrypt", errors.New("error_user_passwd_encrypt")
+	var userPasswd string
+	var err error
+	switch account_type {
+	case hrpc.AccountTypeUser:
+		userPasswd, err = checkNewPasswd(domain_id, userId, password, surepassword)
+		if err != nil {
+			return userPasswd, err
+		}
+	case hrpc.AccountTypeService:
+		// 服务账号不能登录,本地密码使用随机值
+		_, userPasswd, err = genClientSecret()
+		if err != nil {
+			logs.Error(err)
+			return "error_user_passwd_encrypt", errors.New("error_user_passwd_encrypt")
+		}
+	default:
+		return "error_user_type_check", errors.New("error_user_type_check")
 	}
 
 	//
@@ -133,7 +139,12 @@ func (UserModel) Post(data url.Values, user_id string) (string, error) {
 	}
 
 	// insert user passwd
-	_, err = tx.Exec(sys_rdbms_019, userId, userPasswd, userStatus)
+	// 管理员设置的初始密码,按照密码策略决定用户首次登录时是否必须修改
+	force_change := "0"
+	if account_type == hrpc.AccountTypeUser && hrpc.GetPasswdPolicy(domain_id).Reset_change {
+		force_change = "1"
+	}
+	_, err = tx.Exec(sys_rdbms_019, userId, userPasswd, userStatus, time.Now().Unix(), force_change, account_type)
 	if err != nil {
 		tx.Rollback()
 		logs.Error(err)
@@ -153,9 +164,38 @@ func (UserModel) Post(data url.Values, user_id string) (string, error) {
 		logs.Error(err)
 		return "error_user_post", err
 	}
+	if account_type == hrpc.AccountTypeUser {
+		hrpc.SavePasswdHistory(userId, userPasswd)
+	}
 	return "success", nil
 }
 
+// 校验管理员设置的初始密码,返回bcrypt摘要
+func checkNewPasswd(domain_id, user_id, password, surepassword string) (string, error) {
+	if validator.IsEmpty(password) {
+		return "error_user_passwd_check", errors.New("error_user_passwd_check")
+	}
+
+	if validator.IsEmpty(surepassword) {
+		return "error_passwd_empty", errors.New("error_passwd_empty")
+	}
+
+	if password != surepassword {
+		return "error_passwd_confirm_failed", errors.New("error_passwd_confirm_failed")
+	}
+
+	if msg, err := hrpc.CheckPasswdPolicy(domain_id, user_id, password); err != nil {
+		return msg, err
+	}
+
+	userPasswd, err := hpasswd.Hash(password)
+	if err != nil {
+		logs.Error(err)
+		return "error_user_passwd_encrypt", errors.New("error_user_passwd_encrypt")
+	}
+	return userPasswd, nil
+}
+
 // 删除用户信息
 func (UserModel) Delete(data []UserInfo) (string, error) {
 	tx, err := dbobj.Begin()
@@ -233,7 +273,12 @@ func (this UserModel) ModifyStatus(status_id, user_id string) (string, error) {
 		return "error_user_status_empty", errors.New("error_user_status_empty")
 	}
 
-	_, err := dbobj.Exec(sys_rdbms_016, status_id, user_id)
+	// 管理员锁定的用户不会自动解锁,解锁时同时清除连续输错密码导致的锁定
+	reason, lock_time := hrpc.LockReasonNone, int64(0)
+	if status_id == "1" {
+		reason, lock_time = hrpc.LockReasonAdmin, time.Now().Unix()
+	}
+	_, err := dbobj.Exec(sys_rdbms_016, status_id, reason, lock_time, user_id)
 	return "error_user_modify_status", err
 }
 
@@ -245,17 +290,18 @@ func (this UserModel) ModifyPasswd(data url.Values) (string, error) {
 		return "error_passwd_confirm_failed", errors.New("error_passwd_confirm_failed")
 	}
 
-	if len(strings.TrimSpace(confirm_password)) < 6 || len(strings.TrimSpace(confirm_password)) > 30 {
-		return "error_passwd_short", errors.New("error_passwd_short")
-	}
-
-	encry_passwd, err := haes.Encrypt(user_password)
+	domain_id, err := hrpc.GetDomainId(user_id)
 	if err != nil {
 		logs.Error(err)
-		return "error_password_encrpty", errors.New("error_password_encrpty")
+		return "error_user_no_domain", err
+	}
+
+	if msg, err := hrpc.CheckPasswdPolicy(domain_id, user_id, user_password); err != nil {
+		return msg, err
 	}
 
-	_, err = dbobj.Exec(sys_rdbms_020, encry_passwd, user_id)
+	// 管理员重置密码后,按照密码策略决定用户下次登录时是否必须修改密码
+	err = hrpc.SetPasswd(user_id, user_password, hrpc.GetPasswdPolicy(domain_id).Reset_change)
 	if err != nil {
 		logs.Error(err)
 		return "error_user_modify_passwd", err
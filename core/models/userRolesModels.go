@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils"
 	"github.com/hzwy23/hauth/utils/logs"
 )
@@ -12,6 +13,10 @@ type UserRolesModel struct {
 	Code_number string `json:"code_number"`
 	Role_name   string `json:"role_name"`
 	Role_status string `json:"role_status"`
+	// 机构范围,为空时不限制机构
+	Org_scope string `json:"org_scope"`
+	// 1 包含下级机构
+	Org_scope_sub string `json:"org_scope_sub"`
 }
 
 // 根据用户id,获取这个用户已经拥有的角色
@@ -49,7 +54,10 @@ func (UserRolesModel) Auth(data []UserRolesModel, user_id string) (string, error
 
 	for _, val := range data {
 		uuid := utils.JoinCode(val.User_id, val.Role_id)
-		_, err = tx.Exec(sys_rdbms_096, uuid, val.Role_id, val.User_id, user_id)
+		if val.Org_scope_sub != "1" {
+			val.Org_scope_sub = "0"
+		}
+		_, err = tx.Exec(sys_rdbms_096, uuid, val.Role_id, val.User_id, user_id, val.Org_scope, val.Org_scope_sub)
 		if err != nil {
 			logs.Info("用户【", val.User_id, "】已经拥有了角色【", val.Role_id, "】，无需重复授权。")
 		}
@@ -59,6 +67,7 @@ func (UserRolesModel) Auth(data []UserRolesModel, user_id string) (string, error
 		logs.Error(err)
 		return "error_user_role_commit", err
 	}
+	hrpc.InvalidatePermissions(rolesUsers(data)...)
 	return "success", nil
 }
 
@@ -83,5 +92,15 @@ func (UserRolesModel) Revoke(rst []UserRolesModel) (string, error) {
 		logs.Error(err)
 		return "error_user_role", err
 	}
+	hrpc.InvalidatePermissions(rolesUsers(rst)...)
 	return "success", nil
 }
+
+// 授权或者移除角色涉及的用户
+func rolesUsers(data []UserRolesModel) []string {
+	var rst []string
+	for _, val := range data {
+		rst = append(rst, val.User_id)
+	}
+	return rst
+}
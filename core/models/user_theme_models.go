@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/hzwy23/dbobj"
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/logs"
 )
 
@@ -27,5 +28,8 @@ func (this UserThemeModel) Get(user_id string) ([]userThemeData, error) {
 
 func (this UserThemeModel) Put(user_id, theme_id string) error {
 	_, err := dbobj.Exec(sys_rdbms_024, theme_id, user_id)
+	if err == nil {
+		hrpc.InvalidatePermissions(user_id)
+	}
 	return err
 }
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// API授权
+// /v1下的API在路由之前统一校验权限,默认拒绝:
+// 使用AuthPublic声明的API,登录后即可访问,不校验资源权限;
+// 其他API必须是用户被授权的资源,通过hrpc.BasicAuth按照请求的方法与路径校验;
+// 使用AuthDomain声明了域参数的API,参数不为空时,还必须对这个域有读取或者读写权限,
+// 域参数从表单或者JSON请求体中读取.
+//
+// 控制器不再校验API权限,只校验请求内容中的域,例如批量删除时JSON中每一条记录所属的域.
+// 路由与授权声明都在启动服务之前完成,运行期间只读.
+type domainRule struct {
+	// 域编码所在的请求参数
+	param string
+	// r 只读, w 读写
+	mode string
+}
+
+// 读取JSON请求体中域编码的最大长度
+const maxDomainBody = 1 << 20
+
+var publicRoutes = make(map[string]bool)
+var domainRoutes = make(map[string]domainRule)
+
+// AuthPublic 声明登录后即可访问的API,例如用户查询与修改自己的信息
+func AuthPublic(path ...string) {
+	for _, p := range path {
+		publicRoutes[p] = true
+	}
+}
+
+// AuthDomain 声明API的域权限,请求参数param是域编码,mode是r或者w
+func AuthDomain(path, param, mode string) {
+	if mode != "r" && mode != "w" {
+		panic("domain auth mode must be r or w, api is: " + path)
+	}
+	domainRoutes[path] = domainRule{param: param, mode: mode}
+}
+
+// CheckAuthorization 校验用户是否有权限访问API,没有权限时返回403
+func CheckAuthorization(ctx *context.Context) {
+	path := ctx.Request.URL.Path
+	if !publicRoutes[path] && !hrpc.BasicAuth(ctx.Request) {
+		hret.Error(ctx.ResponseWriter, 403, i18n.NoAuth(ctx.Request))
+		return
+	}
+
+	rule, ok := domainRoutes[path]
+	if !ok {
+		return
+	}
+	domain_id, err := requestDomain(ctx.Request, rule.param)
+	if err != nil {
+		hret.Error(ctx.ResponseWriter, 421, i18n.Get(ctx.Request, "error_unmarsh_json"), err)
+		return
+	}
+	if domain_id == "" || hrpc.DomainAuth(ctx.Request, domain_id, rule.mode) {
+		return
+	}
+	logs.Error("insufficient domain privileges, api is:", path, ", domain id is:", domain_id)
+	if rule.mode == "r" {
+		hret.Error(ctx.ResponseWriter, 403, i18n.ReadDomain(ctx.Request, domain_id))
+	} else {
+		hret.Error(ctx.ResponseWriter, 403, i18n.WriteDomain(ctx.Request, domain_id))
+	}
+}
+
+// 读取请求参数中的域编码,表单中没有时从JSON请求体中读取,
+// 读取后恢复请求体,控制器仍然可以读取完整的请求内容.
+// JSON请求体无法解析时返回错误,不能跳过域权限校验
+func requestDomain(r *http.Request, param string) (string, error) {
+	if domain_id := r.FormValue(param); domain_id != "" {
+		return domain_id, nil
+	}
+	if r.Body == nil || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxDomainBody+1))
+	r.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+	if err != nil {
+		logs.Error(err)
+		return "", err
+	}
+	if len(body) > maxDomainBody {
+		return "", errors.New("request body is too large")
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return "", nil
+	}
+
+	var js map[string]interface{}
+	if err = json.Unmarshal(body, &js); err != nil {
+		logs.Error(err)
+		return "", err
+	}
+	val, ok := js[param]
+	if !ok || val == nil {
+		return "", nil
+	}
+	domain_id, ok := val.(string)
+	if !ok {
+		return "", errors.New("domain id must be a string")
+	}
+	return domain_id, nil
+}
@@ -3,7 +3,12 @@ package service
 import (
 	"net/http"
 
+	"github.com/astaxie/beego/context"
+	"github.com/hzwy23/hauth/core/hrpc"
+	"github.com/hzwy23/hauth/utils/hret"
+	"github.com/hzwy23/hauth/utils/i18n"
 	"github.com/hzwy23/hauth/utils/jwt"
+	"github.com/hzwy23/hauth/utils/logs"
 )
 
 const redirect = `
@@ -20,8 +25,40 @@ const redirect = `
 `
 
 func CheckConnection(w http.ResponseWriter, r *http.Request) {
-	cookie, err := r.Cookie("Authorization")
-	if err != nil || !jwt.CheckToken(cookie.Value) {
+	jclaim, err := jwt.GetJwtClaims(r)
+	if err != nil || hrpc.IsRevoked(jclaim) {
 		w.Write([]byte(redirect))
+		return
+	}
+	hrpc.TouchSession(jclaim)
+}
+
+// 使用API key访问时,校验API key,并把服务账号的用户信息保存到请求中,
+// 后续的权限校验与操作日志通过jwt.GetJwtClaims读取这个用户信息
+func CheckApiKey(ctx *context.Context, key string) {
+	jclaim, err := hrpc.AuthenticateApiKey(key)
+	if err != nil {
+		logs.Warn(err)
+		ctx.ResponseWriter.Header().Set("WWW-Authenticate", `Bearer realm="hauth"`)
+		hret.Error(ctx.ResponseWriter, 401, i18n.Get(ctx.Request, "error_api_key_invalid"))
+		return
+	}
+	ctx.Request = jwt.WithClaims(ctx.Request, jclaim)
+}
+
+// 通过cookie认证的请求修改数据时,校验CSRF token
+func CheckCsrf(ctx *context.Context) {
+	if !hrpc.CheckCsrf(ctx.Request) {
+		logs.Warn("csrf token check failed, api is:", ctx.Request.URL.Path, ", client ip is:", hrpc.ClientIP(ctx.Request))
+		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_csrf_token"))
+	}
+}
+
+// 模拟用户登录时,不能访问修改用户凭证的API
+func CheckImpersonation(ctx *context.Context) {
+	jclaim, err := jwt.GetJwtClaims(ctx.Request)
+	if err == nil && !hrpc.ImpersonationAllowed(jclaim, ctx.Request.URL.Path) {
+		logs.Warn("user ", jclaim.Impersonator, " impersonating ", jclaim.UserId, " access denied api ", ctx.Request.URL.Path)
+		hret.Error(ctx.ResponseWriter, 403, i18n.Get(ctx.Request, "error_impersonate_denied"))
 	}
 }
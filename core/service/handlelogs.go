@@ -26,6 +26,9 @@ type handleLogBuf struct {
 	Req_url    string `json:"req_url"`
 	Domain_id  string `json:"domain_id"`
 	Req_body   string `json:"req_body"`
+	Api_key    string `json:"api_key"`
+	// 模拟用户登录时,实际操作的管理员
+	Impersonator string `json:"impersonator"`
 }
 
 func WriteHandleLogs(ctx *context.Context) {
@@ -43,23 +46,45 @@ func WriteHandleLogs(ctx *context.Context) {
 		one.Client_ip = ctx.Input.IP()
 		one.Req_method = ctx.Request.Method
 
-		cookie, _ := ctx.Request.Cookie("Authorization")
-		jclaim, err := jwt.ParseJwt(cookie.Value)
+		jclaim, err := jwt.GetJwtClaims(ctx.Request)
 		if err != nil {
 			one.User_id = one.Client_ip
 			one.Domain_id = one.Client_ip
 		} else {
 			one.User_id = jclaim.UserId
 			one.Domain_id = jclaim.DomainId
+			one.Api_key = jclaim.ApiKey
+			one.Impersonator = jclaim.Impersonator
 		}
-		logs.Infow("http request:", "user_id", one.User_id, "client_up", one.Client_ip, "ret_status", one.Ret_status, "req_method", one.Req_method, "req_url", one.Req_url, "domain_id", one.Domain_id, "req_body", one.Req_body)
+		logs.Infow("http request:", "user_id", one.User_id, "client_up", one.Client_ip, "ret_status", one.Ret_status, "req_method", one.Req_method, "req_url", one.Req_url, "domain_id", one.Domain_id, "req_body", one.Req_body, "api_key", one.Api_key, "impersonator", one.Impersonator)
 		log_buf <- one
 	}
 }
 
+// 密码,刷新token与重置密码token不能记录到操作日志中
+var hiddenFormKeys = map[string]bool{
+	"refresh_token":     true,
+	"reset_token":       true,
+	"state":             true,
+	"challenge":         true,
+	"code":              true,
+	"code_verifier":     true,
+	"client_secret":     true,
+	"password":          true,
+	"orapasswd":         true,
+	"newpasswd":         true,
+	"surepasswd":        true,
+	"userPasswd":        true,
+	"userPasswdConfirm": true,
+}
+
 func formencode(form url.Values) string {
 	var rst string
 	for key, val := range form {
+		if hiddenFormKeys[key] {
+			rst += "&" + key + "=******"
+			continue
+		}
 		if len(val) > 0 {
 			rst += "&" + key + "=" + val[0]
 		}
@@ -70,6 +95,14 @@ func formencode(form url.Values) string {
 	return rst[1:]
 }
 
+// 没有使用API key,或者不是模拟登录时,对应的字段为NULL
+func nullString(val string) interface{} {
+	if val == "" {
+		return nil
+	}
+	return val
+}
+
 func savelogs(log_buf []handleLogBuf) {
 	tx, err := dbobj.Begin()
 	if err != nil {
@@ -77,7 +110,7 @@ func savelogs(log_buf []handleLogBuf) {
 	}
 
 	for _, val := range log_buf {
-		_, err := tx.Exec(hauth_service_001, val.User_id, val.Client_ip, val.Ret_status, val.Req_method, val.Req_url, val.Domain_id, val.Req_body)
+		_, err := tx.Exec(hauth_service_001, val.User_id, val.Client_ip, val.Ret_status, val.Req_method, val.Req_url, val.Domain_id, val.Req_body, nullString(val.Api_key), nullString(val.Impersonator))
 		if err != nil {
 			tx.Rollback()
 			logs.Error("同步日志信息到数据库失败")
@@ -3,6 +3,7 @@ package service
 import (
 	"sync"
 
+	"github.com/hzwy23/hauth/core/hrpc"
 	"github.com/hzwy23/hauth/utils/logs"
 	"github.com/astaxie/beego"
 	"github.com/astaxie/beego/context"
@@ -35,9 +36,34 @@ func Bootstrap() {
 	}, false)
 
 	beego.InsertFilter("/v1/*", beego.BeforeRouter, func(ctx *context.Context) {
-		CheckConnection(ctx.ResponseWriter, ctx.Request)
+		// 刷新token时,访问token可能已经过期
+		if ctx.Request.URL.Path == "/v1/auth/token/refresh" {
+			return
+		}
+		if key := hrpc.RequestApiKey(ctx.Request); key != "" {
+			CheckApiKey(ctx, key)
+		} else {
+			CheckConnection(ctx.ResponseWriter, ctx.Request)
+			if !ctx.ResponseWriter.Started {
+				CheckCsrf(ctx)
+			}
+			if !ctx.ResponseWriter.Started {
+				CheckImpersonation(ctx)
+			}
+		}
+		if !ctx.ResponseWriter.Started {
+			CheckAuthorization(ctx)
+		}
 	}, false)
 
+	// 授权页面使用cookie中的登录信息
+	beego.InsertFilter("/oauth2/authorize", beego.BeforeRouter, CheckCsrf, false)
+
+	// 多个实例之间共享权限缓存
+	if h := hrpc.PermCachePeerHandler(); h != nil {
+		beego.Handler("/_groupcache/*", h)
+	}
+
 	// 注册路由信息
 	registerRouter()
 
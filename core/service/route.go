@@ -10,9 +10,28 @@ func registerRouter() {
 	beego.Get("/HomePage", controllers.HomePage)
 
 	beego.Post("/login", controllers.LoginSystem)
+	beego.Post("/login/mfa", controllers.LoginMfa)
+	beego.Post("/login/mfa/enroll", controllers.LoginMfaEnroll)
+	beego.Get("/login/oidc", controllers.LoginOidc)
+	beego.Get("/login/oidc/callback", controllers.LoginOidcCallback)
 
 	beego.Any("/logout", controllers.LogoutSystem)
 
+	beego.Post("/v1/auth/token/refresh", controllers.RefreshToken)
+
+	beego.Post("/passwd/expired", controllers.PasswdController.PostExpiredPasswd)
+	beego.Post("/passwd/forgot", controllers.PasswdController.PostForgotPasswd)
+	beego.Post("/passwd/reset", controllers.PasswdController.PostResetPasswd)
+
+	// OpenID Connect
+	beego.Get("/.well-known/openid-configuration", controllers.OauthCtl.Discovery)
+	beego.Get("/oauth2/jwks", controllers.OauthCtl.Jwks)
+	beego.Get("/oauth2/authorize", controllers.OauthCtl.Authorize)
+	beego.Post("/oauth2/authorize", controllers.OauthCtl.Consent)
+	beego.Get("/oauth2/authorize/info", controllers.OauthCtl.AuthorizeInfo)
+	beego.Post("/oauth2/token", controllers.OauthCtl.Token)
+	beego.Post("/oauth2/introspect", controllers.OauthCtl.Introspect)
+
 	beego.Get("/", controllers.IndexPage)
 
 	beego.Post("/v1/auth/theme/update", controllers.ThemeCtl.Post)
@@ -22,6 +41,43 @@ func registerRouter() {
 	beego.Get("/v1/auth/index/entry", controllers.SubSystemEntry)
 	beego.Get("/v1/auth/main/menu", controllers.HomePageMenus)
 	beego.Post("/v1/auth/passwd/update", controllers.PasswdController.PostModifyPasswd)
+	beego.Get("/v1/auth/passwd/policy/get", controllers.PasswdPolicyCtl.Get)
+	beego.Put("/v1/auth/passwd/policy/put", controllers.PasswdPolicyCtl.Put)
+	beego.Post("/v1/auth/passwd/policy/delete", controllers.PasswdPolicyCtl.Delete)
+
+	// mfa
+	beego.Get("/v1/auth/mfa/status", controllers.MfaCtl.Status)
+	beego.Post("/v1/auth/mfa/enroll", controllers.MfaCtl.Enroll)
+	beego.Post("/v1/auth/mfa/confirm", controllers.MfaCtl.Confirm)
+	beego.Post("/v1/auth/mfa/recovery", controllers.MfaCtl.Recovery)
+	beego.Post("/v1/auth/mfa/reset", controllers.MfaCtl.Reset)
+	beego.Get("/v1/auth/mfa/require/get", controllers.MfaCtl.GetRequire)
+	beego.Post("/v1/auth/mfa/require/post", controllers.MfaCtl.PostRequire)
+	beego.Post("/v1/auth/mfa/require/delete", controllers.MfaCtl.DeleteRequire)
+
+	// session
+	beego.Get("/v1/auth/session/get", controllers.SessionCtl.Get)
+	beego.Post("/v1/auth/session/delete", controllers.SessionCtl.Delete)
+	beego.Get("/v1/auth/session/domain/get", controllers.SessionCtl.GetDomain)
+	beego.Post("/v1/auth/session/domain/delete", controllers.SessionCtl.DeleteDomain)
+
+	// oauth client
+	beego.Get("/v1/auth/oauth/client/get", controllers.OauthClientCtl.Get)
+	beego.Post("/v1/auth/oauth/client/post", controllers.OauthClientCtl.Post)
+	beego.Put("/v1/auth/oauth/client/put", controllers.OauthClientCtl.Put)
+	beego.Post("/v1/auth/oauth/client/delete", controllers.OauthClientCtl.Delete)
+	beego.Post("/v1/auth/oauth/client/secret", controllers.OauthClientCtl.Secret)
+
+	// identity provider
+	beego.Get("/v1/auth/domain/idp/get", controllers.DomainIdpCtl.Get)
+	beego.Put("/v1/auth/domain/idp/put", controllers.DomainIdpCtl.Put)
+	beego.Post("/v1/auth/domain/idp/delete", controllers.DomainIdpCtl.Delete)
+	beego.Get("/v1/auth/user/identity/get", controllers.DomainIdpCtl.GetIdentity)
+	beego.Post("/v1/auth/user/identity/post", controllers.DomainIdpCtl.PostIdentity)
+	beego.Post("/v1/auth/user/identity/delete", controllers.DomainIdpCtl.DeleteIdentity)
+	beego.Get("/v1/auth/user/apikey/get", controllers.ApiKeyCtl.Get)
+	beego.Post("/v1/auth/user/apikey/post", controllers.ApiKeyCtl.Post)
+	beego.Post("/v1/auth/user/apikey/delete", controllers.ApiKeyCtl.Delete)
 
 	//domain_info
 	beego.Get("/v1/auth/domain/share/page", controllers.DomainShareCtl.Page)
@@ -89,8 +145,16 @@ func registerRouter() {
 	beego.Put("/v1/auth/user/put", controllers.UserCtl.Put)
 	beego.Put("/v1/auth/user/modify/passwd", controllers.UserCtl.ModifyPasswd)
 	beego.Put("/v1/auth/user/modify/status", controllers.UserCtl.ModifyStatus)
+	beego.Post("/v1/auth/user/revoke/token", controllers.UserCtl.RevokeToken)
+	beego.Post("/v1/auth/user/impersonate", controllers.UserCtl.Impersonate)
+	beego.Post("/v1/auth/user/impersonate/end", controllers.UserCtl.EndImpersonate)
 	beego.Post("/v1/auth/user/delete", controllers.UserCtl.Delete)
 	beego.Get("/v1/auth/user/query", controllers.UserCtl.GetUserDetails)
+	beego.Get("/v1/auth/userinfo", controllers.UserCtl.UserInfo)
+
+	beego.Get("/v1/auth/permcache/stats", controllers.PermCacheCtl.Stats)
+	beego.Get("/v1/auth/superadmin/get", controllers.SuperAdminCtl.Get)
+	beego.Get("/v1/auth/superadmin/audit", controllers.SuperAdminCtl.Audit)
 
 	// help
 	beego.Get("/v1/help/system/help", controllers.HelpCtl.Page)
@@ -103,4 +167,48 @@ func registerRouter() {
 	beego.Get("/v1/auth/user/page", controllers.UserCtl.Page)
 	beego.Get("/v1/auth/role/page", controllers.RoleCtl.Page)
 	beego.Get("/v1/auth/swagger/page", controllers.SwaggerCtl.Page)
+
+	// 登录后即可访问的API,其他API都必须授权后才能访问
+	AuthPublic(
+		"/v1/auth/index/entry",
+		"/v1/auth/main/menu",
+		"/v1/auth/theme/update",
+		"/v1/auth/passwd/update",
+		"/v1/auth/mfa/status",
+		"/v1/auth/mfa/enroll",
+		"/v1/auth/mfa/confirm",
+		"/v1/auth/mfa/recovery",
+		"/v1/auth/session/get",
+		"/v1/auth/session/delete",
+		"/v1/auth/domain/id",
+		"/v1/auth/domain/owner",
+		"/v1/auth/domain/self/owner",
+		"/v1/auth/user/query",
+		"/v1/auth/userinfo",
+		"/v1/auth/user/impersonate/end",
+	)
+
+	// 请求参数中的域编码
+	AuthDomain("/v1/auth/passwd/policy/get", "domain_id", "r")
+	AuthDomain("/v1/auth/passwd/policy/put", "domain_id", "w")
+	AuthDomain("/v1/auth/passwd/policy/delete", "domain_id", "w")
+	AuthDomain("/v1/auth/mfa/require/get", "domain_id", "r")
+	AuthDomain("/v1/auth/mfa/require/post", "domain_id", "w")
+	AuthDomain("/v1/auth/mfa/require/delete", "domain_id", "w")
+	AuthDomain("/v1/auth/session/domain/get", "domain_id", "r")
+	AuthDomain("/v1/auth/oauth/client/get", "domain_id", "r")
+	AuthDomain("/v1/auth/oauth/client/delete", "domain_id", "w")
+	AuthDomain("/v1/auth/oauth/client/secret", "domain_id", "w")
+	AuthDomain("/v1/auth/domain/idp/get", "domain_id", "r")
+	AuthDomain("/v1/auth/domain/idp/delete", "domain_id", "w")
+	AuthDomain("/v1/auth/domain/row/details", "domain_id", "r")
+	AuthDomain("/v1/auth/domain/share/get", "domain_id", "r")
+	AuthDomain("/v1/auth/domain/share/unauth", "domain_id", "r")
+	AuthDomain("/v1/auth/domain/share/delete", "domain_id", "w")
+	AuthDomain("/v1/auth/resource/org/get", "domain_id", "r")
+	AuthDomain("/v1/auth/resource/org/delete", "domain_id", "w")
+	AuthDomain("/v1/auth/resource/org/download", "domain_id", "r")
+	AuthDomain("/v1/auth/role/get", "domain_id", "r")
+	AuthDomain("/v1/auth/user/get", "domain_id", "r")
+	AuthDomain("/v1/auth/user/search", "domain_id", "r")
 }
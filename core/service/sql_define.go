@@ -1,3 +1,3 @@
 package service
 
-var hauth_service_001 = `insert into sys_handle_logs(uuid,user_id,handle_time,client_ip,status_code,method,url,domain_id,data) values(uuid(),?,now(),?,?,?,?,?,left(?,2999))`
+var hauth_service_001 = `insert into sys_handle_logs(uuid,user_id,handle_time,client_ip,status_code,method,url,domain_id,data,api_key,impersonator) values(uuid(),?,now(),?,?,?,?,?,left(?,2999),?,?)`
@@ -15,6 +15,72 @@
 /*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
 /*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;
 
+--
+-- Table structure for table `sys_api_key`
+--
+
+DROP TABLE IF EXISTS `sys_api_key`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_api_key` (
+  `key_id` varchar(16) NOT NULL,
+  `key_hash` varchar(64) NOT NULL,
+  `key_name` varchar(100) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `scopes` varchar(2000) DEFAULT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `last_used_time` bigint(20) NOT NULL DEFAULT '0',
+  `status` char(1) NOT NULL DEFAULT '0',
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`key_id`),
+  KEY `fk_sys_api_key_01_idx` (`user_id`),
+  CONSTRAINT `fk_sys_api_key_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='服务账号的API key, key_hash = sha256(secret), scopes为空时可以访问服务账号的全部授权资源';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_api_key`
+--
+
+LOCK TABLES `sys_api_key` WRITE;
+/*!40000 ALTER TABLE `sys_api_key` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_api_key` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_domain_idp`
+--
+
+DROP TABLE IF EXISTS `sys_domain_idp`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_domain_idp` (
+  `domain_id` varchar(30) NOT NULL,
+  `issuer` varchar(300) NOT NULL,
+  `client_id` varchar(200) NOT NULL,
+  `client_secret` varchar(500) NOT NULL,
+  `scopes` varchar(200) DEFAULT NULL,
+  `link_email` char(1) NOT NULL,
+  `status` char(1) NOT NULL,
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  `maintance_user` varchar(30) DEFAULT NULL,
+  `maintance_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`domain_id`),
+  CONSTRAINT `fk_sys_domain_idp_01` FOREIGN KEY (`domain_id`) REFERENCES `sys_domain_info` (`domain_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='域的外部身份提供者';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_domain_idp`
+--
+
+LOCK TABLES `sys_domain_idp` WRITE;
+/*!40000 ALTER TABLE `sys_domain_idp` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_domain_idp` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_domain_info`
 --
@@ -119,6 +185,8 @@ CREATE TABLE `sys_handle_logs` (
   `url` varchar(45) DEFAULT NULL,
   `data` varchar(3000) DEFAULT NULL,
   `domain_id` varchar(30) DEFAULT NULL,
+  `api_key` varchar(32) DEFAULT NULL,
+  `impersonator` varchar(30) DEFAULT NULL,
   PRIMARY KEY (`uuid`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 /*!40101 SET character_set_client = @saved_cs_client */;
@@ -132,6 +200,63 @@ LOCK TABLES `sys_handle_logs` WRITE;
 /*!40000 ALTER TABLE `sys_handle_logs` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_idp_login_state`
+--
+
+DROP TABLE IF EXISTS `sys_idp_login_state`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_idp_login_state` (
+  `state_hash` varchar(64) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `nonce` varchar(64) NOT NULL,
+  `code_verifier` varchar(128) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`state_hash`),
+  KEY `idx_sys_idp_login_state_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='外部身份提供者登录state';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_idp_login_state`
+--
+
+LOCK TABLES `sys_idp_login_state` WRITE;
+/*!40000 ALTER TABLE `sys_idp_login_state` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_idp_login_state` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_impersonate_audit`
+--
+
+DROP TABLE IF EXISTS `sys_impersonate_audit`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_impersonate_audit` (
+  `uuid` varchar(66) NOT NULL,
+  `admin_id` varchar(30) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `session_id` varchar(60) NOT NULL,
+  `action` varchar(10) NOT NULL COMMENT 'start 开始模拟登录, end 结束模拟登录',
+  `handle_time` bigint(20) NOT NULL,
+  `client_ip` varchar(60) DEFAULT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_impersonate_audit_01` (`handle_time`),
+  KEY `idx_sys_impersonate_audit_02` (`admin_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='管理员模拟用户登录的开始与结束记录';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_impersonate_audit`
+--
+
+LOCK TABLES `sys_impersonate_audit` WRITE;
+/*!40000 ALTER TABLE `sys_impersonate_audit` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_impersonate_audit` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_index_page`
 --
@@ -156,6 +281,154 @@ INSERT INTO `sys_index_page` VALUES ('1001','./views/hauth/theme/default/index.t
 /*!40000 ALTER TABLE `sys_index_page` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_login_throttle`
+--
+
+DROP TABLE IF EXISTS `sys_login_throttle`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_login_throttle` (
+  `throttle_key` varchar(100) NOT NULL,
+  `tokens` bigint(20) NOT NULL,
+  `update_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`throttle_key`),
+  KEY `idx_sys_login_throttle_01` (`update_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='登录限流令牌桶';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_login_throttle`
+--
+
+LOCK TABLES `sys_login_throttle` WRITE;
+/*!40000 ALTER TABLE `sys_login_throttle` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_login_throttle` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_mfa_challenge`
+--
+
+DROP TABLE IF EXISTS `sys_mfa_challenge`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_mfa_challenge` (
+  `challenge_hash` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `org_unit_id` varchar(66) NOT NULL,
+  `attempts` int(11) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`challenge_hash`),
+  KEY `idx_sys_mfa_challenge_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='两步验证挑战码';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_mfa_challenge`
+--
+
+LOCK TABLES `sys_mfa_challenge` WRITE;
+/*!40000 ALTER TABLE `sys_mfa_challenge` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_mfa_challenge` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_mfa_require`
+--
+
+DROP TABLE IF EXISTS `sys_mfa_require`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_mfa_require` (
+  `require_type` char(1) NOT NULL,
+  `target_id` varchar(66) NOT NULL,
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`require_type`,`target_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='要求两步验证的域与角色';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_mfa_require`
+--
+
+LOCK TABLES `sys_mfa_require` WRITE;
+/*!40000 ALTER TABLE `sys_mfa_require` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_mfa_require` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_oauth_client`
+--
+
+DROP TABLE IF EXISTS `sys_oauth_client`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_oauth_client` (
+  `client_id` varchar(64) NOT NULL,
+  `client_name` varchar(300) NOT NULL,
+  `client_secret` varchar(160) DEFAULT NULL,
+  `redirect_uris` varchar(2000) DEFAULT NULL,
+  `grant_types` varchar(200) NOT NULL,
+  `scopes` varchar(200) DEFAULT NULL,
+  `client_type` char(1) NOT NULL,
+  `trusted` char(1) NOT NULL,
+  `status` char(1) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  `maintance_user` varchar(30) DEFAULT NULL,
+  `maintance_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`client_id`),
+  KEY `fk_sys_oauth_client_01_idx` (`domain_id`),
+  CONSTRAINT `fk_sys_oauth_client_01` FOREIGN KEY (`domain_id`) REFERENCES `sys_domain_info` (`domain_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='OpenID Connect 应用注册信息';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_oauth_client`
+--
+
+LOCK TABLES `sys_oauth_client` WRITE;
+/*!40000 ALTER TABLE `sys_oauth_client` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_oauth_client` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_oauth_code`
+--
+
+DROP TABLE IF EXISTS `sys_oauth_code`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_oauth_code` (
+  `code_hash` varchar(64) NOT NULL,
+  `client_id` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `org_unit_id` varchar(66) NOT NULL,
+  `redirect_uri` varchar(500) NOT NULL,
+  `scope` varchar(200) NOT NULL,
+  `nonce` varchar(200) DEFAULT NULL,
+  `code_challenge` varchar(128) NOT NULL,
+  `auth_time` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`code_hash`),
+  KEY `idx_sys_oauth_code_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='OpenID Connect 授权码';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_oauth_code`
+--
+
+LOCK TABLES `sys_oauth_code` WRITE;
+/*!40000 ALTER TABLE `sys_oauth_code` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_oauth_code` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_org_info`
 --
@@ -189,6 +462,119 @@ INSERT INTO `sys_org_info` VALUES ('mas_join_234fda','攀枝花市分行','mas_j
 /*!40000 ALTER TABLE `sys_org_info` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_passwd_history`
+--
+
+DROP TABLE IF EXISTS `sys_passwd_history`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_passwd_history` (
+  `uuid` varchar(60) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `user_passwd` varchar(100) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_passwd_history_01` (`user_id`,`create_time`),
+  CONSTRAINT `fk_sys_passwd_history_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户历史密码';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_passwd_history`
+--
+
+LOCK TABLES `sys_passwd_history` WRITE;
+/*!40000 ALTER TABLE `sys_passwd_history` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_passwd_history` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_passwd_policy`
+--
+
+DROP TABLE IF EXISTS `sys_passwd_policy`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_passwd_policy` (
+  `domain_id` varchar(30) NOT NULL,
+  `min_length` int(11) NOT NULL,
+  `require_upper` char(1) NOT NULL,
+  `require_lower` char(1) NOT NULL,
+  `require_digit` char(1) NOT NULL,
+  `require_special` char(1) NOT NULL,
+  `forbid_user_id` char(1) NOT NULL,
+  `history_cnt` int(11) NOT NULL,
+  `max_age` int(11) NOT NULL,
+  `reset_change` char(1) NOT NULL,
+  `modify_user` varchar(30) DEFAULT NULL,
+  `modify_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`domain_id`),
+  CONSTRAINT `fk_sys_passwd_policy_01` FOREIGN KEY (`domain_id`) REFERENCES `sys_domain_info` (`domain_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='域密码策略';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_passwd_policy`
+--
+
+LOCK TABLES `sys_passwd_policy` WRITE;
+/*!40000 ALTER TABLE `sys_passwd_policy` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_passwd_policy` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_passwd_reset`
+--
+
+DROP TABLE IF EXISTS `sys_passwd_reset`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_passwd_reset` (
+  `token_hash` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `client_ip` varchar(64) DEFAULT NULL,
+  `create_time` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`token_hash`),
+  KEY `idx_sys_passwd_reset_01` (`user_id`),
+  KEY `idx_sys_passwd_reset_02` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='找回密码的重置token, token_hash是token的sha256摘要, 使用后删除';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_passwd_reset`
+--
+
+LOCK TABLES `sys_passwd_reset` WRITE;
+/*!40000 ALTER TABLE `sys_passwd_reset` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_passwd_reset` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_perm_version`
+--
+
+DROP TABLE IF EXISTS `sys_perm_version`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_perm_version` (
+  `id` char(1) NOT NULL,
+  `version` bigint(20) NOT NULL DEFAULT '0',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='权限版本号, 角色授权,用户角色,用户主题或者资源发生变化时加1, 各实例据此清除权限缓存';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_perm_version`
+--
+
+LOCK TABLES `sys_perm_version` WRITE;
+/*!40000 ALTER TABLE `sys_perm_version` DISABLE KEYS */;
+INSERT INTO `sys_perm_version` VALUES ('0',0);
+/*!40000 ALTER TABLE `sys_perm_version` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_resource_info`
 --
@@ -217,7 +603,7 @@ CREATE TABLE `sys_resource_info` (
 
 LOCK TABLES `sys_resource_info` WRITE;
 /*!40000 ALTER TABLE `sys_resource_info` DISABLE KEYS */;
-INSERT INTO `sys_resource_info` VALUES ('0100000000','系统管理','0','-1','0','0'),('0101000000','系统审计','0','0100000000','4','0'),('0101010000','操作查询','1','0101000000','1','0'),('0101010100','查看操作日志权限','1','0101010000','2',NULL),('0101010200','下载操作日志按钮','1','0101010000','2',NULL),('0101010300','搜索日志信息按钮','1','0101010000','2',NULL),('0103000000','资源管理','0','0100000000','4','0'),('0103010000','菜单','1','0103000000','1','0'),('0103010100','查询资源信息','1','0103010000','2',NULL),('0103010200','新增资源信息按钮','1','0103010000','2',NULL),('0103010300','编辑资源信息按钮','1','0103010000','2',NULL),('0103010400','删除资源信息按钮','1','0103010000','2',NULL),('01030104001','删除资源信息按钮','1','0101010000','2',NULL),('0103010500','配置主题信息按钮','1','0103010000','2',NULL),('0103020000','组织','1','0103000000','1','0'),('0103020100','查询组织架构信息','1','0103020000','2',NULL),('0103020200','新增组织架构信息按钮','1','0103020000','2',NULL),('0103020300','更新组织架构信息按钮','1','0103020000','2',NULL),('0103020400','删除组织架构信息按钮','1','0103020000','2',NULL),('0103020500','导出组织架构信息按钮','1','0103020000','2',NULL),('0103030100','查询共享域信息','1','0104010200','2',NULL),('0103030200','新增共享域信息按钮','1','0104010200','2',NULL),('0103030300','删除共享域信息按钮','1','0104010200','2',NULL),('0103030400','更新共享域信息按钮','1','0104010200','2',NULL),('0104010000','域定义','1','0103000000','1','0'),('0104010100','查询域信息','1','0104010000','2',NULL),('0104010200','共享域管理','1','0104010000','2',NULL),('0104010300','编辑域信息按钮','1','0104010000','2',NULL),('0104010400','删除域信息按钮','1','0104010000','2',NULL),('0104010500','新增域信息按钮','1','0104010000','2',NULL),('0105000000','用户与安全管理','0','0100000000','4','0'),('0105010000','用户','1','0105000000','1','0'),('0105010100','查询用户信息','1','0105010000','2',NULL),('0105010200','新增用户信息按钮','1','0105010000','2',NULL),('0105010300','编辑用户信息按钮','1','0105010000','2',NULL),('0105010400','删除用户信息按钮','1','0105010000','2',NULL),('0105010500','修改用户密码按钮','1','0105010000','2',NULL),('0105010600','修改用户状态按钮','1','0105010000','2',NULL),('0105020000','角色','1','0105000000','1','0'),('0105020100','查询角色信息','1','0105020000','2',NULL),('0105020200','新增角色信息按钮','1','0105020000','2',NULL),('0105020300','更新角色信息按钮','1','0105020000','2',NULL),('0105020400','删除角色信息按钮','1','0105020000','2',NULL),('0105020500','角色资源管理','1','0105020000','2',NULL),('0105020510','查询角色资源信息','1','0105020500','2',NULL),('0105020520','修改角色资源信息','1','0105020500','2',NULL),('0105040000','授权','1','0105000000','1','0'),('0105040100','授予权限按钮','1','0105040000','2',NULL),('0105040200','移除权限','1','0105040000','2',NULL),('0200000000','成本分摊','0','-1','0',NULL),('0201000000','维度信息管理','0','0200000000','4',NULL),('0201010000','责任中心','1','0201000000','1',NULL),('0201030000','成本类别','1','0201000000','1',NULL),('0201040000','动因信息','1','0201000000','1',NULL),('0201060000','成本池信息','1','0201000000','1',NULL),('0202000000','规则定义管理','0','0200000000','4',NULL),('0202010000','静态规则配置','1','0202000000','1',NULL),('0202020000','分摊规则','1','0202000000','1',NULL),('0202040000','规则组配置','1','0202000000','1',NULL),('0203000000','批次综合管理','0','0200000000','4',NULL),('0203010000','批次管理','1','0203000000','1',NULL),('0203020000','批次历史信息','1','0203000000','1',NULL),('0203040000','费用查询','1','0203000000','1',NULL),('0203050000','动因查询','1','0203000000','1',NULL),('0300000000','内部资金转移定价','0','-1','0',NULL),('0301000000','曲线与规则','0','0300000000','4',NULL),('0301010000','曲线定义','1','0301000000','1',NULL),('0301020000','曲线管理','1','0301000000','1',NULL),('0301050000','定价规则','1','0301000000','1',NULL),('0302000000','调节项管理','0','0300000000','4',NULL),('0302010000','内生性调节项','1','0302000000','1',NULL),('0302020000','政策性调节项','1','0302000000','1',NULL),('0302030000','过滤器配置管理','1','0302000000','1',NULL),('0303000000','批次管理','0','0300000000','4',NULL),('0303010000','单笔试算','1','0303000000','1',NULL),('0303020000','批次配置','1','0303000000','1',NULL),('0303030000','批次历史','1','0303000000','1',NULL),('0400000000','公共维度信息','0','-1','0',NULL),('0401000000','条线信息','1','0400000000','1',NULL),('0402000000','产品信息','1','0400000000','1',NULL),('0403000000','科目信息','1','0400000000','1',NULL),('0404000000','币种信息','1','0400000000','1',NULL),('0500000000','ETL调度','0','-1','0',NULL),('0501000000','调度参数配置','0','0500000000','4',NULL),('0501010000','任务参数定义','1','0501000000','1',NULL),('0501020000','调度核心参数管理','1','0501000000','1',NULL),('0502000000','任务与任务组配置','0','0500000000','4',NULL),('0502010000','任务定义','1','0502000000','1',NULL),('0502020000','任务组定义','1','0502000000','1',NULL),('0503000000','批次配置管理','0','0500000000','4',NULL),('0503010000','批次定义','1','0503000000','1',NULL),('0503020000','批次监控','1','0503000000','1',NULL),('1100000000','系统帮助','0','-1','0',NULL),('1101000000','系统管理帮助','0','1100000000','4',NULL),('1101010000','系统维护帮助信息','1','1101000000','1',NULL),('1101020000','API文档','1','1101000000','1',NULL),('1102000000','管理会计帮助文档','0','1100000000','4',NULL),('1103000000','公共信息帮助','0','1100000000','4',NULL);
+INSERT INTO `sys_resource_info` VALUES ('0100000000','系统管理','0','-1','0','0'),('0101000000','系统审计','0','0100000000','4','0'),('0101010000','操作查询','1','0101000000','1','0'),('0101010100','查看操作日志权限','1','0101010000','2',NULL),('0101010200','下载操作日志按钮','1','0101010000','2',NULL),('0101010300','搜索日志信息按钮','1','0101010000','2',NULL),('0103000000','资源管理','0','0100000000','4','0'),('0103010000','菜单','1','0103000000','1','0'),('0103010100','查询资源信息','1','0103010000','2',NULL),('0103010200','新增资源信息按钮','1','0103010000','2',NULL),('0103010300','编辑资源信息按钮','1','0103010000','2',NULL),('0103010400','删除资源信息按钮','1','0103010000','2',NULL),('01030104001','删除资源信息按钮','1','0101010000','2',NULL),('0103010500','配置主题信息按钮','1','0103010000','2',NULL),('0103020000','组织','1','0103000000','1','0'),('0103020100','查询组织架构信息','1','0103020000','2',NULL),('0103020200','新增组织架构信息按钮','1','0103020000','2',NULL),('0103020300','更新组织架构信息按钮','1','0103020000','2',NULL),('0103020400','删除组织架构信息按钮','1','0103020000','2',NULL),('0103020500','导出组织架构信息按钮','1','0103020000','2',NULL),('0103030100','查询共享域信息','1','0104010200','2',NULL),('0103030200','新增共享域信息按钮','1','0104010200','2',NULL),('0103030300','删除共享域信息按钮','1','0104010200','2',NULL),('0103030400','更新共享域信息按钮','1','0104010200','2',NULL),('0104010000','域定义','1','0103000000','1','0'),('0104010100','查询域信息','1','0104010000','2',NULL),('0104010200','共享域管理','1','0104010000','2',NULL),('0104010300','编辑域信息按钮','1','0104010000','2',NULL),('0104010400','删除域信息按钮','1','0104010000','2',NULL),('0104010500','新增域信息按钮','1','0104010000','2',NULL),('0105000000','用户与安全管理','0','0100000000','4','0'),('0105010000','用户','1','0105000000','1','0'),('0105010100','查询用户信息','1','0105010000','2',NULL),('0105010200','新增用户信息按钮','1','0105010000','2',NULL),('0105010300','编辑用户信息按钮','1','0105010000','2',NULL),('0105010400','删除用户信息按钮','1','0105010000','2',NULL),('0105010500','修改用户密码按钮','1','0105010000','2',NULL),('0105010600','修改用户状态按钮','1','0105010000','2',NULL),('0105020000','角色','1','0105000000','1','0'),('0105020100','查询角色信息','1','0105020000','2',NULL),('0105020200','新增角色信息按钮','1','0105020000','2',NULL),('0105020300','更新角色信息按钮','1','0105020000','2',NULL),('0105020400','删除角色信息按钮','1','0105020000','2',NULL),('0105020500','角色资源管理','1','0105020000','2',NULL),('0105020510','查询角色资源信息','1','0105020500','2',NULL),('0105020520','修改角色资源信息','1','0105020500','2',NULL),('0105040000','授权','1','0105000000','1','0'),('0105040100','授予权限按钮','1','0105040000','2',NULL),('0105040200','移除权限','1','0105040000','2',NULL),('0200000000','成本分摊','0','-1','0',NULL),('0201000000','维度信息管理','0','0200000000','4',NULL),('0201010000','责任中心','1','0201000000','1',NULL),('0201030000','成本类别','1','0201000000','1',NULL),('0201040000','动因信息','1','0201000000','1',NULL),('0201060000','成本池信息','1','0201000000','1',NULL),('0202000000','规则定义管理','0','0200000000','4',NULL),('0202010000','静态规则配置','1','0202000000','1',NULL),('0202020000','分摊规则','1','0202000000','1',NULL),('0202040000','规则组配置','1','0202000000','1',NULL),('0203000000','批次综合管理','0','0200000000','4',NULL),('0203010000','批次管理','1','0203000000','1',NULL),('0203020000','批次历史信息','1','0203000000','1',NULL),('0203040000','费用查询','1','0203000000','1',NULL),('0203050000','动因查询','1','0203000000','1',NULL),('0300000000','内部资金转移定价','0','-1','0',NULL),('0301000000','曲线与规则','0','0300000000','4',NULL),('0301010000','曲线定义','1','0301000000','1',NULL),('0301020000','曲线管理','1','0301000000','1',NULL),('0301050000','定价规则','1','0301000000','1',NULL),('0302000000','调节项管理','0','0300000000','4',NULL),('0302010000','内生性调节项','1','0302000000','1',NULL),('0302020000','政策性调节项','1','0302000000','1',NULL),('0302030000','过滤器配置管理','1','0302000000','1',NULL),('0303000000','批次管理','0','0300000000','4',NULL),('0303010000','单笔试算','1','0303000000','1',NULL),('0303020000','批次配置','1','0303000000','1',NULL),('0303030000','批次历史','1','0303000000','1',NULL),('0400000000','公共维度信息','0','-1','0',NULL),('0401000000','条线信息','1','0400000000','1',NULL),('0402000000','产品信息','1','0400000000','1',NULL),('0403000000','科目信息','1','0400000000','1',NULL),('0404000000','币种信息','1','0400000000','1',NULL),('0500000000','ETL调度','0','-1','0',NULL),('0501000000','调度参数配置','0','0500000000','4',NULL),('0501010000','任务参数定义','1','0501000000','1',NULL),('0501020000','调度核心参数管理','1','0501000000','1',NULL),('0502000000','任务与任务组配置','0','0500000000','4',NULL),('0502010000','任务定义','1','0502000000','1',NULL),('0502020000','任务组定义','1','0502000000','1',NULL),('0503000000','批次配置管理','0','0500000000','4',NULL),('0503010000','批次定义','1','0503000000','1',NULL),('0503020000','批次监控','1','0503000000','1',NULL),('1100000000','系统帮助','0','-1','0',NULL),('1101000000','系统管理帮助','0','1100000000','4',NULL),('1101010000','系统维护帮助信息','1','1101000000','1',NULL),('1101020000','API文档','1','1101000000','1',NULL),('1102000000','管理会计帮助文档','0','1100000000','4',NULL),('1103000000','公共信息帮助','0','1100000000','4',NULL),('0105010700','强制用户退出按钮','1','0105010000','2',NULL),('0104010600','查询密码策略','1','0104010000','2',NULL),('0104010700','设置密码策略按钮','1','0104010000','2',NULL),('0104010800','删除密码策略按钮','1','0104010000','2',NULL),('0104010900','查询两步验证要求','1','0104010000','2',NULL),('0104011000','新增两步验证要求按钮','1','0104010000','2',NULL),('0104011100','删除两步验证要求按钮','1','0104010000','2',NULL),('0105010800','重置两步验证按钮','1','0105010000','2',NULL),('0104011200','查询OpenID Connect应用','1','0104010000','2',NULL),('0104011300','注册OpenID Connect应用按钮','1','0104010000','2',NULL),('0104011400','编辑OpenID Connect应用按钮','1','0104010000','2',NULL),('0104011500','删除OpenID Connect应用按钮','1','0104010000','2',NULL),('0104011600','重置OpenID Connect应用密钥按钮','1','0104010000','2',NULL),('0104011700','查询外部身份提供者','1','0104010000','2',NULL),('0104011800','配置外部身份提供者按钮','1','0104010000','2',NULL),('0104011900','删除外部身份提供者按钮','1','0104010000','2',NULL),('0105010900','查询用户绑定的外部身份','1','0105010000','2',NULL),('0105011000','绑定外部身份按钮','1','0105010000','2',NULL),('0105011100','解除外部身份绑定按钮','1','0105010000','2',NULL),('0105011200','查询API key按钮','1','0105010000','2',NULL),('0105011300','创建API key按钮','1','0105010000','2',NULL),('0105011400','删除API key按钮','1','0105010000','2',NULL),('0105011500','查询登录会话','1','0105010000','2',NULL),('0105011600','结束登录会话按钮','1','0105010000','2',NULL),('0105011700','模拟用户登录按钮','1','0105010000','2',NULL),('0103010600','查看权限缓存统计','1','0103010000','2',NULL),('0105040300','查询超级管理员','1','0105040000','2',NULL),('0105040400','查询超级管理员变更记录','1','0105040000','2',NULL),('0103010700','查询资源详细信息','1','0103010000','2',NULL),('0103010800','查询资源主题信息','1','0103010000','2',NULL),('0103020600','导入组织架构信息按钮','1','0103020000','2',NULL),('0103020700','查询下级组织信息','1','0103020000','2',NULL),('0103030500','查询可以共享的域','1','0104010200','2',NULL),('0104012000','查询域详细信息','1','0104010000','2',NULL),('0105011800','搜索用户信息','1','0105010000','2',NULL),('0105040500','查询用户已经拥有的角色','1','0105040000','2',NULL),('0105040600','查询用户可以授予的角色','1','0105040000','2',NULL);
 /*!40000 ALTER TABLE `sys_resource_info` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -269,6 +655,34 @@ INSERT INTO `sys_resource_type_attr` VALUES ('0','首页菜单'),('1','子系统
 /*!40000 ALTER TABLE `sys_resource_type_attr` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_role_ancestor_relat`
+--
+
+DROP TABLE IF EXISTS `sys_role_ancestor_relat`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_role_ancestor_relat` (
+  `role_id` varchar(66) NOT NULL,
+  `ancestor_id` varchar(66) NOT NULL,
+  `depth` int(11) NOT NULL COMMENT '0 表示角色自身,1 表示上级角色,依次类推',
+  PRIMARY KEY (`role_id`,`ancestor_id`),
+  KEY `fk_sys_role_ancestor_02_idx` (`ancestor_id`),
+  CONSTRAINT `fk_sys_role_ancestor_01` FOREIGN KEY (`role_id`) REFERENCES `sys_role_info` (`role_id`) ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_sys_role_ancestor_02` FOREIGN KEY (`ancestor_id`) REFERENCES `sys_role_info` (`role_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_role_ancestor_relat`
+--
+
+LOCK TABLES `sys_role_ancestor_relat` WRITE;
+/*!40000 ALTER TABLE `sys_role_ancestor_relat` DISABLE KEYS */;
+INSERT INTO `sys_role_ancestor_relat` VALUES ('devops_product_join_43124','devops_product_join_43124',0),('devops_product_join_454235','devops_product_join_454235',0),('devops_product_join_ftpadmin','devops_product_join_ftpadmin',0),('mas_join_cademo','mas_join_cademo',0),('mas_join_ftpdemo','mas_join_ftpdemo',0),('mas_join_masadmin','mas_join_masadmin',0),('vertex_root_join_sysadmin','vertex_root_join_sysadmin',0);
+/*!40000 ALTER TABLE `sys_role_ancestor_relat` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_role_info`
 --
@@ -286,6 +700,8 @@ CREATE TABLE `sys_role_info` (
   `role_maintance_date` datetime NOT NULL,
   `role_maintance_user` varchar(30) NOT NULL,
   `code_number` varchar(66) NOT NULL,
+  `super_admin` char(1) NOT NULL DEFAULT '0' COMMENT '1 表示超级管理员角色',
+  `parent_role_id` varchar(66) DEFAULT NULL COMMENT '上级角色,继承上级角色的资源',
   PRIMARY KEY (`role_id`),
   KEY `fk_sys_idx_11` (`role_status_id`),
   CONSTRAINT `fk_sys_idx_11` FOREIGN KEY (`role_status_id`) REFERENCES `sys_role_status_attr` (`role_status_id`)
@@ -298,7 +714,7 @@ CREATE TABLE `sys_role_info` (
 
 LOCK TABLES `sys_role_info` WRITE;
 /*!40000 ALTER TABLE `sys_role_info` DISABLE KEYS */;
-INSERT INTO `sys_role_info` VALUES ('devops_product_join_43124','43243','ftpadmin','2017-04-13 00:30:15','0','devops_product','2017-04-13 00:30:15','ftpadmin','43124'),('devops_product_join_454235','543254','ftpadmin','2017-04-13 00:31:47','0','devops_product','2017-04-13 00:31:47','ftpadmin','454235'),('devops_product_join_ftpadmin','FTP管理员角色','admin','2017-03-21 09:43:36','0','devops_product','2017-03-21 09:43:36','admin','ftpadmin'),('mas_join_cademo','成本分摊演示角色','admin','2017-03-07 10:36:45','0','mas','2017-04-20 23:11:32','admin','cademo'),('mas_join_ftpdemo','内部资金转移定价演示角色','admin','2017-03-07 10:36:59','0','mas','2017-03-07 10:36:59','admin','ftpdemo'),('mas_join_masadmin','管理会计管理员','admin','2017-03-14 14:44:34','0','mas','2017-04-22 21:03:20','admin','masadmin'),('vertex_root_join_sysadmin','超级管理员','admin','2016-01-01 00:00:00','0','vertex_root','2016-12-16 00:00:00','admin','sysadmin');
+INSERT INTO `sys_role_info` VALUES ('devops_product_join_43124','43243','ftpadmin','2017-04-13 00:30:15','0','devops_product','2017-04-13 00:30:15','ftpadmin','43124','0',NULL),('devops_product_join_454235','543254','ftpadmin','2017-04-13 00:31:47','0','devops_product','2017-04-13 00:31:47','ftpadmin','454235','0',NULL),('devops_product_join_ftpadmin','FTP管理员角色','admin','2017-03-21 09:43:36','0','devops_product','2017-03-21 09:43:36','admin','ftpadmin','0',NULL),('mas_join_cademo','成本分摊演示角色','admin','2017-03-07 10:36:45','0','mas','2017-04-20 23:11:32','admin','cademo','0',NULL),('mas_join_ftpdemo','内部资金转移定价演示角色','admin','2017-03-07 10:36:59','0','mas','2017-03-07 10:36:59','admin','ftpdemo','0',NULL),('mas_join_masadmin','管理会计管理员','admin','2017-03-14 14:44:34','0','mas','2017-04-22 21:03:20','admin','masadmin','0',NULL),('vertex_root_join_sysadmin','超级管理员','admin','2016-01-01 00:00:00','0','vertex_root','2016-12-16 00:00:00','admin','sysadmin','1',NULL);
 /*!40000 ALTER TABLE `sys_role_info` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -327,7 +743,7 @@ CREATE TABLE `sys_role_resource_relat` (
 
 LOCK TABLES `sys_role_resource_relat` WRITE;
 /*!40000 ALTER TABLE `sys_role_resource_relat` DISABLE KEYS */;
-INSERT INTO `sys_role_resource_relat` VALUES ('00716df3-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010600'),('02d6cb28-16e1-11e7-95e0-a0c58951c8d5','mas_join_masadmin','0105040000'),('02d74d86-16e1-11e7-95e0-a0c58951c8d5','mas_join_masadmin','0105040100'),('02d7d7f5-16e1-11e7-95e0-a0c58951c8d5','mas_join_masadmin','0105040200'),('0574d053-07e7-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020300'),('0a7043a9-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0501010000'),('0a706464-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0502010000'),('0a7078f1-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0502020000'),('0a708f98-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0503010000'),('0a70a2f6-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0501000000'),('0a70ba07-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0502000000'),('0a70d529-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0503000000'),('0ba023b2-4667-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0500000000'),('0f65406b-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201000000'),('0f655305-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201040000'),('0f656609-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203000000'),('0f657dda-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201030000'),('0f65938e-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203020000'),('0f65a7da-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203010000'),('0f65d3c9-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202000000'),('0f671952-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202010000'),('0f672d27-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202020000'),('0f6753eb-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202040000'),('0f676552-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203040000'),('0f678912-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0200000000'),('0f679a9f-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201010000'),('0f67bbf4-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201060000'),('0f931a5a-07f2-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105040100'),('0fed7044-024a-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301000000'),('15498bd1-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302000000'),('15499deb-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303000000'),('1549b2c0-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0301020000'),('1549c489-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302030000'),('1549da33-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303010000'),('1549ebe7-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303020000'),('1549ff00-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0301000000'),('154a0c8d-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302010000'),('154a1a9e-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303030000'),('154a2a7c-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0300000000'),('154a62a2-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302020000'),('154a7233-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0301050000'),('17994440-024c-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303030000'),('1bdeaba6-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010100'),('1bf28a08-07e7-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020400'),('1c3118cc-07e2-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030400'),('1c7f66c1-4672-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0502010000'),('2372c034-46b1-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0503020000'),('25167037-07f2-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105040200'),('32cfc9e5-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0401000000'),('32cfe510-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0402000000'),('32cff514-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0403000000'),('32d00969-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0404000000'),('32d0a0f2-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0400000000'),('33bb66bb-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010200'),('3b92fdf5-4672-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0502020000'),('3d23d85e-07e7-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020500'),('43ad40d2-07f1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020510'),('4704352b-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1100000000'),('470450e2-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1101000000'),('4704667c-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1102000000'),('47047a55-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1103000000'),('47048c2b-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1101010000'),('48463b39-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010300'),('48fb522e-04a4-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301010000'),('53c399c4-024c-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302030000'),('55a149ee-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020500'),('55a16810-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020300'),('55a17bc3-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020400'),('55a18b54-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010200'),('55a199c3-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030300'),('55a1b0d0-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020520'),('55a1c1e1-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010000'),('55a1da99-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010400'),('55a1ecf2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010600'),('55a3cd2a-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105000000'),('55a42994-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010500'),('55a48f77-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010000'),('55a4c0d9-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010000'),('55a4efa6-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105040000'),('55a51f7f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010200'),('55a566b2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020100'),('55a58c3f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020400'),('55a5abc3-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0100000000'),('55a5c961-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103000000'),('55a5ddd9-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030100'),('55a5f73b-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030400'),('55a61bb2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020500'),('55a640b7-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105040100'),('55a65ed0-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020200'),('55a67332-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010300'),('55a684f2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010500'),('55a6cb2e-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010300'),('55a711cc-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010500'),('55a7297f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020100'),('55a74032-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101000000'),('55a757d0-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010000'),('55a76915-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020200'),('55a77b15-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020300'),('55a78c3f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010400'),('55a8088c-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010200'),('55a87773-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010100'),('55a8a7c8-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010100'),('55a8bd08-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105040200'),('55a8eaf7-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030200'),('55a900c4-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020510'),('55a912e6-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020000'),('55a925c8-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020000'),('55a938ea-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010300'),('55a94aa1-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010400'),('55a95d48-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010100'),('55a98588-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010200'),('55a9998c-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010100'),('55a9af08-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010300'),('5a587e71-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0400000000'),('5a588e25-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0401000000'),('5a589e29-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0402000000'),('5a5a35ba-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0403000000'),('5a5a4743-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0404000000'),('5a7db1f7-07f1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020520'),('5c60bc08-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301050000'),('5cdef223-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0501000000'),('60700eba-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1101000000'),('607033cf-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1100000000'),('6070454b-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1101010000'),('6402f992-4672-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0503010000'),('68ebf2c8-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1103000000'),('692c628f-1c0a-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0101010100'),('6a935ea9-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1102000000'),('6bb7e04d-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010400'),('6c7f6d2a-250a-11e7-9c7e-a0c58951c8d5','vertex_root_join_sysadmin','01030104001'),('72939327-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302000000'),('7c3618ec-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0502000000'),('7d73294c-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010100'),('8009b52c-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0203050000'),('8024c16b-07d8-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010300'),('824c1f28-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0400000000'),('83794268-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302010000'),('8857ba73-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0503000000'),('8ca4f732-07e5-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0101010200'),('8dc4fada-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201060000'),('8dc56ba3-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203040000'),('8dc57fe7-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203050000'),('8dc59452-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202000000'),('8dc5a6f0-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201010000'),('8dc5bba7-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0200000000'),('8dc5d11a-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201030000'),('8dc5e7da-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202040000'),('8dc5ffda-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203020000'),('8dc6176b-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201000000'),('8dc62d85-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203000000'),('8dc63ec1-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201040000'),('8dc653b0-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202010000'),('8dc669ab-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202020000'),('8dc68185-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203010000'),('9466d2dc-07d5-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010200'),('970569ee-07d8-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010400'),('974d1286-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010200'),('9e79cb72-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302020000'),('9f6f310f-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0400000000'),('9f6f4846-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0401000000'),('9f6f630f-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0402000000'),('9f6fadc6-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0403000000'),('9f6fc475-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0404000000'),('a0e2a82e-20f8-11e7-966c-a0c58951c8d5','vertex_root_join_sysadmin','1101020000'),('a11cab89-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1100000000'),('a11cc274-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1101000000'),('a11cd974-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1102000000'),('a11cee27-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1103000000'),('a11cfdc5-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1101010000'),('a2658092-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020100'),('a2a01355-07e5-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0101010300'),('ad3e53ed-07d8-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010500'),('ad96ffe8-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101010000'),('ad972957-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101010300'),('ad973d01-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101000000'),('ad974e5b-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101010200'),('af623c20-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301020000'),('af6254c6-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302010000'),('af6268c2-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302030000'),('af627c0a-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303010000'),('af62b80e-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0300000000'),('af62c935-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302000000'),('af62da9f-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303000000'),('af62e857-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302020000'),('af62f630-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303020000'),('af64a874-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303030000'),('af64be06-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301000000'),('af64d2b0-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301010000'),('af64e4f9-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301050000'),('b096b467-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303000000'),('b257854d-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0401000000'),('b5801636-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010300'),('b687b293-024a-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301020000'),('b6ca0b31-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010300'),('b6ca200b-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010400'),('b6ca36e4-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010500'),('b6ca480f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010600'),('b6ca5c0b-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010000'),('b6cab506-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103030200'),('b6cac00f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103000000'),('b6cad202-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020000'),('b6cae5b5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020400'),('b6caf864-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010200'),('b6cc6dcb-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010100'),('b6cc8746-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020400'),('b6cc9c46-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105000000'),('b6ccae31-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020200'),('b6ccbf4f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010100'),('b6ccd5ad-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010200'),('b6ccf9f1-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010300'),('b6cd0a06-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010300'),('b6cd1c82-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020510'),('b6cd3017-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010400'),('b6cd66f5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010000'),('b6cd7506-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010100'),('b6cd8439-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020500'),('b6cd9375-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020100'),('b6cda1f9-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020500'),('b6cdb0d7-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103030100'),('b6cdccfe-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010000'),('b6cddc28-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010200'),('b6cdea17-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020100'),('b6cdfb93-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010500'),('b6ce08d7-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103030400'),('b6ce14f1-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010400'),('b6ce228f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010500'),('b6ce2ded-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020200'),('b6ce39b5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020300'),('b6ce49b5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0100000000'),('b6ce568f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020000'),('b6ce7217-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020300'),('b8df3b71-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010500'),('ba1baad1-0249-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0300000000'),('bd267b0e-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020200'),('becdf6e3-0eb9-11e7-9612-a0c58951c8d5','vertex_root_join_sysadmin','0101010100'),('c1177dbf-07e1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030100'),('c3baf059-07ee-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020500'),('c8650311-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303010000'),('c988dc67-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010400'),('ca968c8b-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010100'),('ca96ae0b-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010200'),('ca96c387-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010300'),('ca96d85d-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','01030104001'),('ca96ecc7-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010000'),('ca970110-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101000000'),('ca9713fa-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0100000000'),('cb09f0fd-0eb9-11e7-9612-a0c58951c8d5','mas_join_masadmin','0101010100'),('cb4b16fb-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0402000000'),('d347b0d3-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0501010000'),('d517d48d-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020300'),('d6746779-0ba4-11e7-9649-a0c58951c8d5','mas_join_ftpdemo','0301010000'),('d8fd37ed-07e1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030200'),('daae0b92-07e6-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020100'),('dbaf4cc1-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010200'),('dbaf6401-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010000'),('dbaf77a3-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101010300'),('dbaf8930-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020300'),('dbaf991b-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020500'),('dbafaae3-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010100'),('dbafbc30-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010200'),('dbafce38-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010500'),('dbafdeca-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020200'),('dbaff192-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020500'),('dbb01efd-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101010000'),('dbb03370-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105040000'),('dbb0424a-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020400'),('dbb0533d-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010300'),('dbb063b8-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010100'),('dbb07456-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010300'),('dbb0868e-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020100'),('dbb098db-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010000'),('dbb0b6bd-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030200'),('dbb0c8d6-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030400'),('dbb0d7e7-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020000'),('dbb0e45f-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010100'),('dbb0f052-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010400'),('dbb0ff4a-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020400'),('dbb10c30-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030300'),('dbb1182c-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020000'),('dbb14505-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010200'),('dbb265ac-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010300'),('dbb27678-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010500'),('dbb2a54e-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020300'),('dbb2bf78-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020520'),('dbb2dbb4-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030100'),('dbb2e9c5-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0100000000'),('dbb2f83d-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105000000'),('dbb30885-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010000'),('dbb322ca-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020100'),('dbb33adf-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010400'),('dbb3539b-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010600'),('dbb36bf8-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105040200'),('dbb38238-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010400'),('dbb399f4-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105040100'),('dbb3b16c-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101000000'),('dbb3c901-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103000000'),('dbb3ddce-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101010200'),('dbb3f538-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010500'),('dbb40745-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020200'),('dbb41aa7-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020510'),('e4e93b85-46b1-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0501020000'),('e61931f7-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0403000000'),('ea23a4e6-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020400'),('ec5e6b47-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010500'),('ecfe2317-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303020000'),('ee768238-07e6-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020200'),('f0766b0d-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0100000000'),('f07680fd-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0101000000'),('f076a4d5-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0103000000'),('f076b2d1-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0103010000'),('f076c09b-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0103020000'),('f076e3ca-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0104010000'),('f076efb4-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105000000'),('f076fb82-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105010000'),('f077074b-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105020000'),('f0771e6b-c597-11e6-9b11-d4bed967cdf1','vertex_root_join_sysadmin','0101010000'),('f0771e6b-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105040000'),('f0cd283e-4666-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0500000000'),('f2e86103-07d2-11e7-95d9-a0c58951c8d5','vertex_root_join_sysadmin','0104010100'),('f44f6baa-46b0-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0503020000'),('f6a653e9-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0404000000'),('f82d2048-46b1-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0501020000'),('fb9787a0-07e1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030300');
+INSERT INTO `sys_role_resource_relat` VALUES ('00716df3-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010600'),('02d6cb28-16e1-11e7-95e0-a0c58951c8d5','mas_join_masadmin','0105040000'),('02d74d86-16e1-11e7-95e0-a0c58951c8d5','mas_join_masadmin','0105040100'),('02d7d7f5-16e1-11e7-95e0-a0c58951c8d5','mas_join_masadmin','0105040200'),('0574d053-07e7-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020300'),('0a7043a9-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0501010000'),('0a706464-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0502010000'),('0a7078f1-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0502020000'),('0a708f98-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0503010000'),('0a70a2f6-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0501000000'),('0a70ba07-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0502000000'),('0a70d529-467a-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0503000000'),('0ba023b2-4667-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0500000000'),('0f65406b-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201000000'),('0f655305-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201040000'),('0f656609-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203000000'),('0f657dda-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201030000'),('0f65938e-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203020000'),('0f65a7da-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203010000'),('0f65d3c9-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202000000'),('0f671952-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202010000'),('0f672d27-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202020000'),('0f6753eb-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0202040000'),('0f676552-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0203040000'),('0f678912-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0200000000'),('0f679a9f-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201010000'),('0f67bbf4-02df-11e7-9b60-a0c58951c8d5','mas_join_cademo','0201060000'),('0f931a5a-07f2-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105040100'),('0fed7044-024a-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301000000'),('15498bd1-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302000000'),('15499deb-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303000000'),('1549b2c0-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0301020000'),('1549c489-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302030000'),('1549da33-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303010000'),('1549ebe7-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303020000'),('1549ff00-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0301000000'),('154a0c8d-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302010000'),('154a1a9e-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0303030000'),('154a2a7c-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0300000000'),('154a62a2-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0302020000'),('154a7233-02df-11e7-9b60-a0c58951c8d5','mas_join_ftpdemo','0301050000'),('17994440-024c-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303030000'),('1bdeaba6-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010100'),('1bf28a08-07e7-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020400'),('1c3118cc-07e2-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030400'),('1c7f66c1-4672-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0502010000'),('2372c034-46b1-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0503020000'),('25167037-07f2-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105040200'),('32cfc9e5-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0401000000'),('32cfe510-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0402000000'),('32cff514-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0403000000'),('32d00969-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0404000000'),('32d0a0f2-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0400000000'),('33bb66bb-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010200'),('3b92fdf5-4672-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0502020000'),('3d23d85e-07e7-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020500'),('43ad40d2-07f1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020510'),('4704352b-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1100000000'),('470450e2-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1101000000'),('4704667c-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1102000000'),('47047a55-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1103000000'),('47048c2b-0acc-11e7-998e-a0c58951c8d5','mas_join_masadmin','1101010000'),('48463b39-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010300'),('48fb522e-04a4-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301010000'),('53c399c4-024c-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302030000'),('55a149ee-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020500'),('55a16810-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020300'),('55a17bc3-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020400'),('55a18b54-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010200'),('55a199c3-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030300'),('55a1b0d0-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020520'),('55a1c1e1-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010000'),('55a1da99-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010400'),('55a1ecf2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010600'),('55a3cd2a-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105000000'),('55a42994-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010500'),('55a48f77-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010000'),('55a4c0d9-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010000'),('55a4efa6-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105040000'),('55a51f7f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010200'),('55a566b2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020100'),('55a58c3f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020400'),('55a5abc3-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0100000000'),('55a5c961-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103000000'),('55a5ddd9-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030100'),('55a5f73b-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030400'),('55a61bb2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020500'),('55a640b7-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105040100'),('55a65ed0-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020200'),('55a67332-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010300'),('55a684f2-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010500'),('55a6cb2e-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010300'),('55a711cc-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010500'),('55a7297f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020100'),('55a74032-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101000000'),('55a757d0-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010000'),('55a76915-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020200'),('55a77b15-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020300'),('55a78c3f-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010400'),('55a8088c-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010200'),('55a87773-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010100'),('55a8a7c8-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010100'),('55a8bd08-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105040200'),('55a8eaf7-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103030200'),('55a900c4-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020510'),('55a912e6-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103020000'),('55a925c8-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105020000'),('55a938ea-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0103010300'),('55a94aa1-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0104010400'),('55a95d48-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010100'),('55a98588-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0105010200'),('55a9998c-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010100'),('55a9af08-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0101010300'),('5a587e71-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0400000000'),('5a588e25-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0401000000'),('5a589e29-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0402000000'),('5a5a35ba-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0403000000'),('5a5a4743-1f9d-11e7-9677-a0c58951c8d5','devops_product_join_43124','0404000000'),('5a7db1f7-07f1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020520'),('5c60bc08-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301050000'),('5cdef223-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0501000000'),('60700eba-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1101000000'),('607033cf-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1100000000'),('6070454b-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1101010000'),('6402f992-4672-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0503010000'),('68ebf2c8-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1103000000'),('692c628f-1c0a-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0101010100'),('6a935ea9-1fed-11e7-9677-a0c58951c8d5','vertex_root_join_sysadmin','1102000000'),('6bb7e04d-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010400'),('6c7f6d2a-250a-11e7-9c7e-a0c58951c8d5','vertex_root_join_sysadmin','01030104001'),('72939327-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302000000'),('7c3618ec-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0502000000'),('7d73294c-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010100'),('8009b52c-0ba4-11e7-9649-a0c58951c8d5','mas_join_cademo','0203050000'),('8024c16b-07d8-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010300'),('824c1f28-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0400000000'),('83794268-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302010000'),('8857ba73-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0503000000'),('8ca4f732-07e5-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0101010200'),('8dc4fada-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201060000'),('8dc56ba3-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203040000'),('8dc57fe7-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203050000'),('8dc59452-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202000000'),('8dc5a6f0-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201010000'),('8dc5bba7-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0200000000'),('8dc5d11a-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201030000'),('8dc5e7da-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202040000'),('8dc5ffda-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203020000'),('8dc6176b-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201000000'),('8dc62d85-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203000000'),('8dc63ec1-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0201040000'),('8dc653b0-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202010000'),('8dc669ab-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0202020000'),('8dc68185-5bb3-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','0203010000'),('9466d2dc-07d5-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010200'),('970569ee-07d8-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010400'),('974d1286-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010200'),('9e79cb72-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0302020000'),('9f6f310f-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0400000000'),('9f6f4846-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0401000000'),('9f6f630f-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0402000000'),('9f6fadc6-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0403000000'),('9f6fc475-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','0404000000'),('a0e2a82e-20f8-11e7-966c-a0c58951c8d5','vertex_root_join_sysadmin','1101020000'),('a11cab89-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1100000000'),('a11cc274-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1101000000'),('a11cd974-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1102000000'),('a11cee27-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1103000000'),('a11cfdc5-1f8a-11e7-9677-a0c58951c8d5','devops_product_join_ftpadmin','1101010000'),('a2658092-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020100'),('a2a01355-07e5-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0101010300'),('ad3e53ed-07d8-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0104010500'),('ad96ffe8-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101010000'),('ad972957-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101010300'),('ad973d01-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101000000'),('ad974e5b-0992-11e7-952f-a0c58951c8d5','mas_join_masadmin','0101010200'),('af623c20-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301020000'),('af6254c6-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302010000'),('af6268c2-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302030000'),('af627c0a-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303010000'),('af62b80e-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0300000000'),('af62c935-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302000000'),('af62da9f-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303000000'),('af62e857-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0302020000'),('af62f630-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303020000'),('af64a874-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0303030000'),('af64be06-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301000000'),('af64d2b0-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301010000'),('af64e4f9-1aca-11e7-9d82-a0c58951c8d5','devops_product_join_ftpadmin','0301050000'),('b096b467-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303000000'),('b257854d-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0401000000'),('b5801636-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010300'),('b687b293-024a-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0301020000'),('b6ca0b31-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010300'),('b6ca200b-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010400'),('b6ca36e4-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010500'),('b6ca480f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010600'),('b6ca5c0b-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010000'),('b6cab506-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103030200'),('b6cac00f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103000000'),('b6cad202-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020000'),('b6cae5b5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020400'),('b6caf864-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010200'),('b6cc6dcb-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010100'),('b6cc8746-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020400'),('b6cc9c46-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105000000'),('b6ccae31-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020200'),('b6ccbf4f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010100'),('b6ccd5ad-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010200'),('b6ccf9f1-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010300'),('b6cd0a06-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010300'),('b6cd1c82-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020510'),('b6cd3017-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010400'),('b6cd66f5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010000'),('b6cd7506-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010100'),('b6cd8439-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020500'),('b6cd9375-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020100'),('b6cda1f9-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020500'),('b6cdb0d7-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103030100'),('b6cdccfe-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010000'),('b6cddc28-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103010200'),('b6cdea17-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020100'),('b6cdfb93-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010500'),('b6ce08d7-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103030400'),('b6ce14f1-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0104010400'),('b6ce228f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105010500'),('b6ce2ded-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020200'),('b6ce39b5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020300'),('b6ce49b5-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0100000000'),('b6ce568f-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0105020000'),('b6ce7217-0881-11e7-952f-a0c58951c8d5','mas_join_masadmin','0103020300'),('b8df3b71-07e9-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103010500'),('ba1baad1-0249-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0300000000'),('bd267b0e-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020200'),('becdf6e3-0eb9-11e7-9612-a0c58951c8d5','vertex_root_join_sysadmin','0101010100'),('c1177dbf-07e1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030100'),('c3baf059-07ee-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020500'),('c8650311-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303010000'),('c988dc67-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010400'),('ca968c8b-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010100'),('ca96ae0b-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010200'),('ca96c387-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010300'),('ca96d85d-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','01030104001'),('ca96ecc7-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101010000'),('ca970110-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0101000000'),('ca9713fa-289c-11e7-9c7e-a0c58951c8d5','mas_join_cademo','0100000000'),('cb09f0fd-0eb9-11e7-9612-a0c58951c8d5','mas_join_masadmin','0101010100'),('cb4b16fb-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0402000000'),('d347b0d3-4671-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0501010000'),('d517d48d-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020300'),('d6746779-0ba4-11e7-9649-a0c58951c8d5','mas_join_ftpdemo','0301010000'),('d8fd37ed-07e1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030200'),('daae0b92-07e6-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020100'),('dbaf4cc1-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010200'),('dbaf6401-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010000'),('dbaf77a3-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101010300'),('dbaf8930-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020300'),('dbaf991b-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020500'),('dbafaae3-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010100'),('dbafbc30-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010200'),('dbafce38-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010500'),('dbafdeca-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020200'),('dbaff192-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020500'),('dbb01efd-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101010000'),('dbb03370-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105040000'),('dbb0424a-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020400'),('dbb0533d-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010300'),('dbb063b8-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010100'),('dbb07456-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010300'),('dbb0868e-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020100'),('dbb098db-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010000'),('dbb0b6bd-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030200'),('dbb0c8d6-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030400'),('dbb0d7e7-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020000'),('dbb0e45f-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010100'),('dbb0f052-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010400'),('dbb0ff4a-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020400'),('dbb10c30-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030300'),('dbb1182c-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020000'),('dbb14505-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010200'),('dbb265ac-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010300'),('dbb27678-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010500'),('dbb2a54e-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020300'),('dbb2bf78-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020520'),('dbb2dbb4-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103030100'),('dbb2e9c5-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0100000000'),('dbb2f83d-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105000000'),('dbb30885-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010000'),('dbb322ca-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020100'),('dbb33adf-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010400'),('dbb3539b-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105010600'),('dbb36bf8-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105040200'),('dbb38238-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0104010400'),('dbb399f4-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105040100'),('dbb3b16c-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101000000'),('dbb3c901-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103000000'),('dbb3ddce-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0101010200'),('dbb3f538-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103010500'),('dbb40745-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0103020200'),('dbb41aa7-0dd7-11e7-9612-a0c58951c8d5','devops_product_join_ftpadmin','0105020510'),('e4e93b85-46b1-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0501020000'),('e61931f7-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0403000000'),('ea23a4e6-07ed-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105020400'),('ec5e6b47-07ec-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0105010500'),('ecfe2317-024b-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0303020000'),('ee768238-07e6-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103020200'),('f0766b0d-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0100000000'),('f07680fd-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0101000000'),('f076a4d5-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0103000000'),('f076b2d1-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0103010000'),('f076c09b-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0103020000'),('f076e3ca-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0104010000'),('f076efb4-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105000000'),('f076fb82-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105010000'),('f077074b-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105020000'),('f0771e6b-c597-11e6-9b11-d4bed967cdf1','vertex_root_join_sysadmin','0101010000'),('f0771e6b-c597-11e6-9b11-d4bed967cdff','vertex_root_join_sysadmin','0105040000'),('f0cd283e-4666-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0500000000'),('f2e86103-07d2-11e7-95d9-a0c58951c8d5','vertex_root_join_sysadmin','0104010100'),('f44f6baa-46b0-11e7-9beb-a0c58951c8d5','vertex_root_join_sysadmin','0503020000'),('f6a653e9-04a3-11e7-9b60-a0c58951c8d5','vertex_root_join_sysadmin','0404000000'),('f82d2048-46b1-11e7-9beb-a0c58951c8d5','mas_join_masadmin','0501020000'),('fb9787a0-07e1-11e7-952f-a0c58951c8d5','vertex_root_join_sysadmin','0103030300'),('78bf4c52-ca9d-11f1-aa61-02fc00000001','vertex_root_join_sysadmin','0105010700'),('35e45240-ca9f-11f1-a91d-02fc00000001','vertex_root_join_sysadmin','0104010600'),('35e5311a-ca9f-11f1-a91d-02fc00000001','vertex_root_join_sysadmin','0104010700'),('35e61030-ca9f-11f1-a91d-02fc00000001','vertex_root_join_sysadmin','0104010800'),('22733752-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0104010900'),('2273ddce-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0104011000'),('227475a4-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0104011100'),('22751734-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0105010800'),('34c22ac4-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011200'),('34c39f6c-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011300'),('34c4e8ea-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011400'),('34c61ac6-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011500'),('34c7463a-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011600'),('00f49d02-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0104011700'),('00f57c4a-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0104011800'),('00f65a84-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0104011900'),('00f74d18-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0105010900'),('00f83066-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0105011000'),('00f90d74-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0105011100'),('065030a8-caa4-11f1-b889-02fc00000001','vertex_root_join_sysadmin','0105011200'),('065104c4-caa4-11f1-b889-02fc00000001','vertex_root_join_sysadmin','0105011300'),('0651cb3e-caa4-11f1-b889-02fc00000001','vertex_root_join_sysadmin','0105011400'),('a57817b8-caa4-11f1-b6d3-02fc00000001','vertex_root_join_sysadmin','0105011500'),('a57958b2-caa4-11f1-b6d3-02fc00000001','vertex_root_join_sysadmin','0105011600'),('efdc405e-caa4-11f1-a7ad-02fc00000001','vertex_root_join_sysadmin','0105011700'),('6f169652-caa6-11f1-b749-02fc00000001','vertex_root_join_sysadmin','0103010600'),('2cb995ce-caa7-11f1-aa8a-02fc00000001','vertex_root_join_sysadmin','0105040300'),('2cba50fe-caa7-11f1-aa8a-02fc00000001','vertex_root_join_sysadmin','0105040400'),('ee5fdc24-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103010700'),('ee5fde0e-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103010700'),('ee5fde90-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103010700'),('ee5fdf1c-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103010700'),('ee60f226-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103010800'),('ee60f4ce-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103010800'),('ee60f55a-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103010800'),('ee60f5dc-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103010800'),('ee622ac4-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103020600'),('ee622cc2-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103020600'),('ee622d4e-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103020600'),('ee622dc6-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103020600'),('ee636af6-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103020700'),('ee636cfe-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103020700'),('ee636d94-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103020700'),('ee636e0c-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103020700'),('ee64aa9c-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103030500'),('ee64aec0-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103030500'),('ee64af4c-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103030500'),('ee64afba-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103030500'),('ee65ed12-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0104012000'),('ee65ef24-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0104012000'),('ee65efa6-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0104012000'),('ee65f21c-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0104012000'),('ee674090-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0105011800'),('ee6742e8-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0105011800'),('ee67437e-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0105011800'),('ee6743ec-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0105011800'),('ee68b588-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0105040500'),('ee68b7e0-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0105040500'),('ee68b880-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0105040500'),('ee68b8ee-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0105040500'),('ee6a18e2-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0105040600'),('ee6a1b30-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0105040600'),('ee6a1bda-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0105040600'),('ee6a1c48-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0105040600');
 /*!40000 ALTER TABLE `sys_role_resource_relat` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -368,6 +784,8 @@ CREATE TABLE `sys_role_user_relation` (
   `user_id` varchar(30) DEFAULT NULL,
   `maintance_date` date DEFAULT NULL,
   `maintance_user` varchar(30) DEFAULT NULL,
+  `org_scope` varchar(66) DEFAULT NULL,
+  `org_scope_sub` char(1) NOT NULL DEFAULT '0',
   PRIMARY KEY (`uuid`),
   KEY `fk_sys_idx_03` (`user_id`),
   KEY `fk_sys_role_user_01_idx` (`role_id`),
@@ -382,7 +800,7 @@ CREATE TABLE `sys_role_user_relation` (
 
 LOCK TABLES `sys_role_user_relation` WRITE;
 /*!40000 ALTER TABLE `sys_role_user_relation` DISABLE KEYS */;
-INSERT INTO `sys_role_user_relation` VALUES ('19890228hzwy23','vertex_root_join_sysadmin','admin','2000-01-01','hzwy23'),('74f3ba5a-5bb4-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','431243','2017-06-28','admin'),('caadmin_join_mas_join_cademo','mas_join_cademo','caadmin','2017-06-28','admin'),('demo_join_mas_join_cademo','mas_join_cademo','demo','2017-06-28','admin');
+INSERT INTO `sys_role_user_relation` VALUES ('19890228hzwy23','vertex_root_join_sysadmin','admin','2000-01-01','hzwy23',NULL,'0'),('74f3ba5a-5bb4-11e7-9d30-a0c58951c8d5','vertex_root_join_sysadmin','431243','2017-06-28','admin',NULL,'0'),('caadmin_join_mas_join_cademo','mas_join_cademo','caadmin','2017-06-28','admin',NULL,'0'),('demo_join_mas_join_cademo','mas_join_cademo','demo','2017-06-28','admin',NULL,'0');
 /*!40000 ALTER TABLE `sys_role_user_relation` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -395,9 +813,16 @@ DROP TABLE IF EXISTS `sys_sec_user`;
 /*!40101 SET character_set_client = utf8 */;
 CREATE TABLE `sys_sec_user` (
   `user_id` varchar(30) NOT NULL,
-  `user_passwd` varchar(30) DEFAULT NULL,
+  `user_passwd` varchar(100) DEFAULT NULL,
   `status_id` char(1) DEFAULT NULL,
   `continue_error_cnt` int(11) DEFAULT NULL,
+  `passwd_time` bigint(20) DEFAULT NULL,
+  `force_change` char(1) DEFAULT '0',
+  `lock_cnt` int(11) NOT NULL DEFAULT '0',
+  `lock_reason` char(1) NOT NULL DEFAULT '0',
+  `lock_time` bigint(20) NOT NULL DEFAULT '0',
+  `unlock_time` bigint(20) NOT NULL DEFAULT '0',
+  `account_type` char(1) NOT NULL DEFAULT '0' COMMENT '0 普通用户, 1 服务账号',
   PRIMARY KEY (`user_id`),
   KEY `fk_sys_idx_02` (`status_id`),
   CONSTRAINT `fk_sys_idx_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE,
@@ -411,10 +836,39 @@ CREATE TABLE `sys_sec_user` (
 
 LOCK TABLES `sys_sec_user` WRITE;
 /*!40000 ALTER TABLE `sys_sec_user` DISABLE KEYS */;
-INSERT INTO `sys_sec_user` VALUES ('431243','CguSVgQY2Df4LxG0UT/xwA==','0',NULL),('admin','rVbaiQ3XuCj8aCnhIL1KAA==','0',0),('caadmin','CguSVgQY2Df4LxG0UT/xwA==','0',0),('demo','CguSVgQY2Df4LxG0UT/xwA==','0',0);
+INSERT INTO `sys_sec_user` VALUES ('431243','CguSVgQY2Df4LxG0UT/xwA==','0',NULL,NULL,'0',0,'0',0,0,'0'),('admin','rVbaiQ3XuCj8aCnhIL1KAA==','0',0,NULL,'0',0,'0',0,0,'0'),('caadmin','CguSVgQY2Df4LxG0UT/xwA==','0',0,NULL,'0',0,'0',0,0,'0'),('demo','CguSVgQY2Df4LxG0UT/xwA==','0',0,NULL,'0',0,'0',0,0,'0');
 /*!40000 ALTER TABLE `sys_sec_user` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_super_admin_audit`
+--
+
+DROP TABLE IF EXISTS `sys_super_admin_audit`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_super_admin_audit` (
+  `uuid` varchar(66) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `role_id` varchar(66) NOT NULL,
+  `action` varchar(10) NOT NULL COMMENT 'grant 授予, revoke 移除',
+  `handle_user` varchar(30) NOT NULL,
+  `handle_time` bigint(20) NOT NULL,
+  `client_ip` varchar(60) DEFAULT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_super_admin_audit_01` (`handle_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='超级管理员角色的授予与移除记录';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_super_admin_audit`
+--
+
+LOCK TABLES `sys_super_admin_audit` WRITE;
+/*!40000 ALTER TABLE `sys_super_admin_audit` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_super_admin_audit` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_theme_info`
 --
@@ -457,6 +911,7 @@ CREATE TABLE `sys_theme_value` (
   `group_id` char(1) DEFAULT NULL,
   `res_img` varchar(200) DEFAULT NULL,
   `sort_id` decimal(10,0) DEFAULT NULL,
+  `res_method` varchar(60) DEFAULT NULL COMMENT 'API允许的HTTP方法,逗号分隔,为空时允许所有方法',
   KEY `pk_sys_theme_value_01` (`uuid`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 /*!40101 SET character_set_client = @saved_cs_client */;
@@ -467,10 +922,101 @@ CREATE TABLE `sys_theme_value` (
 
 LOCK TABLES `sys_theme_value` WRITE;
 /*!40000 ALTER TABLE `sys_theme_value` DISABLE KEYS */;
-INSERT INTO `sys_theme_value` VALUES ('1001-0101010000','1001','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1),('1001-0103010000','1001','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3),('1001-0104010000','1001','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1),('1001-0105010000','1001','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1),('1001-0105020000','1001','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2),('1001-0100000000','1001','0100000000','./views/hauth/theme/default/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1),('1001-0105040000','1001','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4),('1001-0103020000','1001','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2),('54786c62-0246-11e7-9b60-a0c58951c8d5','1001','0200000000','./apps/mas/ca/views/cyan/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1),('fb944b0a-0246-11e7-9b60-a0c58951c8d5','1001','0201010000','/v1/ca/responsibility/page','0','#6fc07c','tile tile-wide','1','/static/images/ca_icon/org_info.png',1),('5046d07a-0247-11e7-9b60-a0c58951c8d5','1001','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3),('7929aa2b-0247-11e7-9b60-a0c58951c8d5','1001','0201040000','/v1/ca/driver/page','0','#b4d39e','tile','1','/static/images/ca_icon/driver_info.png',4),('c93c4e93-0247-11e7-9b60-a0c58951c8d5','1001','0201060000','/v1/ca/cost/page','0','#e4d690','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6),('f02a3b32-0247-11e7-9b60-a0c58951c8d5','1001','0202010000','/v1/ca/static/radio/page','0','#009966','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1),('19c73fba-0248-11e7-9b60-a0c58951c8d5','1001','0202020000','/v1/ca/amart/rules/page','0','#3399CC','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2),('66e55e26-0248-11e7-9b60-a0c58951c8d5','1001','0202040000','/v1/ca/amart/group/page','0','#99CC33','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4),('8a180b66-0248-11e7-9b60-a0c58951c8d5','1001','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1),('a831ec58-0248-11e7-9b60-a0c58951c8d5','1001','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2),('dd815000-0248-11e7-9b60-a0c58951c8d5','1001','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3),('ba1a252f-0249-11e7-9b60-a0c58951c8d5','1001','0300000000','./apps/mas/ftp/views/theme/default/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2),('948f67dc-024a-11e7-9b60-a0c58951c8d5','1001','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1),('b687a0e9-024a-11e7-9b60-a0c58951c8d5','1001','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2),('5c60abdd-024b-11e7-9b60-a0c58951c8d5','1001','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3),('83792fdb-024b-11e7-9b60-a0c58951c8d5','1001','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2),('9e79b725-024b-11e7-9b60-a0c58951c8d5','1001','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3),('c864e93c-024b-11e7-9b60-a0c58951c8d5','1001','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1),('ecfe0b20-024b-11e7-9b60-a0c58951c8d5','1001','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2),('1797ac80-024c-11e7-9b60-a0c58951c8d5','1001','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3),('53c3813f-024c-11e7-9b60-a0c58951c8d5','1001','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1),('624b90c0-0278-11e7-9b60-a0c58951c8d5','1002','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1),('824c0d97-04a3-11e7-9b60-a0c58951c8d5','1001','0400000000','./apps/mas/common/views/green/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1),('b2561d1e-04a3-11e7-9b60-a0c58951c8d5','1001','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1),('cb4afcc4-04a3-11e7-9b60-a0c58951c8d5','1001','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2),('e6191fef-04a3-11e7-9b60-a0c58951c8d5','1001','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1),('f6a6448b-04a3-11e7-9b60-a0c58951c8d5','1001','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1),('48fb4303-04a4-11e7-9b60-a0c58951c8d5','1001','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1),('f2e81083-07d2-11e7-95d9-a0c58951c8d5','1001','0104010100','/v1/auth/domain/get','0','','','','',0),('946658e9-07d5-11e7-952f-a0c58951c8d5','1001','0104010200','/v1/auth/domain/share/page','0','','','','',0),('8024ac09-07d8-11e7-952f-a0c58951c8d5','1001','0104010300','/v1/auth/domain/update','0','','','','',0),('9705437b-07d8-11e7-952f-a0c58951c8d5','1001','0104010400','/v1/auth/domain/delete','0','','','','',0),('ad3e295c-07d8-11e7-952f-a0c58951c8d5','1001','0104010500','/v1/auth/domain/post','0','','','','',0),('c1174621-07e1-11e7-952f-a0c58951c8d5','1001','0103030100','/v1/auth/domain/share/get','0','','','','',0),('d8fccbcb-07e1-11e7-952f-a0c58951c8d5','1001','0103030200','/v1/auth/domain/share/post','0','','','','',0),('fb975107-07e1-11e7-952f-a0c58951c8d5','1001','0103030300','/v1/auth/domain/share/delete','0','','','','',0),('1c30f988-07e2-11e7-952f-a0c58951c8d5','1001','0103030400','/v1/auth/domain/share/put','0','','','','',0),('8ca386d8-07e5-11e7-952f-a0c58951c8d5','1001','0101010200','/v1/auth/handle/logs/download','0','','','','',0),('a29fba3f-07e5-11e7-952f-a0c58951c8d5','1001','0101010300','/v1/auth/handle/logs/search','0','','','','',0),('daadf91b-07e6-11e7-952f-a0c58951c8d5','1001','0103020100','/v1/auth/resource/org/get','0','','','','',0),('ee765e9a-07e6-11e7-952f-a0c58951c8d5','1001','0103020200','/v1/auth/resource/org/insert','0','','','','',0),('0574add7-07e7-11e7-952f-a0c58951c8d5','1001','0103020300','/v1/auth/resource/org/update','0','','','','',0),('1bf270aa-07e7-11e7-952f-a0c58951c8d5','1001','0103020400','/v1/auth/resource/org/delete','0','','','','',0),('3d237ba7-07e7-11e7-952f-a0c58951c8d5','1001','0103020500','/v1/auth/resource/org/download','0','','','','',0),('1bde8991-07e9-11e7-952f-a0c58951c8d5','1001','0103010100','/v1/auth/resource/get','0','','','','',0),('33b9cb0c-07e9-11e7-952f-a0c58951c8d5','1001','0103010200','/v1/auth/resource/post','0','','','','',0),('48460086-07e9-11e7-952f-a0c58951c8d5','1001','0103010300','/v1/auth/resource/update','0','','','','',0),('6bb7b2c8-07e9-11e7-952f-a0c58951c8d5','1001','0103010400','/v1/auth/resource/delete','0','','','','',0),('b8df0cd7-07e9-11e7-952f-a0c58951c8d5','1001','0103010500','/v1/auth/resource/config/theme','0','','','','',0),('7d73058c-07ec-11e7-952f-a0c58951c8d5','1001','0105010100','/v1/auth/user/get','0','','','','',0),('974ce1fd-07ec-11e7-952f-a0c58951c8d5','1001','0105010200','/v1/auth/user/post','0','','','','',0),('b58002f6-07ec-11e7-952f-a0c58951c8d5','1001','0105010300','/v1/auth/user/put','0','','','','',0),('c988bb89-07ec-11e7-952f-a0c58951c8d5','1001','0105010400','/v1/auth/user/delete','0','','','','',0),('ec5cb33a-07ec-11e7-952f-a0c58951c8d5','1001','0105010500','/v1/auth/user/modify/passwd','0','','','','',0),('00714873-07ed-11e7-952f-a0c58951c8d5','1001','0105010600','/v1/auth/user/modify/status','0','','','','',0),('a265597d-07ed-11e7-952f-a0c58951c8d5','1001','0105020100','/v1/auth/role/get','0','','','','',0),('bd264fd7-07ed-11e7-952f-a0c58951c8d5','1001','0105020200','/v1/auth/role/post','0','','','','',0),('d517aab8-07ed-11e7-952f-a0c58951c8d5','1001','0105020300','/v1/auth/role/update','0','','','','',0),('ea237b6a-07ed-11e7-952f-a0c58951c8d5','1001','0105020400','/v1/auth/role/delete','0','','','','',0),('c3bad47b-07ee-11e7-952f-a0c58951c8d5','1001','0105020500','/v1/auth/role/resource/details','0','','','','',0),('43ad2a9a-07f1-11e7-952f-a0c58951c8d5','1001','0105020510','/v1/auth/role/resource/get','0','','','','',0),('5a7d8dbf-07f1-11e7-952f-a0c58951c8d5','1001','0105020520','/v1/auth/role/resource/rights','0','','','','',0),('0f9303e2-07f2-11e7-952f-a0c58951c8d5','1001','0105040100','/v1/auth/user/roles/auth','0','','','','',0),('25165700-07f2-11e7-952f-a0c58951c8d5','1001','0105040200','/v1/auth/user/roles/revoke','0','','','','',0),('0e9aec3f-094c-11e7-952f-a0c58951c8d5','1001','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4),('f87a9123-0991-11e7-952f-a0c58951c8d5','1001','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1),('991641c3-0d55-11e7-964b-a0c58951c8d5','1004','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1),('99164f5c-0d55-11e7-964b-a0c58951c8d5','1004','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3),('9916502d-0d55-11e7-964b-a0c58951c8d5','1004','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1),('991650a9-0d55-11e7-964b-a0c58951c8d5','1004','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1),('9916512d-0d55-11e7-964b-a0c58951c8d5','1004','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2),('9916519c-0d55-11e7-964b-a0c58951c8d5','1004','0100000000','./views/hauth/theme/cyan/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1),('99165203-0d55-11e7-964b-a0c58951c8d5','1004','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4),('9916525c-0d55-11e7-964b-a0c58951c8d5','1004','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2),('991652b2-0d55-11e7-964b-a0c58951c8d5','1004','0200000000','./apps/mas/ca/views/green/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1),('9916534b-0d55-11e7-964b-a0c58951c8d5','1004','0201010000','/v1/ca/responsibility/page','0','#6fc07c','tile tile-wide','1','/static/images/ca_icon/org_info.png',1),('9916545c-0d55-11e7-964b-a0c58951c8d5','1004','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3),('991654be-0d55-11e7-964b-a0c58951c8d5','1004','0201040000','/v1/ca/driver/page','0','#b4d39e','tile','1','/static/images/ca_icon/driver_info.png',4),('991657d4-0d55-11e7-964b-a0c58951c8d5','1004','0201060000','/v1/ca/cost/page','0','#e4d690','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6),('9916933a-0d55-11e7-964b-a0c58951c8d5','1004','0202010000','/v1/ca/static/radio/page','0','#92cdd2','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1),('9917f369-0d55-11e7-964b-a0c58951c8d5','1004','0202020000','/v1/ca/amart/rules/page','0','#58c0b3','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2),('9917f42d-0d55-11e7-964b-a0c58951c8d5','1004','0202040000','/v1/ca/amart/group/page','0','#ded1b0','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4),('9917f48b-0d55-11e7-964b-a0c58951c8d5','1004','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1),('9917f4cb-0d55-11e7-964b-a0c58951c8d5','1004','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2),('9917f598-0d55-11e7-964b-a0c58951c8d5','1004','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3),('9917f676-0d55-11e7-964b-a0c58951c8d5','1004','0300000000','./apps/mas/ftp/views/theme/cyan/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2),('9917f6e5-0d55-11e7-964b-a0c58951c8d5','1004','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1),('9917f743-0d55-11e7-964b-a0c58951c8d5','1004','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2),('9917f7ba-0d55-11e7-964b-a0c58951c8d5','1004','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3),('9917f818-0d55-11e7-964b-a0c58951c8d5','1004','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2),('9917f869-0d55-11e7-964b-a0c58951c8d5','1004','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3),('9917f8b6-0d55-11e7-964b-a0c58951c8d5','1004','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1),('99180aad-0d55-11e7-964b-a0c58951c8d5','1004','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2),('99180b3a-0d55-11e7-964b-a0c58951c8d5','1004','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3),('99180b7a-0d55-11e7-964b-a0c58951c8d5','1004','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1),('99180bfa-0d55-11e7-964b-a0c58951c8d5','1004','0400000000','./apps/mas/common/views/cyan/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1),('99180c36-0d55-11e7-964b-a0c58951c8d5','1004','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1),('99180c72-0d55-11e7-964b-a0c58951c8d5','1004','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2),('99180ca9-0d55-11e7-964b-a0c58951c8d5','1004','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1),('99180ced-0d55-11e7-964b-a0c58951c8d5','1004','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1),('99180d2d-0d55-11e7-964b-a0c58951c8d5','1004','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1),('99180d65-0d55-11e7-964b-a0c58951c8d5','1004','0104010100','/v1/auth/domain/get','0','','','','',0),('99180da1-0d55-11e7-964b-a0c58951c8d5','1004','0104010200','/v1/auth/domain/share/page','0','','','','',0),('99180ddc-0d55-11e7-964b-a0c58951c8d5','1004','0104010300','/v1/auth/domain/update','0','','','','',0),('99180e14-0d55-11e7-964b-a0c58951c8d5','1004','0104010400','/v1/auth/domain/delete','0','','','','',0),('99180e4f-0d55-11e7-964b-a0c58951c8d5','1004','0104010500','/v1/auth/domain/post','0','','','','',0),('99180e87-0d55-11e7-964b-a0c58951c8d5','1004','0103030100','/v1/auth/domain/share/get','0','','','','',0),('99180ec3-0d55-11e7-964b-a0c58951c8d5','1004','0103030200','/v1/auth/domain/share/post','0','','','','',0),('99180efa-0d55-11e7-964b-a0c58951c8d5','1004','0103030300','/v1/auth/domain/share/delete','0','','','','',0),('99180f32-0d55-11e7-964b-a0c58951c8d5','1004','0103030400','/v1/auth/domain/share/put','0','','','','',0),('99180fa1-0d55-11e7-964b-a0c58951c8d5','1004','0101010200','/v1/auth/handle/logs/download','0','','','','',0),('99180fdc-0d55-11e7-964b-a0c58951c8d5','1004','0101010300','/v1/auth/handle/logs/search','0','','','','',0),('99181014-0d55-11e7-964b-a0c58951c8d5','1004','0103020100','/v1/auth/resource/org/get','0','','','','',0),('9918104b-0d55-11e7-964b-a0c58951c8d5','1004','0103020200','/v1/auth/resource/org/insert','0','','','','',0),('99181087-0d55-11e7-964b-a0c58951c8d5','1004','0103020300','/v1/auth/resource/org/update','0','','','','',0),('991810be-0d55-11e7-964b-a0c58951c8d5','1004','0103020400','/v1/auth/resource/org/delete','0','','','','',0),('991810fe-0d55-11e7-964b-a0c58951c8d5','1004','0103020500','/v1/auth/resource/org/download','0','','','','',0),('9918113a-0d55-11e7-964b-a0c58951c8d5','1004','0103010100','/v1/auth/resource/get','0','','','','',0),('99181176-0d55-11e7-964b-a0c58951c8d5','1004','0103010200','/v1/auth/resource/post','0','','','','',0),('991811ad-0d55-11e7-964b-a0c58951c8d5','1004','0103010300','/v1/auth/resource/update','0','','','','',0),('991811e1-0d55-11e7-964b-a0c58951c8d5','1004','0103010400','/v1/auth/resource/delete','0','','','','',0),('99181218-0d55-11e7-964b-a0c58951c8d5','1004','0103010500','/v1/auth/resource/config/theme','0','','','','',0),('9918124f-0d55-11e7-964b-a0c58951c8d5','1004','0105010100','/v1/auth/user/get','0','','','','',0),('9918128b-0d55-11e7-964b-a0c58951c8d5','1004','0105010200','/v1/auth/user/post','0','','','','',0),('991812c3-0d55-11e7-964b-a0c58951c8d5','1004','0105010300','/v1/auth/user/put','0','','','','',0),('991812fa-0d55-11e7-964b-a0c58951c8d5','1004','0105010400','/v1/auth/user/delete','0','','','','',0),('99181332-0d55-11e7-964b-a0c58951c8d5','1004','0105010500','/v1/auth/user/modify/passwd','0','','','','',0),('99181365-0d55-11e7-964b-a0c58951c8d5','1004','0105010600','/v1/auth/user/modify/status','0','','','','',0),('9918139c-0d55-11e7-964b-a0c58951c8d5','1004','0105020100','/v1/auth/role/get','0','','','','',0),('991813d4-0d55-11e7-964b-a0c58951c8d5','1004','0105020200','/v1/auth/role/post','0','','','','',0),('9918140b-0d55-11e7-964b-a0c58951c8d5','1004','0105020300','/v1/auth/role/update','0','','','','',0),('99181443-0d55-11e7-964b-a0c58951c8d5','1004','0105020400','/v1/auth/role/delete','0','','','','',0),('99181476-0d55-11e7-964b-a0c58951c8d5','1004','0105020500','/v1/auth/role/resource/details','0','','','','',0),('991814ad-0d55-11e7-964b-a0c58951c8d5','1004','0105020510','/v1/auth/role/resource/get','0','','','','',0),('991814f2-0d55-11e7-964b-a0c58951c8d5','1004','0105020520','/v1/auth/role/resource/rights','0','','','','',0),('9918152d-0d55-11e7-964b-a0c58951c8d5','1004','0105040100','/v1/auth/user/roles/auth','0','','','','',0),('99181569-0d55-11e7-964b-a0c58951c8d5','1004','0105040200','/v1/auth/user/roles/revoke','0','','','','',0),('991815a1-0d55-11e7-964b-a0c58951c8d5','1004','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4),('991815e1-0d55-11e7-964b-a0c58951c8d5','1004','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1),('becde5db-0eb9-11e7-9612-a0c58951c8d5','1001','0101010100','/v1/auth/handle/logs','0','','','','',0),('8e2d2ae7-1c0a-11e7-9d82-a0c58951c8d5','1004','0101010100','/v1/auth/handle/logs','0','','tile tile-large','','',0),('a0e208f2-20f8-11e7-966c-a0c58951c8d5','1001','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1),('b3f18e0b-20f8-11e7-966c-a0c58951c8d5','1004','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1),('6c7f5772-250a-11e7-9c7e-a0c58951c8d5','1001','01030104001','/v1/auth/resource/org/page','','','','','',0),('8a8c3203-2b27-11e7-9c7e-a0c58951c8d5','1002','0200000000','./apps/mas/ca/views/blue/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1),('9b081aec-2b27-11e7-9c7e-a0c58951c8d5','1002','0100000000','./views/hauth/theme/blue/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1),('a343cbfc-2b27-11e7-9c7e-a0c58951c8d5','1002','0101010100','/v1/auth/handle/logs','0','','tile','','',0),('a65d91b0-2b27-11e7-9c7e-a0c58951c8d5','1002','0101010200','/v1/auth/handle/logs/download','0','','tile','','',0),('a8854ec0-2b27-11e7-9c7e-a0c58951c8d5','1002','0101010300','/v1/auth/handle/logs/search','0','','tile','','',0),('aabbbd36-2b27-11e7-9c7e-a0c58951c8d5','1002','01030104001','/v1/auth/resource/org/page','0','','tile','','',0),('af0e054c-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3),('b1314131-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010100','/v1/auth/resource/get','0','','tile','','',0),('b3c7c6a6-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010200','/v1/auth/resource/post','0','','tile','','',0),('b6372ff3-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010300','/v1/auth/resource/update','0','','tile','','',0),('b8d3d1c1-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010400','/v1/auth/resource/delete','0','','tile','','',0),('bb9fc76f-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010500','/v1/auth/resource/config/theme','0','','tile','','',0),('bea9df22-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020100','/v1/auth/resource/org/get','0','','tile','','',0),('c15e0f8b-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020200','/v1/auth/resource/org/insert','0','','tile','','',0),('c37806f8-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020300','/v1/auth/resource/org/update','0','','tile','','',0),('c59c3303-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020400','/v1/auth/resource/org/delete','0','','tile','','',0),('c77c6ed0-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020500','/v1/auth/resource/org/download','0','','tile','','',0),('cc8891d2-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2),('d1f01d28-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1),('d4bfa83c-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010100','/v1/auth/domain/get','0','','tile','','',0),('d767f63e-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010200','/v1/auth/domain/share/page','0','','tile','','',0),('da84a5e1-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030100','/v1/auth/domain/share/get','0','','tile','','',0),('dc65642a-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030200','/v1/auth/domain/share/post','0','','tile','','',0),('de8f9fcb-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030300','/v1/auth/domain/share/delete','0','','tile','','',0),('e0a10dc4-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030400','/v1/auth/domain/share/put','0','','tile','','',0),('e2e782c4-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010300','/v1/auth/domain/update','0','','tile','','',0),('e4e17463-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010400','/v1/auth/domain/delete','0','','tile','','',0),('e777d2c2-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010500','/v1/auth/domain/post','0','','tile','','',0),('eb13f0e9-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010100','/v1/auth/user/get','0','','tile','','',0),('ed148f2a-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010200','/v1/auth/user/post','0','','tile','','',0),('ef613f0c-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010300','/v1/auth/user/put','0','','tile','','',0),('f19af335-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010400','/v1/auth/user/delete','0','','tile','','',0),('f3959708-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010500','/v1/auth/user/modify/passwd','0','','tile','','',0),('f5a0999f-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010600','/v1/auth/user/modify/status','0','','tile','','',0),('f94b4a93-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020100','/v1/auth/role/get','0','','tile','','',0),('fbcd8b0b-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2),('fdb44348-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020200','/v1/auth/role/post','0','','tile','','',0),('ff9f6773-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020300','/v1/auth/role/update','0','','tile','','',0),('0287ee48-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020400','/v1/auth/role/delete','0','','tile','','',0),('052dc4ac-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020500','/v1/auth/role/resource/details','0','','tile','','',0),('0875a5f3-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020510','/v1/auth/role/resource/get','0','','tile','','',0),('0a964ef9-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020520','/v1/auth/role/resource/rights','0','','tile','','',0),('0e4ca28b-2b28-11e7-9c7e-a0c58951c8d5','1002','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4),('107e273d-2b28-11e7-9c7e-a0c58951c8d5','1002','0105040100','/v1/auth/user/roles/auth','0','','tile','','',0),('12cd5409-2b28-11e7-9c7e-a0c58951c8d5','1002','0105040200','/v1/auth/user/roles/revoke','0','','tile','','',0),('51a5bff2-2b28-11e7-9c7e-a0c58951c8d5','1002','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1),('e464ee50-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201010000','/v1/ca/responsibility/page','0','#FF6666','tile tile-wide','1','/static/images/ca_icon/org_info.png',1),('e6c1fb99-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3),('e8d836db-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201040000','/v1/ca/driver/page','0','#CCCC33','tile','1','/static/images/ca_icon/driver_info.png',4),('eac811b6-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201060000','/v1/ca/cost/page','0','#CC6633','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6),('edc39c65-2b2a-11e7-9c7e-a0c58951c8d5','1002','0202010000','/v1/ca/static/radio/page','0','#009966','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1),('efab4483-2b2a-11e7-9c7e-a0c58951c8d5','1002','0202020000','/v1/ca/amart/rules/page','0','#3399CC','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2),('f1b7d81a-2b2a-11e7-9c7e-a0c58951c8d5','1002','0202040000','/v1/ca/amart/group/page','0','#99CC33','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4),('f3a437e0-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1),('f6004f8a-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2),('fa02435a-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3),('fc7a0545-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4),('015376ca-2b2b-11e7-9c7e-a0c58951c8d5','1002','0400000000','./apps/mas/common/views/blue/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1),('07ab049a-2b2b-11e7-9c7e-a0c58951c8d5','1002','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1),('098dd130-2b2b-11e7-9c7e-a0c58951c8d5','1002','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2),('0bbabbfb-2b2b-11e7-9c7e-a0c58951c8d5','1002','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1),('0db2afab-2b2b-11e7-9c7e-a0c58951c8d5','1002','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1),('4fd8fdcf-2b42-11e7-9c7e-a0c58951c8d5','1002','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1),('5dcfdfc0-2b42-11e7-9c7e-a0c58951c8d5','1002','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1),('60c6e788-2b42-11e7-9c7e-a0c58951c8d5','1002','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1),('d4605f79-2b43-11e7-9c7e-a0c58951c8d5','1003','0100000000','./views/hauth/theme/apple/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1),('d7867a7a-2b43-11e7-9c7e-a0c58951c8d5','1003','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1),('dd972a84-2b43-11e7-9c7e-a0c58951c8d5','1003','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3),('e007d284-2b43-11e7-9c7e-a0c58951c8d5','1003','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2),('e224205c-2b43-11e7-9c7e-a0c58951c8d5','1003','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1),('e4ac3710-2b43-11e7-9c7e-a0c58951c8d5','1003','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1),('e716b0a1-2b43-11e7-9c7e-a0c58951c8d5','1003','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2),('ea4b0eda-2b43-11e7-9c7e-a0c58951c8d5','1003','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4),('09f47f4e-2b44-11e7-9c7e-a0c58951c8d5','1003','0200000000','./apps/mas/ca/views/apple/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1),('14d34e0f-2b44-11e7-9c7e-a0c58951c8d5','1003','0201010000','/v1/ca/responsibility/page','0','#6fc07c','tile tile-wide','1','/static/images/ca_icon/org_info.png',1),('186b1431-2b44-11e7-9c7e-a0c58951c8d5','1003','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3),('1ac87e71-2b44-11e7-9c7e-a0c58951c8d5','1003','0201040000','/v1/ca/driver/page','0','#b4d39e','tile','1','/static/images/ca_icon/driver_info.png',4),('1d205fdc-2b44-11e7-9c7e-a0c58951c8d5','1003','0201060000','/v1/ca/cost/page','0','#e4d690','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6),('215c357e-2b44-11e7-9c7e-a0c58951c8d5','1003','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1),('239acba3-2b44-11e7-9c7e-a0c58951c8d5','1003','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2),('2824ed08-2b44-11e7-9c7e-a0c58951c8d5','1003','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3),('2a58bc0b-2b44-11e7-9c7e-a0c58951c8d5','1003','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4),('3403b3b7-2b44-11e7-9c7e-a0c58951c8d5','1003','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1),('37ceac85-2b44-11e7-9c7e-a0c58951c8d5','1003','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1),('3a0e741e-2b44-11e7-9c7e-a0c58951c8d5','1003','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1),('ba15af88-2b44-11e7-9c7e-a0c58951c8d5','1003','0400000000','./apps/mas/common/views/apple/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1),('eb87e6f6-2b44-11e7-9c7e-a0c58951c8d5','1002','0300000000','./apps/mas/ftp/views/theme/blue/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2),('f4f2f6ed-2b44-11e7-9c7e-a0c58951c8d5','1003','0300000000','./apps/mas/ftp/views/theme/apple/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2),('ffed3495-2b44-11e7-9c7e-a0c58951c8d5','1002','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1),('04322974-2b45-11e7-9c7e-a0c58951c8d5','1003','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1),('080a1969-2b45-11e7-9c7e-a0c58951c8d5','1002','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2),('09f345ff-2b45-11e7-9c7e-a0c58951c8d5','1003','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2),('0d1637eb-2b45-11e7-9c7e-a0c58951c8d5','1002','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3),('0ea14dd4-2b45-11e7-9c7e-a0c58951c8d5','1003','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3),('10f8e018-2b45-11e7-9c7e-a0c58951c8d5','1002','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2),('12d82536-2b45-11e7-9c7e-a0c58951c8d5','1003','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2),('14c02da6-2b45-11e7-9c7e-a0c58951c8d5','1002','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3),('165ca720-2b45-11e7-9c7e-a0c58951c8d5','1003','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3),('18c97590-2b45-11e7-9c7e-a0c58951c8d5','1002','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1),('1aa86a37-2b45-11e7-9c7e-a0c58951c8d5','1003','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1),('209d20b2-2b45-11e7-9c7e-a0c58951c8d5','1002','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1),('22a8ba05-2b45-11e7-9c7e-a0c58951c8d5','1003','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1),('258f0649-2b45-11e7-9c7e-a0c58951c8d5','1002','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2),('270af058-2b45-11e7-9c7e-a0c58951c8d5','1003','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2),('29cadde3-2b45-11e7-9c7e-a0c58951c8d5','1002','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3),('2ba922e4-2b45-11e7-9c7e-a0c58951c8d5','1003','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3),('32cb5534-2b45-11e7-9c7e-a0c58951c8d5','1003','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1),('350dd891-2b45-11e7-9c7e-a0c58951c8d5','1003','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2),('3e3b64e8-2b45-11e7-9c7e-a0c58951c8d5','1003','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1),('40813c9f-2b45-11e7-9c7e-a0c58951c8d5','1003','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1),('f58252ab-2b47-11e7-9c7e-a0c58951c8d5','1003','0202010000','/v1/ca/static/radio/page','0','#009966','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1),('f7d09d3b-2b47-11e7-9c7e-a0c58951c8d5','1003','0202020000','/v1/ca/amart/rules/page','0','#3399CC','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2),('fa0a85e5-2b47-11e7-9c7e-a0c58951c8d5','1003','0202040000','/v1/ca/amart/group/page','0','#ded1b0','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4),('f0ccdcc7-4666-11e7-9beb-a0c58951c8d5','1001','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1),('fa2728fc-4666-11e7-9beb-a0c58951c8d5','1002','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1),('fc276e32-4666-11e7-9beb-a0c58951c8d5','1003','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1),('fe6968d2-4666-11e7-9beb-a0c58951c8d5','1004','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1),('d3478cbe-4671-11e7-9beb-a0c58951c8d5','1001','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1),('1c7f5512-4672-11e7-9beb-a0c58951c8d5','1001','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1),('3b92e08b-4672-11e7-9beb-a0c58951c8d5','1001','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2),('6402d0f4-4672-11e7-9beb-a0c58951c8d5','1001','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1),('78c703b2-4672-11e7-9beb-a0c58951c8d5','1002','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1),('7ac7fbb6-4672-11e7-9beb-a0c58951c8d5','1003','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1),('7c98873a-4672-11e7-9beb-a0c58951c8d5','1004','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1),('80454951-4672-11e7-9beb-a0c58951c8d5','1002','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1),('82027c48-4672-11e7-9beb-a0c58951c8d5','1003','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1),('83debaa2-4672-11e7-9beb-a0c58951c8d5','1004','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1),('87220100-4672-11e7-9beb-a0c58951c8d5','1002','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2),('88d9c13f-4672-11e7-9beb-a0c58951c8d5','1003','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2),('8a806181-4672-11e7-9beb-a0c58951c8d5','1004','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2),('8d108700-4672-11e7-9beb-a0c58951c8d5','1002','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1),('8f2d5f2b-4672-11e7-9beb-a0c58951c8d5','1003','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1),('9153d196-4672-11e7-9beb-a0c58951c8d5','1004','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1),('f44f54e6-46b0-11e7-9beb-a0c58951c8d5','1001','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2),('0ba33d1a-46b1-11e7-9beb-a0c58951c8d5','1002','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2),('0d5ea3fc-46b1-11e7-9beb-a0c58951c8d5','1003','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2),('0f1882f2-46b1-11e7-9beb-a0c58951c8d5','1004','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2),('e4e9263d-46b1-11e7-9beb-a0c58951c8d5','1001','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2),('ed067953-46b1-11e7-9beb-a0c58951c8d5','1002','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2),('eea5c6c0-46b1-11e7-9beb-a0c58951c8d5','1003','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2),('f03b11ac-46b1-11e7-9beb-a0c58951c8d5','1004','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2);
+INSERT INTO `sys_theme_value` VALUES ('1001-0101010000','1001','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1,NULL),('1001-0103010000','1001','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3,NULL),('1001-0104010000','1001','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1,NULL),('1001-0105010000','1001','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1,NULL),('1001-0105020000','1001','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2,NULL),('1001-0100000000','1001','0100000000','./views/hauth/theme/default/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1,NULL),('1001-0105040000','1001','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4,NULL),('1001-0103020000','1001','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2,NULL),('54786c62-0246-11e7-9b60-a0c58951c8d5','1001','0200000000','./apps/mas/ca/views/cyan/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1,NULL),('fb944b0a-0246-11e7-9b60-a0c58951c8d5','1001','0201010000','/v1/ca/responsibility/page','0','#6fc07c','tile tile-wide','1','/static/images/ca_icon/org_info.png',1,NULL),('5046d07a-0247-11e7-9b60-a0c58951c8d5','1001','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3,NULL),('7929aa2b-0247-11e7-9b60-a0c58951c8d5','1001','0201040000','/v1/ca/driver/page','0','#b4d39e','tile','1','/static/images/ca_icon/driver_info.png',4,NULL),('c93c4e93-0247-11e7-9b60-a0c58951c8d5','1001','0201060000','/v1/ca/cost/page','0','#e4d690','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6,NULL),('f02a3b32-0247-11e7-9b60-a0c58951c8d5','1001','0202010000','/v1/ca/static/radio/page','0','#009966','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1,NULL),('19c73fba-0248-11e7-9b60-a0c58951c8d5','1001','0202020000','/v1/ca/amart/rules/page','0','#3399CC','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2,NULL),('66e55e26-0248-11e7-9b60-a0c58951c8d5','1001','0202040000','/v1/ca/amart/group/page','0','#99CC33','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4,NULL),('8a180b66-0248-11e7-9b60-a0c58951c8d5','1001','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1,NULL),('a831ec58-0248-11e7-9b60-a0c58951c8d5','1001','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2,NULL),('dd815000-0248-11e7-9b60-a0c58951c8d5','1001','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3,NULL),('ba1a252f-0249-11e7-9b60-a0c58951c8d5','1001','0300000000','./apps/mas/ftp/views/theme/default/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2,NULL),('948f67dc-024a-11e7-9b60-a0c58951c8d5','1001','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1,NULL),('b687a0e9-024a-11e7-9b60-a0c58951c8d5','1001','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2,NULL),('5c60abdd-024b-11e7-9b60-a0c58951c8d5','1001','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3,NULL),('83792fdb-024b-11e7-9b60-a0c58951c8d5','1001','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2,NULL),('9e79b725-024b-11e7-9b60-a0c58951c8d5','1001','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3,NULL),('c864e93c-024b-11e7-9b60-a0c58951c8d5','1001','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1,NULL),('ecfe0b20-024b-11e7-9b60-a0c58951c8d5','1001','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2,NULL),('1797ac80-024c-11e7-9b60-a0c58951c8d5','1001','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3,NULL),('53c3813f-024c-11e7-9b60-a0c58951c8d5','1001','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1,NULL),('624b90c0-0278-11e7-9b60-a0c58951c8d5','1002','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1,NULL),('824c0d97-04a3-11e7-9b60-a0c58951c8d5','1001','0400000000','./apps/mas/common/views/green/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1,NULL),('b2561d1e-04a3-11e7-9b60-a0c58951c8d5','1001','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1,NULL),('cb4afcc4-04a3-11e7-9b60-a0c58951c8d5','1001','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2,NULL),('e6191fef-04a3-11e7-9b60-a0c58951c8d5','1001','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1,NULL),('f6a6448b-04a3-11e7-9b60-a0c58951c8d5','1001','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1,NULL),('48fb4303-04a4-11e7-9b60-a0c58951c8d5','1001','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1,NULL),('f2e81083-07d2-11e7-95d9-a0c58951c8d5','1001','0104010100','/v1/auth/domain/get','0','','','','',0,'GET'),('946658e9-07d5-11e7-952f-a0c58951c8d5','1001','0104010200','/v1/auth/domain/share/page','0','','','','',0,'GET'),('8024ac09-07d8-11e7-952f-a0c58951c8d5','1001','0104010300','/v1/auth/domain/update','0','','','','',0,'PUT'),('9705437b-07d8-11e7-952f-a0c58951c8d5','1001','0104010400','/v1/auth/domain/delete','0','','','','',0,'POST'),('ad3e295c-07d8-11e7-952f-a0c58951c8d5','1001','0104010500','/v1/auth/domain/post','0','','','','',0,'POST'),('c1174621-07e1-11e7-952f-a0c58951c8d5','1001','0103030100','/v1/auth/domain/share/get','0','','','','',0,'GET'),('d8fccbcb-07e1-11e7-952f-a0c58951c8d5','1001','0103030200','/v1/auth/domain/share/post','0','','','','',0,'POST'),('fb975107-07e1-11e7-952f-a0c58951c8d5','1001','0103030300','/v1/auth/domain/share/delete','0','','','','',0,'POST'),('1c30f988-07e2-11e7-952f-a0c58951c8d5','1001','0103030400','/v1/auth/domain/share/put','0','','','','',0,'PUT'),('8ca386d8-07e5-11e7-952f-a0c58951c8d5','1001','0101010200','/v1/auth/handle/logs/download','0','','','','',0,'GET'),('a29fba3f-07e5-11e7-952f-a0c58951c8d5','1001','0101010300','/v1/auth/handle/logs/search','0','','','','',0,'GET'),('daadf91b-07e6-11e7-952f-a0c58951c8d5','1001','0103020100','/v1/auth/resource/org/get','0','','','','',0,'GET'),('ee765e9a-07e6-11e7-952f-a0c58951c8d5','1001','0103020200','/v1/auth/resource/org/insert','0','','','','',0,'POST'),('0574add7-07e7-11e7-952f-a0c58951c8d5','1001','0103020300','/v1/auth/resource/org/update','0','','','','',0,'PUT'),('1bf270aa-07e7-11e7-952f-a0c58951c8d5','1001','0103020400','/v1/auth/resource/org/delete','0','','','','',0,'POST'),('3d237ba7-07e7-11e7-952f-a0c58951c8d5','1001','0103020500','/v1/auth/resource/org/download','0','','','','',0,'GET'),('1bde8991-07e9-11e7-952f-a0c58951c8d5','1001','0103010100','/v1/auth/resource/get','0','','','','',0,'GET'),('33b9cb0c-07e9-11e7-952f-a0c58951c8d5','1001','0103010200','/v1/auth/resource/post','0','','','','',0,'POST'),('48460086-07e9-11e7-952f-a0c58951c8d5','1001','0103010300','/v1/auth/resource/update','0','','','','',0,'PUT'),('6bb7b2c8-07e9-11e7-952f-a0c58951c8d5','1001','0103010400','/v1/auth/resource/delete','0','','','','',0,'POST'),('b8df0cd7-07e9-11e7-952f-a0c58951c8d5','1001','0103010500','/v1/auth/resource/config/theme','0','','','','',0,'PUT'),('7d73058c-07ec-11e7-952f-a0c58951c8d5','1001','0105010100','/v1/auth/user/get','0','','','','',0,'GET'),('974ce1fd-07ec-11e7-952f-a0c58951c8d5','1001','0105010200','/v1/auth/user/post','0','','','','',0,'POST'),('b58002f6-07ec-11e7-952f-a0c58951c8d5','1001','0105010300','/v1/auth/user/put','0','','','','',0,'PUT'),('c988bb89-07ec-11e7-952f-a0c58951c8d5','1001','0105010400','/v1/auth/user/delete','0','','','','',0,'POST'),('ec5cb33a-07ec-11e7-952f-a0c58951c8d5','1001','0105010500','/v1/auth/user/modify/passwd','0','','','','',0,'PUT'),('00714873-07ed-11e7-952f-a0c58951c8d5','1001','0105010600','/v1/auth/user/modify/status','0','','','','',0,'PUT'),('a265597d-07ed-11e7-952f-a0c58951c8d5','1001','0105020100','/v1/auth/role/get','0','','','','',0,'GET'),('bd264fd7-07ed-11e7-952f-a0c58951c8d5','1001','0105020200','/v1/auth/role/post','0','','','','',0,'POST'),('d517aab8-07ed-11e7-952f-a0c58951c8d5','1001','0105020300','/v1/auth/role/update','0','','','','',0,'PUT'),('ea237b6a-07ed-11e7-952f-a0c58951c8d5','1001','0105020400','/v1/auth/role/delete','0','','','','',0,'POST'),('c3bad47b-07ee-11e7-952f-a0c58951c8d5','1001','0105020500','/v1/auth/role/resource/details','0','','','','',0,'GET'),('43ad2a9a-07f1-11e7-952f-a0c58951c8d5','1001','0105020510','/v1/auth/role/resource/get','0','','','','',0,'GET'),('5a7d8dbf-07f1-11e7-952f-a0c58951c8d5','1001','0105020520','/v1/auth/role/resource/rights','0','','','','',0,'POST'),('0f9303e2-07f2-11e7-952f-a0c58951c8d5','1001','0105040100','/v1/auth/user/roles/auth','0','','','','',0,'POST'),('25165700-07f2-11e7-952f-a0c58951c8d5','1001','0105040200','/v1/auth/user/roles/revoke','0','','','','',0,'POST'),('0e9aec3f-094c-11e7-952f-a0c58951c8d5','1001','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4,NULL),('f87a9123-0991-11e7-952f-a0c58951c8d5','1001','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1,NULL),('991641c3-0d55-11e7-964b-a0c58951c8d5','1004','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1,NULL),('99164f5c-0d55-11e7-964b-a0c58951c8d5','1004','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3,NULL),('9916502d-0d55-11e7-964b-a0c58951c8d5','1004','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1,NULL),('991650a9-0d55-11e7-964b-a0c58951c8d5','1004','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1,NULL),('9916512d-0d55-11e7-964b-a0c58951c8d5','1004','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2,NULL),('9916519c-0d55-11e7-964b-a0c58951c8d5','1004','0100000000','./views/hauth/theme/cyan/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1,NULL),('99165203-0d55-11e7-964b-a0c58951c8d5','1004','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4,NULL),('9916525c-0d55-11e7-964b-a0c58951c8d5','1004','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2,NULL),('991652b2-0d55-11e7-964b-a0c58951c8d5','1004','0200000000','./apps/mas/ca/views/green/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1,NULL),('9916534b-0d55-11e7-964b-a0c58951c8d5','1004','0201010000','/v1/ca/responsibility/page','0','#6fc07c','tile tile-wide','1','/static/images/ca_icon/org_info.png',1,NULL),('9916545c-0d55-11e7-964b-a0c58951c8d5','1004','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3,NULL),('991654be-0d55-11e7-964b-a0c58951c8d5','1004','0201040000','/v1/ca/driver/page','0','#b4d39e','tile','1','/static/images/ca_icon/driver_info.png',4,NULL),('991657d4-0d55-11e7-964b-a0c58951c8d5','1004','0201060000','/v1/ca/cost/page','0','#e4d690','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6,NULL),('9916933a-0d55-11e7-964b-a0c58951c8d5','1004','0202010000','/v1/ca/static/radio/page','0','#92cdd2','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1,NULL),('9917f369-0d55-11e7-964b-a0c58951c8d5','1004','0202020000','/v1/ca/amart/rules/page','0','#58c0b3','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2,NULL),('9917f42d-0d55-11e7-964b-a0c58951c8d5','1004','0202040000','/v1/ca/amart/group/page','0','#ded1b0','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4,NULL),('9917f48b-0d55-11e7-964b-a0c58951c8d5','1004','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1,NULL),('9917f4cb-0d55-11e7-964b-a0c58951c8d5','1004','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2,NULL),('9917f598-0d55-11e7-964b-a0c58951c8d5','1004','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3,NULL),('9917f676-0d55-11e7-964b-a0c58951c8d5','1004','0300000000','./apps/mas/ftp/views/theme/cyan/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2,NULL),('9917f6e5-0d55-11e7-964b-a0c58951c8d5','1004','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1,NULL),('9917f743-0d55-11e7-964b-a0c58951c8d5','1004','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2,NULL),('9917f7ba-0d55-11e7-964b-a0c58951c8d5','1004','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3,NULL),('9917f818-0d55-11e7-964b-a0c58951c8d5','1004','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2,NULL),('9917f869-0d55-11e7-964b-a0c58951c8d5','1004','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3,NULL),('9917f8b6-0d55-11e7-964b-a0c58951c8d5','1004','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1,NULL),('99180aad-0d55-11e7-964b-a0c58951c8d5','1004','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2,NULL),('99180b3a-0d55-11e7-964b-a0c58951c8d5','1004','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3,NULL),('99180b7a-0d55-11e7-964b-a0c58951c8d5','1004','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1,NULL),('99180bfa-0d55-11e7-964b-a0c58951c8d5','1004','0400000000','./apps/mas/common/views/cyan/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1,NULL),('99180c36-0d55-11e7-964b-a0c58951c8d5','1004','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1,NULL),('99180c72-0d55-11e7-964b-a0c58951c8d5','1004','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2,NULL),('99180ca9-0d55-11e7-964b-a0c58951c8d5','1004','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1,NULL),('99180ced-0d55-11e7-964b-a0c58951c8d5','1004','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1,NULL),('99180d2d-0d55-11e7-964b-a0c58951c8d5','1004','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1,NULL),('99180d65-0d55-11e7-964b-a0c58951c8d5','1004','0104010100','/v1/auth/domain/get','0','','','','',0,'GET'),('99180da1-0d55-11e7-964b-a0c58951c8d5','1004','0104010200','/v1/auth/domain/share/page','0','','','','',0,'GET'),('99180ddc-0d55-11e7-964b-a0c58951c8d5','1004','0104010300','/v1/auth/domain/update','0','','','','',0,'PUT'),('99180e14-0d55-11e7-964b-a0c58951c8d5','1004','0104010400','/v1/auth/domain/delete','0','','','','',0,'POST'),('99180e4f-0d55-11e7-964b-a0c58951c8d5','1004','0104010500','/v1/auth/domain/post','0','','','','',0,'POST'),('99180e87-0d55-11e7-964b-a0c58951c8d5','1004','0103030100','/v1/auth/domain/share/get','0','','','','',0,'GET'),('99180ec3-0d55-11e7-964b-a0c58951c8d5','1004','0103030200','/v1/auth/domain/share/post','0','','','','',0,'POST'),('99180efa-0d55-11e7-964b-a0c58951c8d5','1004','0103030300','/v1/auth/domain/share/delete','0','','','','',0,'POST'),('99180f32-0d55-11e7-964b-a0c58951c8d5','1004','0103030400','/v1/auth/domain/share/put','0','','','','',0,'PUT'),('99180fa1-0d55-11e7-964b-a0c58951c8d5','1004','0101010200','/v1/auth/handle/logs/download','0','','','','',0,'GET'),('99180fdc-0d55-11e7-964b-a0c58951c8d5','1004','0101010300','/v1/auth/handle/logs/search','0','','','','',0,'GET'),('99181014-0d55-11e7-964b-a0c58951c8d5','1004','0103020100','/v1/auth/resource/org/get','0','','','','',0,'GET'),('9918104b-0d55-11e7-964b-a0c58951c8d5','1004','0103020200','/v1/auth/resource/org/insert','0','','','','',0,'POST'),('99181087-0d55-11e7-964b-a0c58951c8d5','1004','0103020300','/v1/auth/resource/org/update','0','','','','',0,'PUT'),('991810be-0d55-11e7-964b-a0c58951c8d5','1004','0103020400','/v1/auth/resource/org/delete','0','','','','',0,'POST'),('991810fe-0d55-11e7-964b-a0c58951c8d5','1004','0103020500','/v1/auth/resource/org/download','0','','','','',0,'GET'),('9918113a-0d55-11e7-964b-a0c58951c8d5','1004','0103010100','/v1/auth/resource/get','0','','','','',0,'GET'),('99181176-0d55-11e7-964b-a0c58951c8d5','1004','0103010200','/v1/auth/resource/post','0','','','','',0,'POST'),('991811ad-0d55-11e7-964b-a0c58951c8d5','1004','0103010300','/v1/auth/resource/update','0','','','','',0,'PUT'),('991811e1-0d55-11e7-964b-a0c58951c8d5','1004','0103010400','/v1/auth/resource/delete','0','','','','',0,'POST'),('99181218-0d55-11e7-964b-a0c58951c8d5','1004','0103010500','/v1/auth/resource/config/theme','0','','','','',0,'PUT'),('9918124f-0d55-11e7-964b-a0c58951c8d5','1004','0105010100','/v1/auth/user/get','0','','','','',0,'GET'),('9918128b-0d55-11e7-964b-a0c58951c8d5','1004','0105010200','/v1/auth/user/post','0','','','','',0,'POST'),('991812c3-0d55-11e7-964b-a0c58951c8d5','1004','0105010300','/v1/auth/user/put','0','','','','',0,'PUT'),('991812fa-0d55-11e7-964b-a0c58951c8d5','1004','0105010400','/v1/auth/user/delete','0','','','','',0,'POST'),('99181332-0d55-11e7-964b-a0c58951c8d5','1004','0105010500','/v1/auth/user/modify/passwd','0','','','','',0,'PUT'),('99181365-0d55-11e7-964b-a0c58951c8d5','1004','0105010600','/v1/auth/user/modify/status','0','','','','',0,'PUT'),('9918139c-0d55-11e7-964b-a0c58951c8d5','1004','0105020100','/v1/auth/role/get','0','','','','',0,'GET'),('991813d4-0d55-11e7-964b-a0c58951c8d5','1004','0105020200','/v1/auth/role/post','0','','','','',0,'POST'),('9918140b-0d55-11e7-964b-a0c58951c8d5','1004','0105020300','/v1/auth/role/update','0','','','','',0,'PUT'),('99181443-0d55-11e7-964b-a0c58951c8d5','1004','0105020400','/v1/auth/role/delete','0','','','','',0,'POST'),('99181476-0d55-11e7-964b-a0c58951c8d5','1004','0105020500','/v1/auth/role/resource/details','0','','','','',0,'GET'),('991814ad-0d55-11e7-964b-a0c58951c8d5','1004','0105020510','/v1/auth/role/resource/get','0','','','','',0,'GET'),('991814f2-0d55-11e7-964b-a0c58951c8d5','1004','0105020520','/v1/auth/role/resource/rights','0','','','','',0,'POST'),('9918152d-0d55-11e7-964b-a0c58951c8d5','1004','0105040100','/v1/auth/user/roles/auth','0','','','','',0,'POST'),('99181569-0d55-11e7-964b-a0c58951c8d5','1004','0105040200','/v1/auth/user/roles/revoke','0','','','','',0,'POST'),('991815a1-0d55-11e7-964b-a0c58951c8d5','1004','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4,NULL),('991815e1-0d55-11e7-964b-a0c58951c8d5','1004','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1,NULL),('becde5db-0eb9-11e7-9612-a0c58951c8d5','1001','0101010100','/v1/auth/handle/logs','0','','','','',0,'GET'),('8e2d2ae7-1c0a-11e7-9d82-a0c58951c8d5','1004','0101010100','/v1/auth/handle/logs','0','','tile tile-large','','',0,'GET'),('a0e208f2-20f8-11e7-966c-a0c58951c8d5','1001','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1,NULL),('b3f18e0b-20f8-11e7-966c-a0c58951c8d5','1004','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1,NULL),('6c7f5772-250a-11e7-9c7e-a0c58951c8d5','1001','01030104001','/v1/auth/resource/org/page','','','','','',0,'GET'),('8a8c3203-2b27-11e7-9c7e-a0c58951c8d5','1002','0200000000','./apps/mas/ca/views/blue/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1,NULL),('9b081aec-2b27-11e7-9c7e-a0c58951c8d5','1002','0100000000','./views/hauth/theme/blue/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1,NULL),('a343cbfc-2b27-11e7-9c7e-a0c58951c8d5','1002','0101010100','/v1/auth/handle/logs','0','','tile','','',0,'GET'),('a65d91b0-2b27-11e7-9c7e-a0c58951c8d5','1002','0101010200','/v1/auth/handle/logs/download','0','','tile','','',0,'GET'),('a8854ec0-2b27-11e7-9c7e-a0c58951c8d5','1002','0101010300','/v1/auth/handle/logs/search','0','','tile','','',0,'GET'),('aabbbd36-2b27-11e7-9c7e-a0c58951c8d5','1002','01030104001','/v1/auth/resource/org/page','0','','tile','','',0,'GET'),('af0e054c-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3,NULL),('b1314131-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010100','/v1/auth/resource/get','0','','tile','','',0,'GET'),('b3c7c6a6-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010200','/v1/auth/resource/post','0','','tile','','',0,'POST'),('b6372ff3-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010300','/v1/auth/resource/update','0','','tile','','',0,'PUT'),('b8d3d1c1-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010400','/v1/auth/resource/delete','0','','tile','','',0,'POST'),('bb9fc76f-2b27-11e7-9c7e-a0c58951c8d5','1002','0103010500','/v1/auth/resource/config/theme','0','','tile','','',0,'PUT'),('bea9df22-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020100','/v1/auth/resource/org/get','0','','tile','','',0,'GET'),('c15e0f8b-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020200','/v1/auth/resource/org/insert','0','','tile','','',0,'POST'),('c37806f8-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020300','/v1/auth/resource/org/update','0','','tile','','',0,'PUT'),('c59c3303-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020400','/v1/auth/resource/org/delete','0','','tile','','',0,'POST'),('c77c6ed0-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020500','/v1/auth/resource/org/download','0','','tile','','',0,'GET'),('cc8891d2-2b27-11e7-9c7e-a0c58951c8d5','1002','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2,NULL),('d1f01d28-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1,NULL),('d4bfa83c-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010100','/v1/auth/domain/get','0','','tile','','',0,'GET'),('d767f63e-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010200','/v1/auth/domain/share/page','0','','tile','','',0,'GET'),('da84a5e1-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030100','/v1/auth/domain/share/get','0','','tile','','',0,'GET'),('dc65642a-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030200','/v1/auth/domain/share/post','0','','tile','','',0,'POST'),('de8f9fcb-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030300','/v1/auth/domain/share/delete','0','','tile','','',0,'POST'),('e0a10dc4-2b27-11e7-9c7e-a0c58951c8d5','1002','0103030400','/v1/auth/domain/share/put','0','','tile','','',0,'PUT'),('e2e782c4-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010300','/v1/auth/domain/update','0','','tile','','',0,'PUT'),('e4e17463-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010400','/v1/auth/domain/delete','0','','tile','','',0,'POST'),('e777d2c2-2b27-11e7-9c7e-a0c58951c8d5','1002','0104010500','/v1/auth/domain/post','0','','tile','','',0,'POST'),('eb13f0e9-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010100','/v1/auth/user/get','0','','tile','','',0,'GET'),('ed148f2a-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010200','/v1/auth/user/post','0','','tile','','',0,'POST'),('ef613f0c-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010300','/v1/auth/user/put','0','','tile','','',0,'PUT'),('f19af335-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010400','/v1/auth/user/delete','0','','tile','','',0,'POST'),('f3959708-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010500','/v1/auth/user/modify/passwd','0','','tile','','',0,'PUT'),('f5a0999f-2b27-11e7-9c7e-a0c58951c8d5','1002','0105010600','/v1/auth/user/modify/status','0','','tile','','',0,'PUT'),('f94b4a93-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020100','/v1/auth/role/get','0','','tile','','',0,'GET'),('fbcd8b0b-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2,NULL),('fdb44348-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020200','/v1/auth/role/post','0','','tile','','',0,'POST'),('ff9f6773-2b27-11e7-9c7e-a0c58951c8d5','1002','0105020300','/v1/auth/role/update','0','','tile','','',0,'PUT'),('0287ee48-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020400','/v1/auth/role/delete','0','','tile','','',0,'POST'),('052dc4ac-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020500','/v1/auth/role/resource/details','0','','tile','','',0,'GET'),('0875a5f3-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020510','/v1/auth/role/resource/get','0','','tile','','',0,'GET'),('0a964ef9-2b28-11e7-9c7e-a0c58951c8d5','1002','0105020520','/v1/auth/role/resource/rights','0','','tile','','',0,'POST'),('0e4ca28b-2b28-11e7-9c7e-a0c58951c8d5','1002','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4,NULL),('107e273d-2b28-11e7-9c7e-a0c58951c8d5','1002','0105040100','/v1/auth/user/roles/auth','0','','tile','','',0,'POST'),('12cd5409-2b28-11e7-9c7e-a0c58951c8d5','1002','0105040200','/v1/auth/user/roles/revoke','0','','tile','','',0,'POST'),('51a5bff2-2b28-11e7-9c7e-a0c58951c8d5','1002','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1,NULL),('e464ee50-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201010000','/v1/ca/responsibility/page','0','#FF6666','tile tile-wide','1','/static/images/ca_icon/org_info.png',1,NULL),('e6c1fb99-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3,NULL),('e8d836db-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201040000','/v1/ca/driver/page','0','#CCCC33','tile','1','/static/images/ca_icon/driver_info.png',4,NULL),('eac811b6-2b2a-11e7-9c7e-a0c58951c8d5','1002','0201060000','/v1/ca/cost/page','0','#CC6633','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6,NULL),('edc39c65-2b2a-11e7-9c7e-a0c58951c8d5','1002','0202010000','/v1/ca/static/radio/page','0','#009966','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1,NULL),('efab4483-2b2a-11e7-9c7e-a0c58951c8d5','1002','0202020000','/v1/ca/amart/rules/page','0','#3399CC','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2,NULL),('f1b7d81a-2b2a-11e7-9c7e-a0c58951c8d5','1002','0202040000','/v1/ca/amart/group/page','0','#99CC33','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4,NULL),('f3a437e0-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1,NULL),('f6004f8a-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2,NULL),('fa02435a-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3,NULL),('fc7a0545-2b2a-11e7-9c7e-a0c58951c8d5','1002','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4,NULL),('015376ca-2b2b-11e7-9c7e-a0c58951c8d5','1002','0400000000','./apps/mas/common/views/blue/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1,NULL),('07ab049a-2b2b-11e7-9c7e-a0c58951c8d5','1002','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1,NULL),('098dd130-2b2b-11e7-9c7e-a0c58951c8d5','1002','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2,NULL),('0bbabbfb-2b2b-11e7-9c7e-a0c58951c8d5','1002','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1,NULL),('0db2afab-2b2b-11e7-9c7e-a0c58951c8d5','1002','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1,NULL),('4fd8fdcf-2b42-11e7-9c7e-a0c58951c8d5','1002','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1,NULL),('5dcfdfc0-2b42-11e7-9c7e-a0c58951c8d5','1002','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1,NULL),('60c6e788-2b42-11e7-9c7e-a0c58951c8d5','1002','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1,NULL),('d4605f79-2b43-11e7-9c7e-a0c58951c8d5','1003','0100000000','./views/hauth/theme/apple/sysconfig.tpl','0','#FF6600','tile tile-wide','1','/static/images/hauth/system.png',1,NULL),('d7867a7a-2b43-11e7-9c7e-a0c58951c8d5','1003','0101010000','/v1/auth/HandleLogsPage','0','#336699','tile tile-large','3','/static/images/hauth/logs_shen.png',1,NULL),('dd972a84-2b43-11e7-9c7e-a0c58951c8d5','1003','0103010000','/v1/auth/resource/page','0','#666699','tile','1','/static/images/hauth/menus.png',3,NULL),('e007d284-2b43-11e7-9c7e-a0c58951c8d5','1003','0103020000','/v1/auth/resource/org/page','0','#FF6666','tile','1','/static/images/hauth/org.png',2,NULL),('e224205c-2b43-11e7-9c7e-a0c58951c8d5','1003','0104010000','/v1/auth/domain/page','0','#0099CC','tile tile-wide','1','/static/images/hauth/domain.png',1,NULL),('e4ac3710-2b43-11e7-9c7e-a0c58951c8d5','1003','0105010000','/v1/auth/user/page','0','#CC6600','tile tile-wide','2','/static/images/hauth/user_manager.png',1,NULL),('e716b0a1-2b43-11e7-9c7e-a0c58951c8d5','1003','0105020000','/v1/auth/role/page','0','#FFCC33','tile','2','/static/images/hauth/role_manager.png',2,NULL),('ea4b0eda-2b43-11e7-9c7e-a0c58951c8d5','1003','0105040000','/v1/auth/batch/page','0','#339999','tile','2','/static/images/hauth/grant.png',4,NULL),('09f47f4e-2b44-11e7-9c7e-a0c58951c8d5','1003','0200000000','./apps/mas/ca/views/apple/ca.tpl','0','#666699','tile tile-wide','2','/static/images/hauth/grant.png',1,NULL),('14d34e0f-2b44-11e7-9c7e-a0c58951c8d5','1003','0201010000','/v1/ca/responsibility/page','0','#6fc07c','tile tile-wide','1','/static/images/ca_icon/org_info.png',1,NULL),('186b1431-2b44-11e7-9c7e-a0c58951c8d5','1003','0201030000','/v1/ca/cost/direction/page','0','#FF9999','tile','1','/static/images/ca_icon/cost_direction.png',3,NULL),('1ac87e71-2b44-11e7-9c7e-a0c58951c8d5','1003','0201040000','/v1/ca/driver/page','0','#b4d39e','tile','1','/static/images/ca_icon/driver_info.png',4,NULL),('1d205fdc-2b44-11e7-9c7e-a0c58951c8d5','1003','0201060000','/v1/ca/cost/page','0','#e4d690','tile tile-wide','1','/static/images/ca_icon/cost_pool.png',6,NULL),('215c357e-2b44-11e7-9c7e-a0c58951c8d5','1003','0203010000','/v1/ca/dispatch/page','0','#666699','tile tile-wide','3','/static/images/ca_icon/dispatch_manage.png',1,NULL),('239acba3-2b44-11e7-9c7e-a0c58951c8d5','1003','0203020000','/v1/ca/dispatch/history/page','0','#339999','tile tile-wide','3','/static/images/ca_icon/dispatch_history.png',2,NULL),('2824ed08-2b44-11e7-9c7e-a0c58951c8d5','1003','0203040000','/v1/ca/cost/manage/page','0','#FFCC33','tile','3','/static/images/ca_icon/cost_query.png',3,NULL),('2a58bc0b-2b44-11e7-9c7e-a0c58951c8d5','1003','0203050000','/v1/ca/driver/manage/page','0','#CC6600','tile','3','/static/images/ca_icon/driver_query.png',4,NULL),('3403b3b7-2b44-11e7-9c7e-a0c58951c8d5','1003','1100000000','./views/help/default/syshelp.tpl','0','#0099CC','tile tile-wide','3','/static/images/hauth/help.png',1,NULL),('37ceac85-2b44-11e7-9c7e-a0c58951c8d5','1003','1101010000','/v1/help/system/help','0','#339999','tile tile-wide','1','/static/images/hauth/sys_help.png',1,NULL),('3a0e741e-2b44-11e7-9c7e-a0c58951c8d5','1003','1101020000','/v1/auth/swagger/page','1','#339999','tile tile-wide','2','/static/images/hauth/api.png',1,NULL),('ba15af88-2b44-11e7-9c7e-a0c58951c8d5','1003','0400000000','./apps/mas/common/views/apple/dimension.tpl','0','#FFCC33','tile tile-wide','3','/static/images/hauth/system.png',1,NULL),('eb87e6f6-2b44-11e7-9c7e-a0c58951c8d5','1002','0300000000','./apps/mas/ftp/views/theme/blue/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2,NULL),('f4f2f6ed-2b44-11e7-9c7e-a0c58951c8d5','1003','0300000000','./apps/mas/ftp/views/theme/apple/ftp.tpl','0','#009999','tile tile-wide','2','/static/images/hauth/ftp.png',2,NULL),('ffed3495-2b44-11e7-9c7e-a0c58951c8d5','1002','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1,NULL),('04322974-2b45-11e7-9c7e-a0c58951c8d5','1003','0301010000','/v1/ftp/curve/define/page','0','#666699','tile','1','/static/images/hauth/curve_define.png',1,NULL),('080a1969-2b45-11e7-9c7e-a0c58951c8d5','1002','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2,NULL),('09f345ff-2b45-11e7-9c7e-a0c58951c8d5','1003','0301020000','/v1/ftp/curve/manage/page','0','#336699','tile','1','/static/images/hauth/curve_manage.png',2,NULL),('0d1637eb-2b45-11e7-9c7e-a0c58951c8d5','1002','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3,NULL),('0ea14dd4-2b45-11e7-9c7e-a0c58951c8d5','1003','0301050000','/v1/ftp/rules/manage/page','0','#99CC33','tile tile-wide','1','/static/images/hauth/ftp_rules.png',3,NULL),('10f8e018-2b45-11e7-9c7e-a0c58951c8d5','1002','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2,NULL),('12d82536-2b45-11e7-9c7e-a0c58951c8d5','1003','0302010000','/v1/ftp/adjust/inner/page','0','#0099CC','tile','2','/static/images/hauth/ftp_inner_adjust.png',2,NULL),('14c02da6-2b45-11e7-9c7e-a0c58951c8d5','1002','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3,NULL),('165ca720-2b45-11e7-9c7e-a0c58951c8d5','1003','0302020000','/v1/ftp/adjust/outer/page','0','#CC6600','tile','2','/static/images/hauth/ftp_outer_adjust.png',3,NULL),('18c97590-2b45-11e7-9c7e-a0c58951c8d5','1002','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1,NULL),('1aa86a37-2b45-11e7-9c7e-a0c58951c8d5','1003','0302030000','/v1/ftp/filter/define/page','0','#FFCC33','tile tile-wide','2','/static/images/hauth/ftp_filter.png',1,NULL),('209d20b2-2b45-11e7-9c7e-a0c58951c8d5','1002','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1,NULL),('22a8ba05-2b45-11e7-9c7e-a0c58951c8d5','1003','0303010000','/v1/ftp/single/calc/page','0','#FF6666','tile tile-wide','3','/static/images/hauth/ftp_single_calc.png',1,NULL),('258f0649-2b45-11e7-9c7e-a0c58951c8d5','1002','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2,NULL),('270af058-2b45-11e7-9c7e-a0c58951c8d5','1003','0303020000','/v1/ftp/dispatch/manage/page','0','#009933','tile','3','/static/images/hauth/ftp_dispatch.png',2,NULL),('29cadde3-2b45-11e7-9c7e-a0c58951c8d5','1002','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3,NULL),('2ba922e4-2b45-11e7-9c7e-a0c58951c8d5','1003','0303030000','/v1/ftp/dispatch/history/page','0','#009999','tile','3','/static/images/hauth/ftp_dispatch_history.png',3,NULL),('32cb5534-2b45-11e7-9c7e-a0c58951c8d5','1003','0401000000','/v1/common/depart/page','0','#6fc07c','tile tile-wide','1','/static/images/common_icon/department.png',1,NULL),('350dd891-2b45-11e7-9c7e-a0c58951c8d5','1003','0402000000','/v1/common/product/page','0','#92cdd2','tile tile-wide','1','/static/images/common_icon/product.png',2,NULL),('3e3b64e8-2b45-11e7-9c7e-a0c58951c8d5','1003','0403000000','/v1/common/glaccount/page','0','#ed9f86','tile tile-large','2','/static/images/common_icon/gl_account.png',1,NULL),('40813c9f-2b45-11e7-9c7e-a0c58951c8d5','1003','0404000000','/v1/common/isocurrency/page','0','#67accd','tile tile-large','3','/static/images/common_icon/iso_currency.png',1,NULL),('f58252ab-2b47-11e7-9c7e-a0c58951c8d5','1003','0202010000','/v1/ca/static/radio/page','0','#009966','tile tile-wide','2','/static/images/ca_icon/static_rules.png',1,NULL),('f7d09d3b-2b47-11e7-9c7e-a0c58951c8d5','1003','0202020000','/v1/ca/amart/rules/page','0','#3399CC','tile tile-wide','2','/static/images/ca_icon/amart_rules.png',2,NULL),('fa0a85e5-2b47-11e7-9c7e-a0c58951c8d5','1003','0202040000','/v1/ca/amart/group/page','0','#ded1b0','tile tile-wide','2','/static/images/ca_icon/group_rules.png',4,NULL),('f0ccdcc7-4666-11e7-9beb-a0c58951c8d5','1001','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1,NULL),('fa2728fc-4666-11e7-9beb-a0c58951c8d5','1002','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1,NULL),('fc276e32-4666-11e7-9beb-a0c58951c8d5','1003','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1,NULL),('fe6968d2-4666-11e7-9beb-a0c58951c8d5','1004','0500000000','./views/dispatch/index.tpl','0','#009966','tile tile-wide','2','/static/images/dispatch_icon/etl.png',1,NULL),('d3478cbe-4671-11e7-9beb-a0c58951c8d5','1001','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1,NULL),('1c7f5512-4672-11e7-9beb-a0c58951c8d5','1001','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1,NULL),('3b92e08b-4672-11e7-9beb-a0c58951c8d5','1001','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2,NULL),('6402d0f4-4672-11e7-9beb-a0c58951c8d5','1001','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1,NULL),('78c703b2-4672-11e7-9beb-a0c58951c8d5','1002','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1,NULL),('7ac7fbb6-4672-11e7-9beb-a0c58951c8d5','1003','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1,NULL),('7c98873a-4672-11e7-9beb-a0c58951c8d5','1004','0501010000','/v1/dispatch/argument/page','0','#FF6666','tile tile-wide','1','/static/images/dispatch_icon/arg_define.png',1,NULL),('80454951-4672-11e7-9beb-a0c58951c8d5','1002','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1,NULL),('82027c48-4672-11e7-9beb-a0c58951c8d5','1003','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1,NULL),('83debaa2-4672-11e7-9beb-a0c58951c8d5','1004','0502010000','/v1/dispatch/task/page','0','#FF9999','tile tile-wide','2','/static/images/dispatch_icon/task.png',1,NULL),('87220100-4672-11e7-9beb-a0c58951c8d5','1002','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2,NULL),('88d9c13f-4672-11e7-9beb-a0c58951c8d5','1003','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2,NULL),('8a806181-4672-11e7-9beb-a0c58951c8d5','1004','0502020000','/v1/dispatch/group/page','0','#CC6633','tile','2','/static/images/dispatch_icon/group.png',2,NULL),('8d108700-4672-11e7-9beb-a0c58951c8d5','1002','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1,NULL),('8f2d5f2b-4672-11e7-9beb-a0c58951c8d5','1003','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1,NULL),('9153d196-4672-11e7-9beb-a0c58951c8d5','1004','0503010000','/v1/dispatch/batch/page','0','#009966','tile tile-wide','3','/static/images/dispatch_icon/batch.png',1,NULL),('f44f54e6-46b0-11e7-9beb-a0c58951c8d5','1001','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2,NULL),('0ba33d1a-46b1-11e7-9beb-a0c58951c8d5','1002','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2,NULL),('0d5ea3fc-46b1-11e7-9beb-a0c58951c8d5','1003','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2,NULL),('0f1882f2-46b1-11e7-9beb-a0c58951c8d5','1004','0503020000','/v1/dispatch/batch/monitoring/page','0','#0099CC','tile tile-wide','3','/static/images/dispatch_icon/monitoring.png',2,NULL),('e4e9263d-46b1-11e7-9beb-a0c58951c8d5','1001','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2,NULL),('ed067953-46b1-11e7-9beb-a0c58951c8d5','1002','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2,NULL),('eea5c6c0-46b1-11e7-9beb-a0c58951c8d5','1003','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2,NULL),('f03b11ac-46b1-11e7-9beb-a0c58951c8d5','1004','0501020000','/v1/dispatch/system/config/page','0','#FF9966','tile tile-wide','1','/static/images/dispatch_icon/arg_system.png',2,NULL),('78bee7b2-ca9d-11f1-aa61-02fc00000001','1001','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('78beeb40-ca9d-11f1-aa61-02fc00000001','1002','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('78beec08-ca9d-11f1-aa61-02fc00000001','1003','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('78beec94-ca9d-11f1-aa61-02fc00000001','1004','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('35e3f624-ca9f-11f1-a91d-02fc00000001','1001','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e3f93a-ca9f-11f1-a91d-02fc00000001','1002','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e3f9da-ca9f-11f1-a91d-02fc00000001','1003','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e3fa66-ca9f-11f1-a91d-02fc00000001','1004','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e4d58a-ca9f-11f1-a91d-02fc00000001','1001','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e4d850-ca9f-11f1-a91d-02fc00000001','1002','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e4d972-ca9f-11f1-a91d-02fc00000001','1003','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e4da44-ca9f-11f1-a91d-02fc00000001','1004','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e5bc84-ca9f-11f1-a91d-02fc00000001','1001','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('35e5bf7c-ca9f-11f1-a91d-02fc00000001','1002','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('35e5c012-ca9f-11f1-a91d-02fc00000001','1003','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('35e5c094-ca9f-11f1-a91d-02fc00000001','1004','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('2272e950-caa0-11f1-b192-02fc00000001','1001','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2272eb94-caa0-11f1-b192-02fc00000001','1002','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2272ec20-caa0-11f1-b192-02fc00000001','1003','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2272ecac-caa0-11f1-b192-02fc00000001','1004','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2273a494-caa0-11f1-b192-02fc00000001','1001','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('2273a642-caa0-11f1-b192-02fc00000001','1002','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('2273a6ce-caa0-11f1-b192-02fc00000001','1003','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('2273a750-caa0-11f1-b192-02fc00000001','1004','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('22743b66-caa0-11f1-b192-02fc00000001','1001','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('22743ce2-caa0-11f1-b192-02fc00000001','1002','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('22743d46-caa0-11f1-b192-02fc00000001','1003','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('22743da0-caa0-11f1-b192-02fc00000001','1004','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('2274da08-caa0-11f1-b192-02fc00000001','1001','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('2274dbac-caa0-11f1-b192-02fc00000001','1002','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('2274dc06-caa0-11f1-b192-02fc00000001','1003','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('2274dc56-caa0-11f1-b192-02fc00000001','1004','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('34c1a13a-caa2-11f1-b483-02fc00000001','1001','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c1a3ce-caa2-11f1-b483-02fc00000001','1002','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c1a482-caa2-11f1-b483-02fc00000001','1003','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c1a504-caa2-11f1-b483-02fc00000001','1004','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c31b5a-caa2-11f1-b483-02fc00000001','1001','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c31e34-caa2-11f1-b483-02fc00000001','1002','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c31f1a-caa2-11f1-b483-02fc00000001','1003','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c31fd8-caa2-11f1-b483-02fc00000001','1004','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c4781a-caa2-11f1-b483-02fc00000001','1001','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c47ac2-caa2-11f1-b483-02fc00000001','1002','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c47b6c-caa2-11f1-b483-02fc00000001','1003','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c47bee-caa2-11f1-b483-02fc00000001','1004','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c5b68a-caa2-11f1-b483-02fc00000001','1001','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c5b87e-caa2-11f1-b483-02fc00000001','1002','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c5b8ec-caa2-11f1-b483-02fc00000001','1003','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c5b93c-caa2-11f1-b483-02fc00000001','1004','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c6e852-caa2-11f1-b483-02fc00000001','1001','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('34c6eb04-caa2-11f1-b483-02fc00000001','1002','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('34c6eba4-caa2-11f1-b483-02fc00000001','1003','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('34c6ec26-caa2-11f1-b483-02fc00000001','1004','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('00f44b5e-caa3-11f1-8cdb-02fc00000001','1001','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f44d5c-caa3-11f1-8cdb-02fc00000001','1002','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f44dca-caa3-11f1-8cdb-02fc00000001','1003','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f44e2e-caa3-11f1-8cdb-02fc00000001','1004','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f52330-caa3-11f1-8cdb-02fc00000001','1001','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f52574-caa3-11f1-8cdb-02fc00000001','1002','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f5261e-caa3-11f1-8cdb-02fc00000001','1003','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f526a0-caa3-11f1-8cdb-02fc00000001','1004','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f5ffb2-caa3-11f1-8cdb-02fc00000001','1001','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f6012e-caa3-11f1-8cdb-02fc00000001','1002','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f60246-caa3-11f1-8cdb-02fc00000001','1003','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f602a0-caa3-11f1-8cdb-02fc00000001','1004','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f6ea9e-caa3-11f1-8cdb-02fc00000001','1001','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f6ed5a-caa3-11f1-8cdb-02fc00000001','1002','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f6edf0-caa3-11f1-8cdb-02fc00000001','1003','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f6ee68-caa3-11f1-8cdb-02fc00000001','1004','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f7e82c-caa3-11f1-8cdb-02fc00000001','1001','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f7eba6-caa3-11f1-8cdb-02fc00000001','1002','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f7ec32-caa3-11f1-8cdb-02fc00000001','1003','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f7ec82-caa3-11f1-8cdb-02fc00000001','1004','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f8b9d2-caa3-11f1-8cdb-02fc00000001','1001','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('00f8bbf8-caa3-11f1-8cdb-02fc00000001','1002','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('00f8bc7a-caa3-11f1-8cdb-02fc00000001','1003','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('00f8bcca-caa3-11f1-8cdb-02fc00000001','1004','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('064fd734-caa4-11f1-b889-02fc00000001','1001','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('064fd900-caa4-11f1-b889-02fc00000001','1002','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('064fd96e-caa4-11f1-b889-02fc00000001','1003','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('064fd9be-caa4-11f1-b889-02fc00000001','1004','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('0650be4c-caa4-11f1-b889-02fc00000001','1001','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('0650bfdc-caa4-11f1-b889-02fc00000001','1002','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('0650c086-caa4-11f1-b889-02fc00000001','1003','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('0650c1bc-caa4-11f1-b889-02fc00000001','1004','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('06517e7c-caa4-11f1-b889-02fc00000001','1001','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('06518066-caa4-11f1-b889-02fc00000001','1002','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('065180d4-caa4-11f1-b889-02fc00000001','1003','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('06518124-caa4-11f1-b889-02fc00000001','1004','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('a577763c-caa4-11f1-b6d3-02fc00000001','1001','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a57779d4-caa4-11f1-b6d3-02fc00000001','1002','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a57789c4-caa4-11f1-b6d3-02fc00000001','1003','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a5778a82-caa4-11f1-b6d3-02fc00000001','1004','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a578f3cc-caa4-11f1-b6d3-02fc00000001','1001','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('a578f656-caa4-11f1-b6d3-02fc00000001','1002','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('a578f71e-caa4-11f1-b6d3-02fc00000001','1003','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('a578f7be-caa4-11f1-b6d3-02fc00000001','1004','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('efdbdcea-caa4-11f1-a7ad-02fc00000001','1001','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('efdbdea2-caa4-11f1-a7ad-02fc00000001','1002','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('efdbdf10-caa4-11f1-a7ad-02fc00000001','1003','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('efdbdf60-caa4-11f1-a7ad-02fc00000001','1004','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('6f162cee-caa6-11f1-b749-02fc00000001','1001','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('6f162fa0-caa6-11f1-b749-02fc00000001','1002','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('6f163036-caa6-11f1-b749-02fc00000001','1003','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('6f1630b8-caa6-11f1-b749-02fc00000001','1004','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('2cb947f4-caa7-11f1-aa8a-02fc00000001','1001','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cb949ca-caa7-11f1-aa8a-02fc00000001','1002','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cb94a42-caa7-11f1-aa8a-02fc00000001','1003','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cb94a92-caa7-11f1-aa8a-02fc00000001','1004','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cba0d7e-caa7-11f1-aa8a-02fc00000001','1001','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('2cba0ed2-caa7-11f1-aa8a-02fc00000001','1002','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('2cba0f72-caa7-11f1-aa8a-02fc00000001','1003','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('2cba0fea-caa7-11f1-aa8a-02fc00000001','1004','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('ee5f8b7a-caa7-11f1-9e6e-02fc00000001','1001','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee5f8d28-caa7-11f1-9e6e-02fc00000001','1002','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee5f8d8c-caa7-11f1-9e6e-02fc00000001','1003','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee5f8dd2-caa7-11f1-9e6e-02fc00000001','1004','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee606efa-caa7-11f1-9e6e-02fc00000001','1001','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee607120-caa7-11f1-9e6e-02fc00000001','1002','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee60735a-caa7-11f1-9e6e-02fc00000001','1003','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee60740e-caa7-11f1-9e6e-02fc00000001','1004','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee61b5b2-caa7-11f1-9e6e-02fc00000001','1001','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee61b8c8-caa7-11f1-9e6e-02fc00000001','1002','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee61b95e-caa7-11f1-9e6e-02fc00000001','1003','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee61b9e0-caa7-11f1-9e6e-02fc00000001','1004','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee62f5bc-caa7-11f1-9e6e-02fc00000001','1001','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee62f7f6-caa7-11f1-9e6e-02fc00000001','1002','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee62f88c-caa7-11f1-9e6e-02fc00000001','1003','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee62f90e-caa7-11f1-9e6e-02fc00000001','1004','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee643666-caa7-11f1-9e6e-02fc00000001','1001','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee6438b4-caa7-11f1-9e6e-02fc00000001','1002','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee64394a-caa7-11f1-9e6e-02fc00000001','1003','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee6439c2-caa7-11f1-9e6e-02fc00000001','1004','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee657be8-caa7-11f1-9e6e-02fc00000001','1001','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee657e40-caa7-11f1-9e6e-02fc00000001','1002','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee657ecc-caa7-11f1-9e6e-02fc00000001','1003','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee657f44-caa7-11f1-9e6e-02fc00000001','1004','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee66c0a2-caa7-11f1-9e6e-02fc00000001','1001','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee66c2fa-caa7-11f1-9e6e-02fc00000001','1002','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee66c39a-caa7-11f1-9e6e-02fc00000001','1003','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee66c412-caa7-11f1-9e6e-02fc00000001','1004','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee681dd0-caa7-11f1-9e6e-02fc00000001','1001','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee68205a-caa7-11f1-9e6e-02fc00000001','1002','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee6820f0-caa7-11f1-9e6e-02fc00000001','1003','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee682168-caa7-11f1-9e6e-02fc00000001','1004','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee698cd8-caa7-11f1-9e6e-02fc00000001','1001','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET'),('ee698f6c-caa7-11f1-9e6e-02fc00000001','1002','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET'),('ee69900c-caa7-11f1-9e6e-02fc00000001','1003','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET'),('ee699098-caa7-11f1-9e6e-02fc00000001','1004','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET');
 /*!40000 ALTER TABLE `sys_theme_value` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_token_refresh`
+--
+
+DROP TABLE IF EXISTS `sys_token_refresh`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_token_refresh` (
+  `token_hash` varchar(64) NOT NULL,
+  `family_id` varchar(60) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `org_unit_id` varchar(66) NOT NULL,
+  `status` char(1) NOT NULL,
+  `session_time` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`token_hash`),
+  KEY `idx_sys_token_refresh_01` (`family_id`),
+  KEY `idx_sys_token_refresh_02` (`user_id`),
+  KEY `idx_sys_token_refresh_03` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='刷新token信息';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_token_refresh`
+--
+
+LOCK TABLES `sys_token_refresh` WRITE;
+/*!40000 ALTER TABLE `sys_token_refresh` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_token_refresh` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_token_revoke`
+--
+
+DROP TABLE IF EXISTS `sys_token_revoke`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_token_revoke` (
+  `uuid` varchar(60) NOT NULL,
+  `revoke_type` char(1) NOT NULL,
+  `jti` varchar(60) DEFAULT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `revoke_time` bigint(20) NOT NULL,
+  `revoke_user` varchar(30) DEFAULT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_token_revoke_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='token吊销信息';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_token_revoke`
+--
+
+LOCK TABLES `sys_token_revoke` WRITE;
+/*!40000 ALTER TABLE `sys_token_revoke` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_token_revoke` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_user_identity`
+--
+
+DROP TABLE IF EXISTS `sys_user_identity`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_user_identity` (
+  `identity_id` varchar(64) NOT NULL,
+  `issuer` varchar(300) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`identity_id`),
+  KEY `fk_sys_user_identity_01_idx` (`user_id`),
+  CONSTRAINT `fk_sys_user_identity_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户绑定的外部身份, identity_id = sha256(issuer + 换行 + sub)';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_user_identity`
+--
+
+LOCK TABLES `sys_user_identity` WRITE;
+/*!40000 ALTER TABLE `sys_user_identity` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_user_identity` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_user_info`
 --
@@ -504,6 +1050,93 @@ INSERT INTO `sys_user_info` VALUES ('431243','2143214','2017-06-28 10:51:09','ad
 /*!40000 ALTER TABLE `sys_user_info` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `sys_user_mfa`
+--
+
+DROP TABLE IF EXISTS `sys_user_mfa`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_user_mfa` (
+  `user_id` varchar(30) NOT NULL,
+  `secret` varchar(200) NOT NULL,
+  `status` char(1) NOT NULL,
+  `last_step` bigint(20) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  `enable_time` bigint(20) DEFAULT NULL,
+  PRIMARY KEY (`user_id`),
+  CONSTRAINT `fk_sys_user_mfa_01` FOREIGN KEY (`user_id`) REFERENCES `sys_sec_user` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户两步验证密钥';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_user_mfa`
+--
+
+LOCK TABLES `sys_user_mfa` WRITE;
+/*!40000 ALTER TABLE `sys_user_mfa` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_user_mfa` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_user_mfa_recovery`
+--
+
+DROP TABLE IF EXISTS `sys_user_mfa_recovery`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_user_mfa_recovery` (
+  `code_hash` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`code_hash`),
+  KEY `idx_sys_user_mfa_recovery_01` (`user_id`),
+  CONSTRAINT `fk_sys_user_mfa_recovery_01` FOREIGN KEY (`user_id`) REFERENCES `sys_sec_user` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='两步验证恢复码';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_user_mfa_recovery`
+--
+
+LOCK TABLES `sys_user_mfa_recovery` WRITE;
+/*!40000 ALTER TABLE `sys_user_mfa_recovery` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_user_mfa_recovery` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `sys_user_session`
+--
+
+DROP TABLE IF EXISTS `sys_user_session`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `sys_user_session` (
+  `session_id` varchar(60) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `client_ip` varchar(64) DEFAULT NULL,
+  `user_agent` varchar(500) DEFAULT NULL,
+  `login_time` bigint(20) NOT NULL,
+  `last_active` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `status` char(1) NOT NULL DEFAULT '0',
+  PRIMARY KEY (`session_id`),
+  KEY `idx_sys_user_session_01` (`user_id`),
+  KEY `idx_sys_user_session_02` (`domain_id`),
+  KEY `idx_sys_user_session_03` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户登录会话, session_id是刷新token的family_id, status = 0 有效, 1 已结束';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `sys_user_session`
+--
+
+LOCK TABLES `sys_user_session` WRITE;
+/*!40000 ALTER TABLE `sys_user_session` DISABLE KEYS */;
+/*!40000 ALTER TABLE `sys_user_session` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `sys_user_status_attr`
 --
@@ -0,0 +1,406 @@
+-- 已有数据库升级脚本
+--
+-- 适用于按旧版 init_hauth.sql 初始化的数据库, 新建数据库直接导入 init_hauth.sql 即可.
+-- 脚本可以重复执行: 新表使用 IF NOT EXISTS 创建, 数据使用 INSERT IGNORE 写入.
+-- 注意: ALTER TABLE 语句只能执行一次, 重复执行时会提示字段已存在, 可以忽略该错误.
+
+/*!40101 SET NAMES utf8 */;
+/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;
+
+--
+-- Table structure for table `sys_api_key`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_api_key` (
+  `key_id` varchar(16) NOT NULL,
+  `key_hash` varchar(64) NOT NULL,
+  `key_name` varchar(100) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `scopes` varchar(2000) DEFAULT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `last_used_time` bigint(20) NOT NULL DEFAULT '0',
+  `status` char(1) NOT NULL DEFAULT '0',
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`key_id`),
+  KEY `fk_sys_api_key_01_idx` (`user_id`),
+  CONSTRAINT `fk_sys_api_key_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='服务账号的API key, key_hash = sha256(secret), scopes为空时可以访问服务账号的全部授权资源';
+
+--
+-- Table structure for table `sys_domain_idp`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_domain_idp` (
+  `domain_id` varchar(30) NOT NULL,
+  `issuer` varchar(300) NOT NULL,
+  `client_id` varchar(200) NOT NULL,
+  `client_secret` varchar(500) NOT NULL,
+  `scopes` varchar(200) DEFAULT NULL,
+  `link_email` char(1) NOT NULL,
+  `status` char(1) NOT NULL,
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  `maintance_user` varchar(30) DEFAULT NULL,
+  `maintance_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`domain_id`),
+  CONSTRAINT `fk_sys_domain_idp_01` FOREIGN KEY (`domain_id`) REFERENCES `sys_domain_info` (`domain_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='域的外部身份提供者';
+
+--
+-- Table structure for table `sys_idp_login_state`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_idp_login_state` (
+  `state_hash` varchar(64) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `nonce` varchar(64) NOT NULL,
+  `code_verifier` varchar(128) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`state_hash`),
+  KEY `idx_sys_idp_login_state_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='外部身份提供者登录state';
+
+--
+-- Table structure for table `sys_impersonate_audit`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_impersonate_audit` (
+  `uuid` varchar(66) NOT NULL,
+  `admin_id` varchar(30) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `session_id` varchar(60) NOT NULL,
+  `action` varchar(10) NOT NULL COMMENT 'start 开始模拟登录, end 结束模拟登录',
+  `handle_time` bigint(20) NOT NULL,
+  `client_ip` varchar(60) DEFAULT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_impersonate_audit_01` (`handle_time`),
+  KEY `idx_sys_impersonate_audit_02` (`admin_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='管理员模拟用户登录的开始与结束记录';
+
+--
+-- Table structure for table `sys_login_throttle`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_login_throttle` (
+  `throttle_key` varchar(100) NOT NULL,
+  `tokens` bigint(20) NOT NULL,
+  `update_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`throttle_key`),
+  KEY `idx_sys_login_throttle_01` (`update_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='登录限流令牌桶';
+
+--
+-- Table structure for table `sys_mfa_challenge`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_mfa_challenge` (
+  `challenge_hash` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `org_unit_id` varchar(66) NOT NULL,
+  `attempts` int(11) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`challenge_hash`),
+  KEY `idx_sys_mfa_challenge_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='两步验证挑战码';
+
+--
+-- Table structure for table `sys_mfa_require`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_mfa_require` (
+  `require_type` char(1) NOT NULL,
+  `target_id` varchar(66) NOT NULL,
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`require_type`,`target_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='要求两步验证的域与角色';
+
+--
+-- Table structure for table `sys_oauth_client`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_oauth_client` (
+  `client_id` varchar(64) NOT NULL,
+  `client_name` varchar(300) NOT NULL,
+  `client_secret` varchar(160) DEFAULT NULL,
+  `redirect_uris` varchar(2000) DEFAULT NULL,
+  `grant_types` varchar(200) NOT NULL,
+  `scopes` varchar(200) DEFAULT NULL,
+  `client_type` char(1) NOT NULL,
+  `trusted` char(1) NOT NULL,
+  `status` char(1) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `create_user` varchar(30) DEFAULT NULL,
+  `create_date` datetime DEFAULT NULL,
+  `maintance_user` varchar(30) DEFAULT NULL,
+  `maintance_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`client_id`),
+  KEY `fk_sys_oauth_client_01_idx` (`domain_id`),
+  CONSTRAINT `fk_sys_oauth_client_01` FOREIGN KEY (`domain_id`) REFERENCES `sys_domain_info` (`domain_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='OpenID Connect 应用注册信息';
+
+--
+-- Table structure for table `sys_oauth_code`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_oauth_code` (
+  `code_hash` varchar(64) NOT NULL,
+  `client_id` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `org_unit_id` varchar(66) NOT NULL,
+  `redirect_uri` varchar(500) NOT NULL,
+  `scope` varchar(200) NOT NULL,
+  `nonce` varchar(200) DEFAULT NULL,
+  `code_challenge` varchar(128) NOT NULL,
+  `auth_time` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`code_hash`),
+  KEY `idx_sys_oauth_code_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='OpenID Connect 授权码';
+
+--
+-- Table structure for table `sys_passwd_history`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_passwd_history` (
+  `uuid` varchar(60) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `user_passwd` varchar(100) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_passwd_history_01` (`user_id`,`create_time`),
+  CONSTRAINT `fk_sys_passwd_history_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户历史密码';
+
+--
+-- Table structure for table `sys_passwd_policy`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_passwd_policy` (
+  `domain_id` varchar(30) NOT NULL,
+  `min_length` int(11) NOT NULL,
+  `require_upper` char(1) NOT NULL,
+  `require_lower` char(1) NOT NULL,
+  `require_digit` char(1) NOT NULL,
+  `require_special` char(1) NOT NULL,
+  `forbid_user_id` char(1) NOT NULL,
+  `history_cnt` int(11) NOT NULL,
+  `max_age` int(11) NOT NULL,
+  `reset_change` char(1) NOT NULL,
+  `modify_user` varchar(30) DEFAULT NULL,
+  `modify_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`domain_id`),
+  CONSTRAINT `fk_sys_passwd_policy_01` FOREIGN KEY (`domain_id`) REFERENCES `sys_domain_info` (`domain_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='域密码策略';
+
+--
+-- Table structure for table `sys_passwd_reset`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_passwd_reset` (
+  `token_hash` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `client_ip` varchar(64) DEFAULT NULL,
+  `create_time` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`token_hash`),
+  KEY `idx_sys_passwd_reset_01` (`user_id`),
+  KEY `idx_sys_passwd_reset_02` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='找回密码的重置token, token_hash是token的sha256摘要, 使用后删除';
+
+--
+-- Table structure for table `sys_perm_version`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_perm_version` (
+  `id` char(1) NOT NULL,
+  `version` bigint(20) NOT NULL DEFAULT '0',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='权限版本号, 角色授权,用户角色,用户主题或者资源发生变化时加1, 各实例据此清除权限缓存';
+
+--
+-- Table structure for table `sys_role_ancestor_relat`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_role_ancestor_relat` (
+  `role_id` varchar(66) NOT NULL,
+  `ancestor_id` varchar(66) NOT NULL,
+  `depth` int(11) NOT NULL COMMENT '0 表示角色自身,1 表示上级角色,依次类推',
+  PRIMARY KEY (`role_id`,`ancestor_id`),
+  KEY `fk_sys_role_ancestor_02_idx` (`ancestor_id`),
+  CONSTRAINT `fk_sys_role_ancestor_01` FOREIGN KEY (`role_id`) REFERENCES `sys_role_info` (`role_id`) ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_sys_role_ancestor_02` FOREIGN KEY (`ancestor_id`) REFERENCES `sys_role_info` (`role_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+--
+-- Table structure for table `sys_super_admin_audit`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_super_admin_audit` (
+  `uuid` varchar(66) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `role_id` varchar(66) NOT NULL,
+  `action` varchar(10) NOT NULL COMMENT 'grant 授予, revoke 移除',
+  `handle_user` varchar(30) NOT NULL,
+  `handle_time` bigint(20) NOT NULL,
+  `client_ip` varchar(60) DEFAULT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_super_admin_audit_01` (`handle_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='超级管理员角色的授予与移除记录';
+
+--
+-- Table structure for table `sys_token_refresh`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_token_refresh` (
+  `token_hash` varchar(64) NOT NULL,
+  `family_id` varchar(60) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `org_unit_id` varchar(66) NOT NULL,
+  `status` char(1) NOT NULL,
+  `session_time` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`token_hash`),
+  KEY `idx_sys_token_refresh_01` (`family_id`),
+  KEY `idx_sys_token_refresh_02` (`user_id`),
+  KEY `idx_sys_token_refresh_03` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='刷新token信息';
+
+--
+-- Table structure for table `sys_token_revoke`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_token_revoke` (
+  `uuid` varchar(60) NOT NULL,
+  `revoke_type` char(1) NOT NULL,
+  `jti` varchar(60) DEFAULT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `revoke_time` bigint(20) NOT NULL,
+  `revoke_user` varchar(30) DEFAULT NULL,
+  PRIMARY KEY (`uuid`),
+  KEY `idx_sys_token_revoke_01` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='token吊销信息';
+
+--
+-- Table structure for table `sys_user_identity`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_user_identity` (
+  `identity_id` varchar(64) NOT NULL,
+  `issuer` varchar(300) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`identity_id`),
+  KEY `fk_sys_user_identity_01_idx` (`user_id`),
+  CONSTRAINT `fk_sys_user_identity_01` FOREIGN KEY (`user_id`) REFERENCES `sys_user_info` (`user_id`) ON DELETE CASCADE ON UPDATE NO ACTION
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户绑定的外部身份, identity_id = sha256(issuer + 换行 + sub)';
+
+--
+-- Table structure for table `sys_user_mfa`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_user_mfa` (
+  `user_id` varchar(30) NOT NULL,
+  `secret` varchar(200) NOT NULL,
+  `status` char(1) NOT NULL,
+  `last_step` bigint(20) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  `enable_time` bigint(20) DEFAULT NULL,
+  PRIMARY KEY (`user_id`),
+  CONSTRAINT `fk_sys_user_mfa_01` FOREIGN KEY (`user_id`) REFERENCES `sys_sec_user` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户两步验证密钥';
+
+--
+-- Table structure for table `sys_user_mfa_recovery`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_user_mfa_recovery` (
+  `code_hash` varchar(64) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `create_time` bigint(20) NOT NULL,
+  PRIMARY KEY (`code_hash`),
+  KEY `idx_sys_user_mfa_recovery_01` (`user_id`),
+  CONSTRAINT `fk_sys_user_mfa_recovery_01` FOREIGN KEY (`user_id`) REFERENCES `sys_sec_user` (`user_id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='两步验证恢复码';
+
+--
+-- Table structure for table `sys_user_session`
+--
+
+CREATE TABLE IF NOT EXISTS `sys_user_session` (
+  `session_id` varchar(60) NOT NULL,
+  `user_id` varchar(30) NOT NULL,
+  `domain_id` varchar(30) NOT NULL,
+  `client_ip` varchar(64) DEFAULT NULL,
+  `user_agent` varchar(500) DEFAULT NULL,
+  `login_time` bigint(20) NOT NULL,
+  `last_active` bigint(20) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `status` char(1) NOT NULL DEFAULT '0',
+  PRIMARY KEY (`session_id`),
+  KEY `idx_sys_user_session_01` (`user_id`),
+  KEY `idx_sys_user_session_02` (`domain_id`),
+  KEY `idx_sys_user_session_03` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='用户登录会话, session_id是刷新token的family_id, status = 0 有效, 1 已结束';
+
+--
+-- 已有表新增或修改的字段
+--
+
+ALTER TABLE `sys_handle_logs`
+  ADD COLUMN `api_key` varchar(32) DEFAULT NULL AFTER `domain_id`,
+  ADD COLUMN `impersonator` varchar(30) DEFAULT NULL AFTER `api_key`;
+
+ALTER TABLE `sys_role_info`
+  ADD COLUMN `super_admin` char(1) NOT NULL DEFAULT '0' COMMENT '1 表示超级管理员角色' AFTER `code_number`,
+  ADD COLUMN `parent_role_id` varchar(66) DEFAULT NULL COMMENT '上级角色,继承上级角色的资源' AFTER `super_admin`;
+
+ALTER TABLE `sys_role_user_relation`
+  ADD COLUMN `org_scope` varchar(66) DEFAULT NULL AFTER `maintance_user`,
+  ADD COLUMN `org_scope_sub` char(1) NOT NULL DEFAULT '0' AFTER `org_scope`;
+
+ALTER TABLE `sys_sec_user`
+  MODIFY COLUMN `user_passwd` varchar(100) DEFAULT NULL,
+  ADD COLUMN `passwd_time` bigint(20) DEFAULT NULL AFTER `continue_error_cnt`,
+  ADD COLUMN `force_change` char(1) DEFAULT '0' AFTER `passwd_time`,
+  ADD COLUMN `lock_cnt` int(11) NOT NULL DEFAULT '0' AFTER `force_change`,
+  ADD COLUMN `lock_reason` char(1) NOT NULL DEFAULT '0' AFTER `lock_cnt`,
+  ADD COLUMN `lock_time` bigint(20) NOT NULL DEFAULT '0' AFTER `lock_reason`,
+  ADD COLUMN `unlock_time` bigint(20) NOT NULL DEFAULT '0' AFTER `lock_time`,
+  ADD COLUMN `account_type` char(1) NOT NULL DEFAULT '0' COMMENT '0 普通用户, 1 服务账号' AFTER `unlock_time`;
+
+ALTER TABLE `sys_theme_value`
+  ADD COLUMN `res_method` varchar(60) DEFAULT NULL COMMENT 'API允许的HTTP方法,逗号分隔,为空时允许所有方法' AFTER `sort_id`;
+
+--
+-- 初始化数据
+--
+
+UPDATE `sys_role_info` SET `super_admin` = '1' WHERE `role_id` = 'vertex_root_join_sysadmin';
+
+-- 每个角色在闭包表中都有一条指向自身的记录
+INSERT IGNORE INTO `sys_role_ancestor_relat` (`role_id`, `ancestor_id`, `depth`) SELECT `role_id`, `role_id`, 0 FROM `sys_role_info`;
+
+INSERT IGNORE INTO `sys_perm_version` VALUES ('0',0);
+
+INSERT IGNORE INTO `sys_resource_info` VALUES ('0105010700','强制用户退出按钮','1','0105010000','2',NULL),('0104010600','查询密码策略','1','0104010000','2',NULL),('0104010700','设置密码策略按钮','1','0104010000','2',NULL),('0104010800','删除密码策略按钮','1','0104010000','2',NULL),('0104010900','查询两步验证要求','1','0104010000','2',NULL),('0104011000','新增两步验证要求按钮','1','0104010000','2',NULL),('0104011100','删除两步验证要求按钮','1','0104010000','2',NULL),('0105010800','重置两步验证按钮','1','0105010000','2',NULL),('0104011200','查询OpenID Connect应用','1','0104010000','2',NULL),('0104011300','注册OpenID Connect应用按钮','1','0104010000','2',NULL),('0104011400','编辑OpenID Connect应用按钮','1','0104010000','2',NULL),('0104011500','删除OpenID Connect应用按钮','1','0104010000','2',NULL),('0104011600','重置OpenID Connect应用密钥按钮','1','0104010000','2',NULL),('0104011700','查询外部身份提供者','1','0104010000','2',NULL),('0104011800','配置外部身份提供者按钮','1','0104010000','2',NULL),('0104011900','删除外部身份提供者按钮','1','0104010000','2',NULL),('0105010900','查询用户绑定的外部身份','1','0105010000','2',NULL),('0105011000','绑定外部身份按钮','1','0105010000','2',NULL),('0105011100','解除外部身份绑定按钮','1','0105010000','2',NULL),('0105011200','查询API key按钮','1','0105010000','2',NULL),('0105011300','创建API key按钮','1','0105010000','2',NULL),('0105011400','删除API key按钮','1','0105010000','2',NULL),('0105011500','查询登录会话','1','0105010000','2',NULL),('0105011600','结束登录会话按钮','1','0105010000','2',NULL),('0105011700','模拟用户登录按钮','1','0105010000','2',NULL),('0103010600','查看权限缓存统计','1','0103010000','2',NULL),('0105040300','查询超级管理员','1','0105040000','2',NULL),('0105040400','查询超级管理员变更记录','1','0105040000','2',NULL),('0103010700','查询资源详细信息','1','0103010000','2',NULL),('0103010800','查询资源主题信息','1','0103010000','2',NULL),('0103020600','导入组织架构信息按钮','1','0103020000','2',NULL),('0103020700','查询下级组织信息','1','0103020000','2',NULL),('0103030500','查询可以共享的域','1','0104010200','2',NULL),('0104012000','查询域详细信息','1','0104010000','2',NULL),('0105011800','搜索用户信息','1','0105010000','2',NULL),('0105040500','查询用户已经拥有的角色','1','0105040000','2',NULL),('0105040600','查询用户可以授予的角色','1','0105040000','2',NULL);
+
+INSERT IGNORE INTO `sys_role_resource_relat` VALUES ('78bf4c52-ca9d-11f1-aa61-02fc00000001','vertex_root_join_sysadmin','0105010700'),('35e45240-ca9f-11f1-a91d-02fc00000001','vertex_root_join_sysadmin','0104010600'),('35e5311a-ca9f-11f1-a91d-02fc00000001','vertex_root_join_sysadmin','0104010700'),('35e61030-ca9f-11f1-a91d-02fc00000001','vertex_root_join_sysadmin','0104010800'),('22733752-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0104010900'),('2273ddce-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0104011000'),('227475a4-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0104011100'),('22751734-caa0-11f1-b192-02fc00000001','vertex_root_join_sysadmin','0105010800'),('34c22ac4-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011200'),('34c39f6c-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011300'),('34c4e8ea-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011400'),('34c61ac6-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011500'),('34c7463a-caa2-11f1-b483-02fc00000001','vertex_root_join_sysadmin','0104011600'),('00f49d02-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0104011700'),('00f57c4a-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0104011800'),('00f65a84-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0104011900'),('00f74d18-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0105010900'),('00f83066-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0105011000'),('00f90d74-caa3-11f1-8cdb-02fc00000001','vertex_root_join_sysadmin','0105011100'),('065030a8-caa4-11f1-b889-02fc00000001','vertex_root_join_sysadmin','0105011200'),('065104c4-caa4-11f1-b889-02fc00000001','vertex_root_join_sysadmin','0105011300'),('0651cb3e-caa4-11f1-b889-02fc00000001','vertex_root_join_sysadmin','0105011400'),('a57817b8-caa4-11f1-b6d3-02fc00000001','vertex_root_join_sysadmin','0105011500'),('a57958b2-caa4-11f1-b6d3-02fc00000001','vertex_root_join_sysadmin','0105011600'),('efdc405e-caa4-11f1-a7ad-02fc00000001','vertex_root_join_sysadmin','0105011700'),('6f169652-caa6-11f1-b749-02fc00000001','vertex_root_join_sysadmin','0103010600'),('2cb995ce-caa7-11f1-aa8a-02fc00000001','vertex_root_join_sysadmin','0105040300'),('2cba50fe-caa7-11f1-aa8a-02fc00000001','vertex_root_join_sysadmin','0105040400'),('ee5fdc24-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103010700'),('ee5fde0e-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103010700'),('ee5fde90-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103010700'),('ee5fdf1c-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103010700'),('ee60f226-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103010800'),('ee60f4ce-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103010800'),('ee60f55a-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103010800'),('ee60f5dc-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103010800'),('ee622ac4-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103020600'),('ee622cc2-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103020600'),('ee622d4e-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103020600'),('ee622dc6-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103020600'),('ee636af6-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103020700'),('ee636cfe-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103020700'),('ee636d94-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103020700'),('ee636e0c-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103020700'),('ee64aa9c-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0103030500'),('ee64aec0-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0103030500'),('ee64af4c-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0103030500'),('ee64afba-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0103030500'),('ee65ed12-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0104012000'),('ee65ef24-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0104012000'),('ee65efa6-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0104012000'),('ee65f21c-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0104012000'),('ee674090-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0105011800'),('ee6742e8-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0105011800'),('ee67437e-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0105011800'),('ee6743ec-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0105011800'),('ee68b588-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0105040500'),('ee68b7e0-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0105040500'),('ee68b880-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0105040500'),('ee68b8ee-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0105040500'),('ee6a18e2-caa7-11f1-9e6e-02fc00000001','mas_join_masadmin','0105040600'),('ee6a1b30-caa7-11f1-9e6e-02fc00000001','devops_product_join_43124','0105040600'),('ee6a1bda-caa7-11f1-9e6e-02fc00000001','devops_product_join_ftpadmin','0105040600'),('ee6a1c48-caa7-11f1-9e6e-02fc00000001','vertex_root_join_sysadmin','0105040600');
+
+-- API 允许的 HTTP 方法
+UPDATE `sys_theme_value` SET `res_method` = 'GET' WHERE `res_method` IS NULL AND `uuid` IN ('052dc4ac-2b28-11e7-9c7e-a0c58951c8d5','0875a5f3-2b28-11e7-9c7e-a0c58951c8d5','1bde8991-07e9-11e7-952f-a0c58951c8d5','3d237ba7-07e7-11e7-952f-a0c58951c8d5','43ad2a9a-07f1-11e7-952f-a0c58951c8d5','6c7f5772-250a-11e7-9c7e-a0c58951c8d5','7d73058c-07ec-11e7-952f-a0c58951c8d5','8ca386d8-07e5-11e7-952f-a0c58951c8d5','8e2d2ae7-1c0a-11e7-9d82-a0c58951c8d5','946658e9-07d5-11e7-952f-a0c58951c8d5','99180d65-0d55-11e7-964b-a0c58951c8d5','99180da1-0d55-11e7-964b-a0c58951c8d5','99180e87-0d55-11e7-964b-a0c58951c8d5','99180fa1-0d55-11e7-964b-a0c58951c8d5','99180fdc-0d55-11e7-964b-a0c58951c8d5','99181014-0d55-11e7-964b-a0c58951c8d5','991810fe-0d55-11e7-964b-a0c58951c8d5','9918113a-0d55-11e7-964b-a0c58951c8d5','9918124f-0d55-11e7-964b-a0c58951c8d5','9918139c-0d55-11e7-964b-a0c58951c8d5','99181476-0d55-11e7-964b-a0c58951c8d5','991814ad-0d55-11e7-964b-a0c58951c8d5','a265597d-07ed-11e7-952f-a0c58951c8d5','a29fba3f-07e5-11e7-952f-a0c58951c8d5','a343cbfc-2b27-11e7-9c7e-a0c58951c8d5','a65d91b0-2b27-11e7-9c7e-a0c58951c8d5','a8854ec0-2b27-11e7-9c7e-a0c58951c8d5','aabbbd36-2b27-11e7-9c7e-a0c58951c8d5','b1314131-2b27-11e7-9c7e-a0c58951c8d5','bea9df22-2b27-11e7-9c7e-a0c58951c8d5','becde5db-0eb9-11e7-9612-a0c58951c8d5','c1174621-07e1-11e7-952f-a0c58951c8d5','c3bad47b-07ee-11e7-952f-a0c58951c8d5','c77c6ed0-2b27-11e7-9c7e-a0c58951c8d5','d4bfa83c-2b27-11e7-9c7e-a0c58951c8d5','d767f63e-2b27-11e7-9c7e-a0c58951c8d5','da84a5e1-2b27-11e7-9c7e-a0c58951c8d5','daadf91b-07e6-11e7-952f-a0c58951c8d5','eb13f0e9-2b27-11e7-9c7e-a0c58951c8d5','f2e81083-07d2-11e7-95d9-a0c58951c8d5','f94b4a93-2b27-11e7-9c7e-a0c58951c8d5');
+UPDATE `sys_theme_value` SET `res_method` = 'PUT' WHERE `res_method` IS NULL AND `uuid` IN ('00714873-07ed-11e7-952f-a0c58951c8d5','0574add7-07e7-11e7-952f-a0c58951c8d5','1c30f988-07e2-11e7-952f-a0c58951c8d5','48460086-07e9-11e7-952f-a0c58951c8d5','8024ac09-07d8-11e7-952f-a0c58951c8d5','99180ddc-0d55-11e7-964b-a0c58951c8d5','99180f32-0d55-11e7-964b-a0c58951c8d5','99181087-0d55-11e7-964b-a0c58951c8d5','991811ad-0d55-11e7-964b-a0c58951c8d5','99181218-0d55-11e7-964b-a0c58951c8d5','991812c3-0d55-11e7-964b-a0c58951c8d5','99181332-0d55-11e7-964b-a0c58951c8d5','99181365-0d55-11e7-964b-a0c58951c8d5','9918140b-0d55-11e7-964b-a0c58951c8d5','b58002f6-07ec-11e7-952f-a0c58951c8d5','b6372ff3-2b27-11e7-9c7e-a0c58951c8d5','b8df0cd7-07e9-11e7-952f-a0c58951c8d5','bb9fc76f-2b27-11e7-9c7e-a0c58951c8d5','c37806f8-2b27-11e7-9c7e-a0c58951c8d5','d517aab8-07ed-11e7-952f-a0c58951c8d5','e0a10dc4-2b27-11e7-9c7e-a0c58951c8d5','e2e782c4-2b27-11e7-9c7e-a0c58951c8d5','ec5cb33a-07ec-11e7-952f-a0c58951c8d5','ef613f0c-2b27-11e7-9c7e-a0c58951c8d5','f3959708-2b27-11e7-9c7e-a0c58951c8d5','f5a0999f-2b27-11e7-9c7e-a0c58951c8d5','ff9f6773-2b27-11e7-9c7e-a0c58951c8d5');
+UPDATE `sys_theme_value` SET `res_method` = 'POST' WHERE `res_method` IS NULL AND `uuid` IN ('0287ee48-2b28-11e7-9c7e-a0c58951c8d5','0a964ef9-2b28-11e7-9c7e-a0c58951c8d5','0f9303e2-07f2-11e7-952f-a0c58951c8d5','107e273d-2b28-11e7-9c7e-a0c58951c8d5','12cd5409-2b28-11e7-9c7e-a0c58951c8d5','1bf270aa-07e7-11e7-952f-a0c58951c8d5','25165700-07f2-11e7-952f-a0c58951c8d5','33b9cb0c-07e9-11e7-952f-a0c58951c8d5','5a7d8dbf-07f1-11e7-952f-a0c58951c8d5','6bb7b2c8-07e9-11e7-952f-a0c58951c8d5','9705437b-07d8-11e7-952f-a0c58951c8d5','974ce1fd-07ec-11e7-952f-a0c58951c8d5','99180e14-0d55-11e7-964b-a0c58951c8d5','99180e4f-0d55-11e7-964b-a0c58951c8d5','99180ec3-0d55-11e7-964b-a0c58951c8d5','99180efa-0d55-11e7-964b-a0c58951c8d5','9918104b-0d55-11e7-964b-a0c58951c8d5','991810be-0d55-11e7-964b-a0c58951c8d5','99181176-0d55-11e7-964b-a0c58951c8d5','991811e1-0d55-11e7-964b-a0c58951c8d5','9918128b-0d55-11e7-964b-a0c58951c8d5','991812fa-0d55-11e7-964b-a0c58951c8d5','991813d4-0d55-11e7-964b-a0c58951c8d5','99181443-0d55-11e7-964b-a0c58951c8d5','991814f2-0d55-11e7-964b-a0c58951c8d5','9918152d-0d55-11e7-964b-a0c58951c8d5','99181569-0d55-11e7-964b-a0c58951c8d5','ad3e295c-07d8-11e7-952f-a0c58951c8d5','b3c7c6a6-2b27-11e7-9c7e-a0c58951c8d5','b8d3d1c1-2b27-11e7-9c7e-a0c58951c8d5','bd264fd7-07ed-11e7-952f-a0c58951c8d5','c15e0f8b-2b27-11e7-9c7e-a0c58951c8d5','c59c3303-2b27-11e7-9c7e-a0c58951c8d5','c988bb89-07ec-11e7-952f-a0c58951c8d5','d8fccbcb-07e1-11e7-952f-a0c58951c8d5','dc65642a-2b27-11e7-9c7e-a0c58951c8d5','de8f9fcb-2b27-11e7-9c7e-a0c58951c8d5','e4e17463-2b27-11e7-9c7e-a0c58951c8d5','e777d2c2-2b27-11e7-9c7e-a0c58951c8d5','ea237b6a-07ed-11e7-952f-a0c58951c8d5','ed148f2a-2b27-11e7-9c7e-a0c58951c8d5','ee765e9a-07e6-11e7-952f-a0c58951c8d5','f19af335-2b27-11e7-9c7e-a0c58951c8d5','fb975107-07e1-11e7-952f-a0c58951c8d5','fdb44348-2b27-11e7-9c7e-a0c58951c8d5');
+
+DELETE FROM `sys_theme_value` WHERE `uuid` IN ('00f44b5e-caa3-11f1-8cdb-02fc00000001','00f44d5c-caa3-11f1-8cdb-02fc00000001','00f44dca-caa3-11f1-8cdb-02fc00000001','00f44e2e-caa3-11f1-8cdb-02fc00000001','00f52330-caa3-11f1-8cdb-02fc00000001','00f52574-caa3-11f1-8cdb-02fc00000001','00f5261e-caa3-11f1-8cdb-02fc00000001','00f526a0-caa3-11f1-8cdb-02fc00000001','00f5ffb2-caa3-11f1-8cdb-02fc00000001','00f6012e-caa3-11f1-8cdb-02fc00000001','00f60246-caa3-11f1-8cdb-02fc00000001','00f602a0-caa3-11f1-8cdb-02fc00000001','00f6ea9e-caa3-11f1-8cdb-02fc00000001','00f6ed5a-caa3-11f1-8cdb-02fc00000001','00f6edf0-caa3-11f1-8cdb-02fc00000001','00f6ee68-caa3-11f1-8cdb-02fc00000001','00f7e82c-caa3-11f1-8cdb-02fc00000001','00f7eba6-caa3-11f1-8cdb-02fc00000001','00f7ec32-caa3-11f1-8cdb-02fc00000001','00f7ec82-caa3-11f1-8cdb-02fc00000001','00f8b9d2-caa3-11f1-8cdb-02fc00000001','00f8bbf8-caa3-11f1-8cdb-02fc00000001','00f8bc7a-caa3-11f1-8cdb-02fc00000001','00f8bcca-caa3-11f1-8cdb-02fc00000001','064fd734-caa4-11f1-b889-02fc00000001','064fd900-caa4-11f1-b889-02fc00000001','064fd96e-caa4-11f1-b889-02fc00000001','064fd9be-caa4-11f1-b889-02fc00000001','0650be4c-caa4-11f1-b889-02fc00000001','0650bfdc-caa4-11f1-b889-02fc00000001','0650c086-caa4-11f1-b889-02fc00000001','0650c1bc-caa4-11f1-b889-02fc00000001','06517e7c-caa4-11f1-b889-02fc00000001','06518066-caa4-11f1-b889-02fc00000001','065180d4-caa4-11f1-b889-02fc00000001','06518124-caa4-11f1-b889-02fc00000001','2272e950-caa0-11f1-b192-02fc00000001','2272eb94-caa0-11f1-b192-02fc00000001','2272ec20-caa0-11f1-b192-02fc00000001','2272ecac-caa0-11f1-b192-02fc00000001','2273a494-caa0-11f1-b192-02fc00000001','2273a642-caa0-11f1-b192-02fc00000001','2273a6ce-caa0-11f1-b192-02fc00000001','2273a750-caa0-11f1-b192-02fc00000001','22743b66-caa0-11f1-b192-02fc00000001','22743ce2-caa0-11f1-b192-02fc00000001','22743d46-caa0-11f1-b192-02fc00000001','22743da0-caa0-11f1-b192-02fc00000001','2274da08-caa0-11f1-b192-02fc00000001','2274dbac-caa0-11f1-b192-02fc00000001','2274dc06-caa0-11f1-b192-02fc00000001','2274dc56-caa0-11f1-b192-02fc00000001','2cb947f4-caa7-11f1-aa8a-02fc00000001','2cb949ca-caa7-11f1-aa8a-02fc00000001','2cb94a42-caa7-11f1-aa8a-02fc00000001','2cb94a92-caa7-11f1-aa8a-02fc00000001','2cba0d7e-caa7-11f1-aa8a-02fc00000001','2cba0ed2-caa7-11f1-aa8a-02fc00000001','2cba0f72-caa7-11f1-aa8a-02fc00000001','2cba0fea-caa7-11f1-aa8a-02fc00000001','34c1a13a-caa2-11f1-b483-02fc00000001','34c1a3ce-caa2-11f1-b483-02fc00000001','34c1a482-caa2-11f1-b483-02fc00000001','34c1a504-caa2-11f1-b483-02fc00000001','34c31b5a-caa2-11f1-b483-02fc00000001','34c31e34-caa2-11f1-b483-02fc00000001','34c31f1a-caa2-11f1-b483-02fc00000001','34c31fd8-caa2-11f1-b483-02fc00000001','34c4781a-caa2-11f1-b483-02fc00000001','34c47ac2-caa2-11f1-b483-02fc00000001','34c47b6c-caa2-11f1-b483-02fc00000001','34c47bee-caa2-11f1-b483-02fc00000001','34c5b68a-caa2-11f1-b483-02fc00000001','34c5b87e-caa2-11f1-b483-02fc00000001','34c5b8ec-caa2-11f1-b483-02fc00000001','34c5b93c-caa2-11f1-b483-02fc00000001','34c6e852-caa2-11f1-b483-02fc00000001','34c6eb04-caa2-11f1-b483-02fc00000001','34c6eba4-caa2-11f1-b483-02fc00000001','34c6ec26-caa2-11f1-b483-02fc00000001','35e3f624-ca9f-11f1-a91d-02fc00000001','35e3f93a-ca9f-11f1-a91d-02fc00000001','35e3f9da-ca9f-11f1-a91d-02fc00000001','35e3fa66-ca9f-11f1-a91d-02fc00000001','35e4d58a-ca9f-11f1-a91d-02fc00000001','35e4d850-ca9f-11f1-a91d-02fc00000001','35e4d972-ca9f-11f1-a91d-02fc00000001','35e4da44-ca9f-11f1-a91d-02fc00000001','35e5bc84-ca9f-11f1-a91d-02fc00000001','35e5bf7c-ca9f-11f1-a91d-02fc00000001','35e5c012-ca9f-11f1-a91d-02fc00000001','35e5c094-ca9f-11f1-a91d-02fc00000001','6f162cee-caa6-11f1-b749-02fc00000001','6f162fa0-caa6-11f1-b749-02fc00000001','6f163036-caa6-11f1-b749-02fc00000001','6f1630b8-caa6-11f1-b749-02fc00000001','78bee7b2-ca9d-11f1-aa61-02fc00000001','78beeb40-ca9d-11f1-aa61-02fc00000001','78beec08-ca9d-11f1-aa61-02fc00000001','78beec94-ca9d-11f1-aa61-02fc00000001','a577763c-caa4-11f1-b6d3-02fc00000001','a57779d4-caa4-11f1-b6d3-02fc00000001','a57789c4-caa4-11f1-b6d3-02fc00000001','a5778a82-caa4-11f1-b6d3-02fc00000001','a578f3cc-caa4-11f1-b6d3-02fc00000001','a578f656-caa4-11f1-b6d3-02fc00000001','a578f71e-caa4-11f1-b6d3-02fc00000001','a578f7be-caa4-11f1-b6d3-02fc00000001','ee5f8b7a-caa7-11f1-9e6e-02fc00000001','ee5f8d28-caa7-11f1-9e6e-02fc00000001','ee5f8d8c-caa7-11f1-9e6e-02fc00000001','ee5f8dd2-caa7-11f1-9e6e-02fc00000001','ee606efa-caa7-11f1-9e6e-02fc00000001','ee607120-caa7-11f1-9e6e-02fc00000001','ee60735a-caa7-11f1-9e6e-02fc00000001','ee60740e-caa7-11f1-9e6e-02fc00000001','ee61b5b2-caa7-11f1-9e6e-02fc00000001','ee61b8c8-caa7-11f1-9e6e-02fc00000001','ee61b95e-caa7-11f1-9e6e-02fc00000001','ee61b9e0-caa7-11f1-9e6e-02fc00000001','ee62f5bc-caa7-11f1-9e6e-02fc00000001','ee62f7f6-caa7-11f1-9e6e-02fc00000001','ee62f88c-caa7-11f1-9e6e-02fc00000001','ee62f90e-caa7-11f1-9e6e-02fc00000001','ee643666-caa7-11f1-9e6e-02fc00000001','ee6438b4-caa7-11f1-9e6e-02fc00000001','ee64394a-caa7-11f1-9e6e-02fc00000001','ee6439c2-caa7-11f1-9e6e-02fc00000001','ee657be8-caa7-11f1-9e6e-02fc00000001','ee657e40-caa7-11f1-9e6e-02fc00000001','ee657ecc-caa7-11f1-9e6e-02fc00000001','ee657f44-caa7-11f1-9e6e-02fc00000001','ee66c0a2-caa7-11f1-9e6e-02fc00000001','ee66c2fa-caa7-11f1-9e6e-02fc00000001','ee66c39a-caa7-11f1-9e6e-02fc00000001','ee66c412-caa7-11f1-9e6e-02fc00000001','ee681dd0-caa7-11f1-9e6e-02fc00000001','ee68205a-caa7-11f1-9e6e-02fc00000001','ee6820f0-caa7-11f1-9e6e-02fc00000001','ee682168-caa7-11f1-9e6e-02fc00000001','ee698cd8-caa7-11f1-9e6e-02fc00000001','ee698f6c-caa7-11f1-9e6e-02fc00000001','ee69900c-caa7-11f1-9e6e-02fc00000001','ee699098-caa7-11f1-9e6e-02fc00000001','efdbdcea-caa4-11f1-a7ad-02fc00000001','efdbdea2-caa4-11f1-a7ad-02fc00000001','efdbdf10-caa4-11f1-a7ad-02fc00000001','efdbdf60-caa4-11f1-a7ad-02fc00000001');
+INSERT INTO `sys_theme_value` VALUES ('78bee7b2-ca9d-11f1-aa61-02fc00000001','1001','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('78beeb40-ca9d-11f1-aa61-02fc00000001','1002','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('78beec08-ca9d-11f1-aa61-02fc00000001','1003','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('78beec94-ca9d-11f1-aa61-02fc00000001','1004','0105010700','/v1/auth/user/revoke/token','0','','','','',0,'POST'),('35e3f624-ca9f-11f1-a91d-02fc00000001','1001','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e3f93a-ca9f-11f1-a91d-02fc00000001','1002','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e3f9da-ca9f-11f1-a91d-02fc00000001','1003','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e3fa66-ca9f-11f1-a91d-02fc00000001','1004','0104010600','/v1/auth/passwd/policy/get','0','','','','',0,'GET'),('35e4d58a-ca9f-11f1-a91d-02fc00000001','1001','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e4d850-ca9f-11f1-a91d-02fc00000001','1002','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e4d972-ca9f-11f1-a91d-02fc00000001','1003','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e4da44-ca9f-11f1-a91d-02fc00000001','1004','0104010700','/v1/auth/passwd/policy/put','0','','','','',0,'PUT'),('35e5bc84-ca9f-11f1-a91d-02fc00000001','1001','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('35e5bf7c-ca9f-11f1-a91d-02fc00000001','1002','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('35e5c012-ca9f-11f1-a91d-02fc00000001','1003','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('35e5c094-ca9f-11f1-a91d-02fc00000001','1004','0104010800','/v1/auth/passwd/policy/delete','0','','','','',0,'POST'),('2272e950-caa0-11f1-b192-02fc00000001','1001','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2272eb94-caa0-11f1-b192-02fc00000001','1002','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2272ec20-caa0-11f1-b192-02fc00000001','1003','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2272ecac-caa0-11f1-b192-02fc00000001','1004','0104010900','/v1/auth/mfa/require/get','0','','','','',0,'GET'),('2273a494-caa0-11f1-b192-02fc00000001','1001','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('2273a642-caa0-11f1-b192-02fc00000001','1002','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('2273a6ce-caa0-11f1-b192-02fc00000001','1003','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('2273a750-caa0-11f1-b192-02fc00000001','1004','0104011000','/v1/auth/mfa/require/post','0','','','','',0,'POST'),('22743b66-caa0-11f1-b192-02fc00000001','1001','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('22743ce2-caa0-11f1-b192-02fc00000001','1002','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('22743d46-caa0-11f1-b192-02fc00000001','1003','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('22743da0-caa0-11f1-b192-02fc00000001','1004','0104011100','/v1/auth/mfa/require/delete','0','','','','',0,'POST'),('2274da08-caa0-11f1-b192-02fc00000001','1001','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('2274dbac-caa0-11f1-b192-02fc00000001','1002','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('2274dc06-caa0-11f1-b192-02fc00000001','1003','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('2274dc56-caa0-11f1-b192-02fc00000001','1004','0105010800','/v1/auth/mfa/reset','0','','','','',0,'POST'),('34c1a13a-caa2-11f1-b483-02fc00000001','1001','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c1a3ce-caa2-11f1-b483-02fc00000001','1002','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c1a482-caa2-11f1-b483-02fc00000001','1003','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c1a504-caa2-11f1-b483-02fc00000001','1004','0104011200','/v1/auth/oauth/client/get','0','','','','',0,'GET'),('34c31b5a-caa2-11f1-b483-02fc00000001','1001','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c31e34-caa2-11f1-b483-02fc00000001','1002','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c31f1a-caa2-11f1-b483-02fc00000001','1003','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c31fd8-caa2-11f1-b483-02fc00000001','1004','0104011300','/v1/auth/oauth/client/post','0','','','','',0,'POST'),('34c4781a-caa2-11f1-b483-02fc00000001','1001','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c47ac2-caa2-11f1-b483-02fc00000001','1002','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c47b6c-caa2-11f1-b483-02fc00000001','1003','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c47bee-caa2-11f1-b483-02fc00000001','1004','0104011400','/v1/auth/oauth/client/put','0','','','','',0,'PUT'),('34c5b68a-caa2-11f1-b483-02fc00000001','1001','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c5b87e-caa2-11f1-b483-02fc00000001','1002','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c5b8ec-caa2-11f1-b483-02fc00000001','1003','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c5b93c-caa2-11f1-b483-02fc00000001','1004','0104011500','/v1/auth/oauth/client/delete','0','','','','',0,'POST'),('34c6e852-caa2-11f1-b483-02fc00000001','1001','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('34c6eb04-caa2-11f1-b483-02fc00000001','1002','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('34c6eba4-caa2-11f1-b483-02fc00000001','1003','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('34c6ec26-caa2-11f1-b483-02fc00000001','1004','0104011600','/v1/auth/oauth/client/secret','0','','','','',0,'POST'),('00f44b5e-caa3-11f1-8cdb-02fc00000001','1001','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f44d5c-caa3-11f1-8cdb-02fc00000001','1002','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f44dca-caa3-11f1-8cdb-02fc00000001','1003','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f44e2e-caa3-11f1-8cdb-02fc00000001','1004','0104011700','/v1/auth/domain/idp/get','0','','','','',0,'GET'),('00f52330-caa3-11f1-8cdb-02fc00000001','1001','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f52574-caa3-11f1-8cdb-02fc00000001','1002','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f5261e-caa3-11f1-8cdb-02fc00000001','1003','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f526a0-caa3-11f1-8cdb-02fc00000001','1004','0104011800','/v1/auth/domain/idp/put','0','','','','',0,'PUT'),('00f5ffb2-caa3-11f1-8cdb-02fc00000001','1001','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f6012e-caa3-11f1-8cdb-02fc00000001','1002','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f60246-caa3-11f1-8cdb-02fc00000001','1003','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f602a0-caa3-11f1-8cdb-02fc00000001','1004','0104011900','/v1/auth/domain/idp/delete','0','','','','',0,'POST'),('00f6ea9e-caa3-11f1-8cdb-02fc00000001','1001','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f6ed5a-caa3-11f1-8cdb-02fc00000001','1002','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f6edf0-caa3-11f1-8cdb-02fc00000001','1003','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f6ee68-caa3-11f1-8cdb-02fc00000001','1004','0105010900','/v1/auth/user/identity/get','0','','','','',0,'GET'),('00f7e82c-caa3-11f1-8cdb-02fc00000001','1001','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f7eba6-caa3-11f1-8cdb-02fc00000001','1002','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f7ec32-caa3-11f1-8cdb-02fc00000001','1003','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f7ec82-caa3-11f1-8cdb-02fc00000001','1004','0105011000','/v1/auth/user/identity/post','0','','','','',0,'POST'),('00f8b9d2-caa3-11f1-8cdb-02fc00000001','1001','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('00f8bbf8-caa3-11f1-8cdb-02fc00000001','1002','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('00f8bc7a-caa3-11f1-8cdb-02fc00000001','1003','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('00f8bcca-caa3-11f1-8cdb-02fc00000001','1004','0105011100','/v1/auth/user/identity/delete','0','','','','',0,'POST'),('064fd734-caa4-11f1-b889-02fc00000001','1001','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('064fd900-caa4-11f1-b889-02fc00000001','1002','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('064fd96e-caa4-11f1-b889-02fc00000001','1003','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('064fd9be-caa4-11f1-b889-02fc00000001','1004','0105011200','/v1/auth/user/apikey/get','0','','','','',0,'GET'),('0650be4c-caa4-11f1-b889-02fc00000001','1001','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('0650bfdc-caa4-11f1-b889-02fc00000001','1002','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('0650c086-caa4-11f1-b889-02fc00000001','1003','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('0650c1bc-caa4-11f1-b889-02fc00000001','1004','0105011300','/v1/auth/user/apikey/post','0','','','','',0,'POST'),('06517e7c-caa4-11f1-b889-02fc00000001','1001','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('06518066-caa4-11f1-b889-02fc00000001','1002','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('065180d4-caa4-11f1-b889-02fc00000001','1003','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('06518124-caa4-11f1-b889-02fc00000001','1004','0105011400','/v1/auth/user/apikey/delete','0','','','','',0,'POST'),('a577763c-caa4-11f1-b6d3-02fc00000001','1001','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a57779d4-caa4-11f1-b6d3-02fc00000001','1002','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a57789c4-caa4-11f1-b6d3-02fc00000001','1003','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a5778a82-caa4-11f1-b6d3-02fc00000001','1004','0105011500','/v1/auth/session/domain/get','0','','','','',0,'GET'),('a578f3cc-caa4-11f1-b6d3-02fc00000001','1001','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('a578f656-caa4-11f1-b6d3-02fc00000001','1002','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('a578f71e-caa4-11f1-b6d3-02fc00000001','1003','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('a578f7be-caa4-11f1-b6d3-02fc00000001','1004','0105011600','/v1/auth/session/domain/delete','0','','','','',0,'POST'),('efdbdcea-caa4-11f1-a7ad-02fc00000001','1001','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('efdbdea2-caa4-11f1-a7ad-02fc00000001','1002','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('efdbdf10-caa4-11f1-a7ad-02fc00000001','1003','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('efdbdf60-caa4-11f1-a7ad-02fc00000001','1004','0105011700','/v1/auth/user/impersonate','0','','','','',0,'POST'),('6f162cee-caa6-11f1-b749-02fc00000001','1001','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('6f162fa0-caa6-11f1-b749-02fc00000001','1002','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('6f163036-caa6-11f1-b749-02fc00000001','1003','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('6f1630b8-caa6-11f1-b749-02fc00000001','1004','0103010600','/v1/auth/permcache/stats','0','','','','',0,'GET'),('2cb947f4-caa7-11f1-aa8a-02fc00000001','1001','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cb949ca-caa7-11f1-aa8a-02fc00000001','1002','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cb94a42-caa7-11f1-aa8a-02fc00000001','1003','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cb94a92-caa7-11f1-aa8a-02fc00000001','1004','0105040300','/v1/auth/superadmin/get','0','','','','',0,'GET'),('2cba0d7e-caa7-11f1-aa8a-02fc00000001','1001','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('2cba0ed2-caa7-11f1-aa8a-02fc00000001','1002','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('2cba0f72-caa7-11f1-aa8a-02fc00000001','1003','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('2cba0fea-caa7-11f1-aa8a-02fc00000001','1004','0105040400','/v1/auth/superadmin/audit','0','','','','',0,'GET'),('ee5f8b7a-caa7-11f1-9e6e-02fc00000001','1001','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee5f8d28-caa7-11f1-9e6e-02fc00000001','1002','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee5f8d8c-caa7-11f1-9e6e-02fc00000001','1003','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee5f8dd2-caa7-11f1-9e6e-02fc00000001','1004','0103010700','/v1/auth/resource/query','0','','','','',0,'GET'),('ee606efa-caa7-11f1-9e6e-02fc00000001','1001','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee607120-caa7-11f1-9e6e-02fc00000001','1002','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee60735a-caa7-11f1-9e6e-02fc00000001','1003','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee60740e-caa7-11f1-9e6e-02fc00000001','1004','0103010800','/v1/auth/resource/queryTheme','0','','','','',0,'GET'),('ee61b5b2-caa7-11f1-9e6e-02fc00000001','1001','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee61b8c8-caa7-11f1-9e6e-02fc00000001','1002','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee61b95e-caa7-11f1-9e6e-02fc00000001','1003','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee61b9e0-caa7-11f1-9e6e-02fc00000001','1004','0103020600','/v1/auth/resource/org/upload','0','','','','',0,'POST'),('ee62f5bc-caa7-11f1-9e6e-02fc00000001','1001','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee62f7f6-caa7-11f1-9e6e-02fc00000001','1002','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee62f88c-caa7-11f1-9e6e-02fc00000001','1003','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee62f90e-caa7-11f1-9e6e-02fc00000001','1004','0103020700','/v1/auth/relation/domain/org','0','','','','',0,'GET'),('ee643666-caa7-11f1-9e6e-02fc00000001','1001','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee6438b4-caa7-11f1-9e6e-02fc00000001','1002','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee64394a-caa7-11f1-9e6e-02fc00000001','1003','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee6439c2-caa7-11f1-9e6e-02fc00000001','1004','0103030500','/v1/auth/domain/share/unauth','0','','','','',0,'GET'),('ee657be8-caa7-11f1-9e6e-02fc00000001','1001','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee657e40-caa7-11f1-9e6e-02fc00000001','1002','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee657ecc-caa7-11f1-9e6e-02fc00000001','1003','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee657f44-caa7-11f1-9e6e-02fc00000001','1004','0104012000','/v1/auth/domain/row/details','0','','','','',0,'GET'),('ee66c0a2-caa7-11f1-9e6e-02fc00000001','1001','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee66c2fa-caa7-11f1-9e6e-02fc00000001','1002','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee66c39a-caa7-11f1-9e6e-02fc00000001','1003','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee66c412-caa7-11f1-9e6e-02fc00000001','1004','0105011800','/v1/auth/user/search','0','','','','',0,'GET'),('ee681dd0-caa7-11f1-9e6e-02fc00000001','1001','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee68205a-caa7-11f1-9e6e-02fc00000001','1002','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee6820f0-caa7-11f1-9e6e-02fc00000001','1003','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee682168-caa7-11f1-9e6e-02fc00000001','1004','0105040500','/v1/auth/user/roles/get','0','','','','',0,'GET'),('ee698cd8-caa7-11f1-9e6e-02fc00000001','1001','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET'),('ee698f6c-caa7-11f1-9e6e-02fc00000001','1002','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET'),('ee69900c-caa7-11f1-9e6e-02fc00000001','1003','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET'),('ee699098-caa7-11f1-9e6e-02fc00000001','1004','0105040600','/v1/auth/user/roles/other','0','','','','',0,'GET');
+
+/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;
@@ -89,6 +89,17 @@ var Hutils = {
             body:"点击确定退出系统"
         })
     },
+    // 结束模拟用户登录,使用管理员的刷新token恢复管理员的登录状态
+    HEndImpersonate:function(){
+        $.ajax({type:"post",url:"/v1/auth/user/impersonate/end",cache:!1,dataType:"json",
+            complete:function(){
+                $.ajax({type:"post",url:"/v1/auth/token/refresh",cache:!1,dataType:"json",
+                    success:function(){window.location.href="/HomePage"},
+                    error:function(){window.location.href="/"}
+                })
+            }
+        })
+    },
     // 用户信息管理
     UserMgrInfo:function(){
         if (window.event != undefined){
@@ -1938,6 +1949,31 @@ var Hutils = {
     };
 }(jQuery));
 
+/*
+ * CSRF token
+ * 通过cookie登录时,修改数据的请求需要在X-CSRF-Token头部中提交CsrfToken cookie中的值,
+ * 所有通过jQuery发送的请求自动附带这个头部.
+ * */
+(function($){
+
+    $.extend({
+        HCsrfToken:function(){
+            var m = document.cookie.match(/(?:^|;\s*)CsrfToken=([^;]*)/);
+            return m ? decodeURIComponent(m[1]) : "";
+        },
+    });
+
+    $.ajaxPrefilter(function(options, originalOptions, xhr){
+        if (/^(GET|HEAD|OPTIONS|TRACE)$/i.test(options.type) || options.crossDomain) {
+            return
+        }
+        var token = $.HCsrfToken();
+        if (token != "") {
+            xhr.setRequestHeader("X-CSRF-Token", token);
+        }
+    });
+}(jQuery));
+
 /*
  * 弹出框效果
  * */
@@ -2123,6 +2159,9 @@ var Hutils = {
                         // 不压缩image, 默认如果是jpeg，文件上传前会压缩一把再上传！
                         resize: false
                     });
+                    uploader.on('uploadBeforeSend',function (obj, data, headers) {
+                        headers["X-CSRF-Token"] = $.HCsrfToken();
+                    });
                     uploader.on('beforeFileQueued',function () {
                         uploader.reset();
                     });
@@ -0,0 +1,51 @@
+// 用户密码摘要
+// 密码使用bcrypt算法保存,每一个密码使用不同的盐值,无法从数据库中还原出密码明文.
+// 早期版本使用AES加密保存密码,这类密码在用户下次登录成功后,
+// 由调用方根据Verify的返回值重新计算摘要并保存.
+package hpasswd
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcrypt计算强度
+const cost = 10
+
+// MaxLength 密码最大字节数,bcrypt只使用密码的前72个字节
+const MaxLength = 72
+
+// Hash 计算密码的bcrypt摘要
+func Hash(passwd string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(passwd), cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Verify 校验密码是否正确
+// 第一个返回值表示密码是否正确,
+// 第二个返回值表示保存的密码需要重新计算摘要,AES加密的旧密码,或者bcrypt强度低于当前配置时返回true
+func Verify(hashed, passwd string) (bool, bool) {
+	if !isBcrypt(hashed) {
+		en, err := haes.Encrypt(passwd)
+		if err != nil || subtle.ConstantTimeCompare([]byte(en), []byte(hashed)) != 1 {
+			return false, false
+		}
+		return true, true
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(hashed), []byte(passwd)) != nil {
+		return false, false
+	}
+	c, err := bcrypt.Cost([]byte(hashed))
+	return true, err != nil || c < cost
+}
+
+func isBcrypt(hashed string) bool {
+	return strings.HasPrefix(hashed, "$2a$") || strings.HasPrefix(hashed, "$2b$") || strings.HasPrefix(hashed, "$2y$")
+}
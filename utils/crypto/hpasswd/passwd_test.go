@@ -0,0 +1,62 @@
+package hpasswd
+
+import (
+	"testing"
+
+	"github.com/hzwy23/hauth/utils/crypto/haes"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHash(t *testing.T) {
+	h1, err := Hash("Passw0rd!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := Hash("Passw0rd!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isBcrypt(h1) || h1 == h2 {
+		t.Error("password hash must be bcrypt with random salt.", h1, h2)
+	}
+	if c, err := bcrypt.Cost([]byte(h1)); err != nil || c != cost {
+		t.Error("unexpected bcrypt cost.", c, err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	hashed, err := Hash("Passw0rd!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := haes.Encrypt("Passw0rd!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weak, err := bcrypt.GenerateFromPassword([]byte("Passw0rd!"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		hashed string
+		passwd string
+		ok     bool
+		rehash bool
+	}{
+		{"bcrypt", hashed, "Passw0rd!", true, false},
+		{"bcrypt wrong password", hashed, "passw0rd!", false, false},
+		{"legacy aes", legacy, "Passw0rd!", true, true},
+		{"legacy aes wrong password", legacy, "Passw0rd", false, false},
+		{"low cost bcrypt", string(weak), "Passw0rd!", true, true},
+		{"low cost bcrypt wrong password", string(weak), "", false, false},
+		{"empty hash", "", "", false, false},
+	}
+	for _, c := range cases {
+		ok, rehash := Verify(c.hashed, c.passwd)
+		if ok != c.ok || rehash != c.rehash {
+			t.Errorf("%s: got (%v, %v), want (%v, %v)", c.name, ok, rehash, c.ok, c.rehash)
+		}
+	}
+}
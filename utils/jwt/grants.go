@@ -0,0 +1,95 @@
+package jwt
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// 授权信息
+// token中的authorities是用户拥有的角色编码,多个角色使用逗号分隔;
+// perms是用户可以访问的资源编码,排序后使用逗号连接,再经过deflate压缩与base64url编码,
+// 只有开启了Hauth.jwt.permissions时才写入token;
+// gver是授权信息的版本,角色或者资源授权发生变化后版本随之变化,
+// 其他服务可以与认证服务返回的当前版本比较,判断token中的授权信息是否已经过期.
+type Grants struct {
+	Roles []string
+	// 为nil时token中不包含资源编码
+	Permissions []string
+	Version     string
+}
+
+// EncodePermissions 把资源编码列表编码成token中的perms
+func EncodePermissions(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	sorted := append([]string(nil), list...)
+	sort.Strings(sorted)
+
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.BestCompression)
+	w.Write([]byte(strings.Join(sorted, ",")))
+	w.Close()
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes())
+}
+
+// DecodePermissions 解析token中的perms,返回排序后的资源编码列表
+func DecodePermissions(perms string) ([]string, error) {
+	if perms == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(perms)
+	if err != nil {
+		return nil, err
+	}
+	r := flate.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	list, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(string(list), ","), nil
+}
+
+func (c *JwtClaims) setGrants(g *Grants) {
+	if g == nil {
+		return
+	}
+	c.Authorities = strings.Join(g.Roles, ",")
+	if g.Permissions != nil {
+		c.Permissions = EncodePermissions(g.Permissions)
+	}
+	c.GrantVersion = g.Version
+}
+
+// Roles 返回token中的角色编码
+func (c *JwtClaims) Roles() []string {
+	if c.Authorities == "" {
+		return nil
+	}
+	return strings.Split(c.Authorities, ",")
+}
+
+// HasRole 判断token中是否包含角色
+func (c *JwtClaims) HasRole(role_id string) bool {
+	for _, r := range c.Roles() {
+		if r == role_id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission 判断token中是否包含资源,token中没有资源编码时返回false
+func (c *JwtClaims) HasPermission(res_id string) bool {
+	list, err := DecodePermissions(c.Permissions)
+	if err != nil {
+		return false
+	}
+	i := sort.SearchStrings(list, res_id)
+	return i < len(list) && list[i] == res_id
+}
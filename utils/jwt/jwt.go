@@ -2,40 +2,96 @@ package jwt
 
 import (
 	"errors"
-	"fmt"
 	"time"
 
-	"github.com/hzwy23/hauth/utils/logs"
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/uuid"
 )
 
 type JwtClaims struct {
 	*jwt.StandardClaims
-	UserId      string
-	DomainId    string
-	OrgUnitId   string
+	UserId    string
+	DomainId  string
+	OrgUnitId string
+	// 用户拥有的角色编码,多个角色使用逗号分隔
 	Authorities string `json:"authorities"`
+	// 用户可以访问的资源编码,使用EncodePermissions编码
+	Permissions string `json:"perms,omitempty"`
+	// 授权信息的版本
+	GrantVersion string `json:"gver,omitempty"`
+	// 签发给第三方应用的token中,授权的范围;使用API key访问时,是API key允许访问的资源
+	Scope string `json:"scope,omitempty"`
+	// 使用API key访问时,API key的编码
+	ApiKey string `json:"api_key,omitempty"`
+	// 通过cookie登录时,绑定到这个token的CSRF token的sha256摘要
+	Csrf string `json:"csrf,omitempty"`
+	// 用户登录后签发的token,所属的会话编码
+	SessionId string `json:"sid,omitempty"`
+	// 管理员模拟用户登录时,实际操作的管理员, UserId是被模拟的用户
+	Impersonator string `json:"impersonator,omitempty"`
+	// 签发时间,单位:毫秒,用于判断token是否在用户级别的吊销之前签发
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 }
 
-var (
-	key []byte = []byte("hzwy23@163.com-jwt")
-)
+// 使用密钥环中当前的key签名,并在token头部写入kid
+func sign(claims JwtClaims) (string, error) {
+	key, err := ring.signing()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.signKey)
+}
 
-func GenToken(user_id, domain_id, org_id string, dt int64) string {
-	fmt.Println(time.Now().Unix())
-	claims := JwtClaims{
-		&jwt.StandardClaims{
-			ExpiresAt: time.Now().Unix() + dt,
+// 每一个token都带有唯一的jti,用于服务端吊销token
+func newClaims(user_id, domain_id, org_id string, dt int64) JwtClaims {
+	ms := time.Now().UnixNano() / int64(time.Millisecond)
+	now := ms / 1000
+	return JwtClaims{
+		StandardClaims: &jwt.StandardClaims{
+			Id:        uuid.GenUUID(),
+			IssuedAt:  now,
+			ExpiresAt: now + dt,
 			Issuer:    "hzwy23",
 		},
-		user_id,
-		domain_id,
-		org_id,
-		"ROLE_ADMIN,AUTH_WRITE,ACTUATOR",
+		UserId:     user_id,
+		DomainId:   domain_id,
+		OrgUnitId:  org_id,
+		IssuedAtMs: ms,
+	}
+}
+
+func GenToken(user_id, domain_id, org_id string, dt int64) string {
+	return GenSessionToken(user_id, domain_id, org_id, "", "", nil, dt)
+}
+
+// GenSessionToken 签发用户登录后使用的访问token, sid是会话编码, csrf是CSRF token的摘要,
+// grants是用户的授权信息
+func GenSessionToken(user_id, domain_id, org_id, sid, csrf string, grants *Grants, dt int64) string {
+	claims := newClaims(user_id, domain_id, org_id, dt)
+	claims.setGrants(grants)
+	claims.SessionId = sid
+	claims.Csrf = csrf
+	ss, err := sign(claims)
+	if err != nil {
+		logs.Error(err)
+		return ""
 	}
+	return ss
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString(key)
+// GenImpersonationToken 签发管理员模拟用户登录使用的访问token
+// admin_id是管理员,sid是管理员的会话编码,管理员的会话结束时,模拟登录同时结束
+// grants是被模拟用户的授权信息
+func GenImpersonationToken(admin_id, user_id, domain_id, org_id, sid, csrf string, grants *Grants, dt int64) string {
+	claims := newClaims(user_id, domain_id, org_id, dt)
+	claims.setGrants(grants)
+	claims.SessionId = sid
+	claims.Csrf = csrf
+	claims.Impersonator = admin_id
+	ss, err := sign(claims)
 	if err != nil {
 		logs.Error(err)
 		return ""
@@ -43,21 +99,30 @@ func GenToken(user_id, domain_id, org_id string, dt int64) string {
 	return ss
 }
 
+// ApiKeyClaims 使用API key访问时的用户信息,不签名,只在服务端内部使用
+// jti是API key的编码,有效期是API key的过期时间
+func ApiKeyClaims(key_id, user_id, domain_id, org_id, scope string, expire int64) *JwtClaims {
+	claims := newClaims(user_id, domain_id, org_id, 0)
+	claims.Id = "apikey-" + key_id
+	claims.ExpiresAt = expire
+	claims.Scope = scope
+	claims.ApiKey = key_id
+	return &claims
+}
+
 func DestoryToken() string {
 
 	claims := JwtClaims{
-		&jwt.StandardClaims{
+		StandardClaims: &jwt.StandardClaims{
 			ExpiresAt: int64(time.Now().Unix() - 99999),
 			Issuer:    "hzwy23",
 		},
-		"exit",
-		"exit",
-		"exit",
-		"ROLE_ADMIN,AUTH_WRITE,ACTUATOR",
+		UserId:    "exit",
+		DomainId:  "exit",
+		OrgUnitId: "exit",
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString(key)
+	ss, err := sign(claims)
 	if err != nil {
 		logs.Error(err)
 		return ""
@@ -66,24 +131,41 @@ func DestoryToken() string {
 }
 
 func CheckToken(token string) bool {
-	_, err := jwt.Parse(token, func(*jwt.Token) (interface{}, error) {
-		return key, nil
-	})
+	_, err := jwt.Parse(token, ring.keyFunc)
 	if err != nil {
-		fmt.Println("parase with claims failed.", err)
+		logs.Error("parse token failed.", err)
 		return false
 	}
 	return true
 }
 
+// 签发给第三方应用的token带有aud,只能由第三方应用使用,不能用来访问本系统
 func ParseJwt(token string) (*JwtClaims, error) {
 	var jclaim = &JwtClaims{}
-	_, err := jwt.ParseWithClaims(token, jclaim, func(*jwt.Token) (interface{}, error) {
-		return key, nil
-	})
+	_, err := jwt.ParseWithClaims(token, jclaim, ring.keyFunc)
 	if err != nil {
-		fmt.Println("parase with claims failed.", err, token)
+		logs.Error("parse token failed.", err)
 		return nil, errors.New("parase with claims failed.")
 	}
+	if jclaim.StandardClaims != nil && jclaim.Audience != "" {
+		return nil, errors.New("token is issued to client " + jclaim.Audience)
+	}
+	return jclaim, nil
+}
+
+// ParseAccessToken 校验访问token,包括签发给第三方应用的token,用于token自省
+// ID token不是访问token,没有scope,不能通过校验
+func ParseAccessToken(token string) (*JwtClaims, error) {
+	var jclaim = &JwtClaims{}
+	_, err := jwt.ParseWithClaims(token, jclaim, ring.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+	if jclaim.StandardClaims == nil {
+		return nil, errors.New("token has no standard claims.")
+	}
+	if jclaim.Audience != "" && jclaim.Scope == "" {
+		return nil, errors.New("token is not an access token.")
+	}
 	return jclaim, nil
 }
@@ -0,0 +1,274 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/hzwy23/hauth/utils/config"
+	"github.com/hzwy23/hauth/utils/logs"
+)
+
+// 签名密钥环
+// 所有签发的token,头部都会带上kid,校验时根据kid查找对应的公钥或密钥.
+// 轮换密钥时,将新的key加入到Hauth.jwt.keys中,并修改Hauth.jwt.signing.kid,
+// 旧的key继续保留在Hauth.jwt.keys中,直到旧key签发的token全部过期后再移除.
+//
+// app.conf配置示例:
+// Hauth.jwt.signing.kid = k2
+// Hauth.jwt.keys = k1,k2
+// Hauth.jwt.key.k1.alg = HS256
+// Hauth.jwt.key.k1.secret = "your secret"
+// Hauth.jwt.key.k2.alg = RS256
+// Hauth.jwt.key.k2.private = ./conf/jwt_k2.key
+// Hauth.jwt.key.k2.public = ./conf/jwt_k2.pub
+// Hauth.jwt.oidc.kid = k2
+type signingKey struct {
+	kid       string
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+type keyRing struct {
+	lock    *sync.RWMutex
+	current string
+	// 签发OpenID Connect token使用的key,必须是非对称算法
+	oidc string
+	keys map[string]*signingKey
+}
+
+var ring = &keyRing{
+	lock: new(sync.RWMutex),
+	keys: make(map[string]*signingKey),
+}
+
+// 返回当前用于签名的key
+func (r *keyRing) signing() (*signingKey, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if key, ok := r.keys[r.current]; ok {
+		return key, nil
+	}
+	return nil, errors.New("jwt signing key is not configured.")
+}
+
+// 根据token头部的kid,返回校验token使用的key
+// 同时校验token的签名算法必须与key的签名算法一致,防止算法替换攻击.
+func (r *keyRing) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return nil, errors.New("token has no kid header.")
+	}
+
+	r.lock.RLock()
+	key, ok := r.keys[kid]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, errors.New("unknown jwt key id: " + kid)
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method: " + token.Method.Alg())
+	}
+	return key.verifyKey, nil
+}
+
+// AddKey 向密钥环中新增一个key
+// alg 支持HS256,HS384,HS512,RS256,RS384,RS512,ES256,ES384,ES512
+// HS系列算法中,signKey与verifyKey都是[]byte类型的密钥
+// RS系列算法中,signKey是*rsa.PrivateKey, verifyKey是*rsa.PublicKey
+// ES系列算法中,signKey是*ecdsa.PrivateKey, verifyKey是*ecdsa.PublicKey
+// signKey可以为nil,表示这个key只用于校验旧的token.
+func AddKey(kid, alg string, signKey, verifyKey interface{}) error {
+	method := jwt.GetSigningMethod(alg)
+	if method == nil || method == jwt.SigningMethodNone {
+		return errors.New("unsupported jwt signing method: " + alg)
+	}
+	if verifyKey == nil {
+		return errors.New("jwt verify key is empty, kid is: " + kid)
+	}
+	ring.lock.Lock()
+	defer ring.lock.Unlock()
+	ring.keys[kid] = &signingKey{
+		kid:       kid,
+		method:    method,
+		signKey:   signKey,
+		verifyKey: verifyKey,
+	}
+	return nil
+}
+
+// SetSigningKey 设置签发新token时使用的key
+func SetSigningKey(kid string) error {
+	ring.lock.Lock()
+	defer ring.lock.Unlock()
+	key, ok := ring.keys[kid]
+	if !ok {
+		return errors.New("unknown jwt key id: " + kid)
+	}
+	if key.signKey == nil {
+		return errors.New("jwt key can not be used to sign, kid is: " + kid)
+	}
+	ring.current = kid
+	return nil
+}
+
+// RemoveKey 从密钥环中移除key,移除后,这个key签发的token将无法通过校验
+func RemoveKey(kid string) {
+	ring.lock.Lock()
+	defer ring.lock.Unlock()
+	delete(ring.keys, kid)
+	if ring.current == kid {
+		ring.current = ""
+	}
+	if ring.oidc == kid {
+		ring.oidc = ""
+	}
+}
+
+// LoadKeys 从配置文件中读取密钥环信息
+func LoadKeys(file string) error {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		return err
+	}
+
+	kids, err := conf.Get("Hauth.jwt.keys")
+	if err != nil {
+		return err
+	}
+
+	for _, kid := range strings.Split(kids, ",") {
+		kid = strings.TrimSpace(kid)
+		if kid == "" {
+			continue
+		}
+		err = loadKey(conf, kid)
+		if err != nil {
+			return err
+		}
+	}
+
+	if kid, err := conf.Get("Hauth.jwt.oidc.kid"); err == nil {
+		if err = SetOidcKey(kid); err != nil {
+			return err
+		}
+	}
+
+	kid, err := conf.Get("Hauth.jwt.signing.kid")
+	if err != nil {
+		return err
+	}
+	return SetSigningKey(kid)
+}
+
+type configGetter interface {
+	Get(key string) (string, error)
+}
+
+func loadKey(conf configGetter, kid string) error {
+	prefix := "Hauth.jwt.key." + kid + "."
+	alg, err := conf.Get(prefix + "alg")
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case strings.HasPrefix(alg, "HS"):
+		secret, err := conf.Get(prefix + "secret")
+		if err != nil {
+			return err
+		}
+		return AddKey(kid, alg, []byte(secret), []byte(secret))
+
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "ES"):
+		var signKey, verifyKey interface{}
+
+		// 私钥可以不配置,表示这个key只用于校验
+		if file, err := conf.Get(prefix + "private"); err == nil {
+			pem, err := readKeyFile(file)
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(alg, "RS") {
+				signKey, err = jwt.ParseRSAPrivateKeyFromPEM(pem)
+			} else {
+				signKey, err = jwt.ParseECPrivateKeyFromPEM(pem)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		file, err := conf.Get(prefix + "public")
+		if err != nil {
+			return err
+		}
+		pem, err := readKeyFile(file)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(alg, "RS") {
+			verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(pem)
+		} else {
+			verifyKey, err = jwt.ParseECPublicKeyFromPEM(pem)
+		}
+		if err != nil {
+			return err
+		}
+		return AddKey(kid, alg, signKey, verifyKey)
+
+	default:
+		return errors.New("unsupported jwt signing method: " + alg)
+	}
+}
+
+// 相对路径以HBIGDATA_HOME为根目录
+func readKeyFile(file string) ([]byte, error) {
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(os.Getenv("HBIGDATA_HOME"), file)
+	}
+	return ioutil.ReadFile(file)
+}
+
+// 没有配置密钥时,生成一个随机的HS256密钥,
+// 这个密钥只在当前进程内有效,重启服务后,所有的token都将失效.
+func genRandomKey() {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		panic("generate random jwt key failed." + err.Error())
+	}
+	AddKey("random", "HS256", secret, secret)
+	SetSigningKey("random")
+}
+
+// 判断app.conf中是否配置了Hauth.jwt.keys
+func keysConfigured(file string) bool {
+	conf, err := config.GetConfig(file)
+	if err != nil {
+		return false
+	}
+	kids, err := conf.Get("Hauth.jwt.keys")
+	return err == nil && strings.Trim(kids, ", ") != ""
+}
+
+// 只有没有配置密钥时才使用随机密钥,配置了密钥但是读取失败时,服务不能启动,
+// 否则配置错误很难被发现,并且各个实例使用不同的随机密钥,签发的token互相不能校验.
+func init() {
+	file := filepath.Join(os.Getenv("HBIGDATA_HOME"), "conf", "app.conf")
+	if !keysConfigured(file) {
+		logs.Warn("jwt keys are not configured in app.conf, use random key instead of.")
+		genRandomKey()
+		return
+	}
+	if err := LoadKeys(file); err != nil {
+		panic("load jwt keys from app.conf failed." + err.Error())
+	}
+}
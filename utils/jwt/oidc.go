@@ -0,0 +1,187 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/hzwy23/hauth/utils/logs"
+	"github.com/hzwy23/hauth/utils/uuid"
+)
+
+// OpenID Connect token签名
+// 第三方应用通过JWKS获取公钥校验token,所以只能使用RS或者ES系列的非对称算法签名.
+// 优先使用Hauth.jwt.oidc.kid指定的key,没有配置时,如果当前签名key是非对称算法,直接使用当前签名key;
+// 都没有时,生成一个只在当前进程内有效的RSA key,多实例部署时必须配置非对称算法的key.
+const randomOidcKid = "oidc-random"
+
+// JWK 公钥信息,RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// IdClaims ID token中的用户信息
+// 除了标准字段外,与JwtClaims一样带有用户,域与机构信息
+type IdClaims struct {
+	*jwt.StandardClaims
+	Nonce     string `json:"nonce,omitempty"`
+	AuthTime  int64  `json:"auth_time,omitempty"`
+	UserId    string
+	DomainId  string
+	OrgUnitId string
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+func isAsymmetric(key *signingKey) bool {
+	switch key.method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		return true
+	}
+	return false
+}
+
+// SetOidcKey 设置签发OpenID Connect token使用的key
+func SetOidcKey(kid string) error {
+	ring.lock.Lock()
+	defer ring.lock.Unlock()
+	key, ok := ring.keys[kid]
+	if !ok {
+		return errors.New("unknown jwt key id: " + kid)
+	}
+	if key.signKey == nil || !isAsymmetric(key) {
+		return errors.New("oidc key must be RS or ES key with private key, kid is: " + kid)
+	}
+	ring.oidc = kid
+	return nil
+}
+
+func (r *keyRing) oidcSigning() (*signingKey, error) {
+	r.lock.RLock()
+	if key, ok := r.keys[r.oidc]; ok {
+		r.lock.RUnlock()
+		return key, nil
+	}
+	if key, ok := r.keys[r.current]; ok && isAsymmetric(key) {
+		r.lock.RUnlock()
+		return key, nil
+	}
+	r.lock.RUnlock()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if key, ok := r.keys[r.oidc]; ok {
+		return key, nil
+	}
+	logs.Warn("oidc signing key is not configured, generate random RSA key instead of.")
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	key := &signingKey{
+		kid:       randomOidcKid,
+		method:    jwt.SigningMethodRS256,
+		signKey:   priv,
+		verifyKey: &priv.PublicKey,
+	}
+	r.keys[key.kid] = key
+	r.oidc = key.kid
+	return key, nil
+}
+
+func signOidc(claims jwt.Claims) (string, error) {
+	key, err := ring.oidcSigning()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.signKey)
+}
+
+// GenClientToken 签发给第三方应用的访问token
+// aud是应用编码,这个token不能用来访问本系统
+func GenClientToken(issuer, aud, user_id, domain_id, org_id, scope string, dt int64) (string, error) {
+	claims := newClaims(user_id, domain_id, org_id, dt)
+	claims.Issuer = issuer
+	claims.Audience = aud
+	claims.Subject = user_id
+	claims.Scope = scope
+	return signOidc(claims)
+}
+
+// GenIdToken 签发ID token, aud是应用编码, sub是claims中的用户
+func GenIdToken(issuer, aud string, claims IdClaims, dt int64) (string, error) {
+	now := time.Now().Unix()
+	claims.StandardClaims = &jwt.StandardClaims{
+		Id:        uuid.GenUUID(),
+		IssuedAt:  now,
+		ExpiresAt: now + dt,
+		Issuer:    issuer,
+		Audience:  aud,
+		Subject:   claims.UserId,
+	}
+	return signOidc(claims)
+}
+
+// PublicKeys 返回密钥环中所有非对称算法的公钥,对称算法的密钥不能公开
+func PublicKeys() JWKSet {
+	// 第一次访问时,确保已经有签名使用的key
+	ring.oidcSigning()
+
+	ring.lock.RLock()
+	defer ring.lock.RUnlock()
+
+	rst := JWKSet{Keys: []JWK{}}
+	for kid, key := range ring.keys {
+		k := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			k.Kty = "RSA"
+			k.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			k.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			k.Kty = "EC"
+			k.Crv = pub.Curve.Params().Name
+			k.X = base64.RawURLEncoding.EncodeToString(padBytes(pub.X.Bytes(), size))
+			k.Y = base64.RawURLEncoding.EncodeToString(padBytes(pub.Y.Bytes(), size))
+		default:
+			continue
+		}
+		rst.Keys = append(rst.Keys, k)
+	}
+	sort.Slice(rst.Keys, func(i, j int) bool {
+		return strings.Compare(rst.Keys[i].Kid, rst.Keys[j].Kid) < 0
+	})
+	return rst
+}
+
+// EC公钥的坐标长度固定,不足时在前面补0
+func padBytes(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	rst := make([]byte, size)
+	copy(rst[size-len(b):], b)
+	return rst
+}
@@ -0,0 +1,51 @@
+package jwt
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type claimsKey struct{}
+
+// ErrNoToken 请求中既没有Authorization头部,也没有Authorization cookie
+type ErrNoToken struct{}
+
+func (ErrNoToken) Error() string {
+	return "no token found in request header or cookie."
+}
+
+// GetToken 从请求中读取token
+// 优先读取头部中的 Authorization: Bearer <jwt>, 没有时再读取名为Authorization的cookie,
+// 浏览器通过cookie传递token, 其他客户端可以通过头部传递token.
+func GetToken(r *http.Request) (string, error) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+			return strings.TrimSpace(auth[7:]), nil
+		}
+	}
+	cookie, err := r.Cookie("Authorization")
+	if err != nil || cookie.Value == "" {
+		return "", ErrNoToken{}
+	}
+	return cookie.Value, nil
+}
+
+// GetJwtClaims 从请求中读取token,并解析出用户信息
+// 请求中没有token时,返回ErrNoToken. 已经通过WithClaims认证的请求,直接返回认证时的用户信息.
+func GetJwtClaims(r *http.Request) (*JwtClaims, error) {
+	if jclaim, ok := r.Context().Value(claimsKey{}).(*JwtClaims); ok {
+		return jclaim, nil
+	}
+	token, err := GetToken(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJwt(token)
+}
+
+// WithClaims 把使用其他方式认证的用户信息保存到请求中,例如API key,
+// 之后通过GetJwtClaims读取用户信息时,不再解析请求中的token
+func WithClaims(r *http.Request, jclaim *JwtClaims) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), claimsKey{}, jclaim))
+}
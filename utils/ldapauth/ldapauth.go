@@ -0,0 +1,258 @@
+// LDAP/Active Directory 用户认证
+// 使用用户的账号和密码绑定(bind)目录服务,绑定成功表示密码正确,
+// 同时读取用户的姓名,邮箱,电话,所属部门与所属组等属性.
+//
+// 配置了BindDN时,先使用服务账号绑定,按照UserFilter查找用户的DN,再使用用户的DN与密码绑定;
+// 没有配置BindDN时,使用UserDN模板直接拼接出用户的DN,例如:
+// OpenLDAP   uid=%s,ou=people,dc=example,dc=com
+// AD         %s@example.com
+package ldapauth
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+var (
+	// 目录服务中没有这个用户
+	ErrUserNotFound = errors.New("user is not found in directory.")
+	// 用户密码错误,或者用户在目录服务中被禁用
+	ErrInvalidCredentials = errors.New("invalid directory credentials.")
+)
+
+type Config struct {
+	// 目录服务地址, ldap://host:389 或者 ldaps://host:636
+	Url                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+
+	// 查找用户使用的服务账号,为空时使用UserDN模板
+	BindDN       string
+	BindPassword string
+
+	BaseDN     string
+	UserFilter string
+	UserDN     string
+
+	// 用户属性映射,属性为空时不读取
+	AttrName   string
+	AttrEmail  string
+	AttrPhone  string
+	AttrOrg    string
+	AttrGroups string
+
+	// 通过查询组获取用户所属的组,例如 (member=%s), %s替换为用户的DN
+	GroupBaseDN string
+	GroupFilter string
+}
+
+// Entry 目录服务中的用户信息
+// Groups中保存用户所属组的DN
+type Entry struct {
+	DN     string
+	Name   string
+	Email  string
+	Phone  string
+	Org    string
+	Groups []string
+}
+
+type Client struct {
+	conf Config
+}
+
+func NewClient(conf Config) *Client {
+	if conf.UserFilter == "" {
+		conf.UserFilter = "(uid=%s)"
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = 5 * time.Second
+	}
+	return &Client{conf: conf}
+}
+
+// Authenticate 使用用户账号与密码绑定目录服务,成功后返回用户信息
+func (c *Client) Authenticate(user_id, passwd string) (*Entry, error) {
+	// 密码为空时,LDAP服务会把绑定当成匿名绑定并返回成功
+	if user_id == "" || passwd == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var dn string
+	if c.conf.BindDN != "" {
+		if err = conn.Bind(c.conf.BindDN, c.conf.BindPassword); err != nil {
+			return nil, fmt.Errorf("bind service account failed: %v", err)
+		}
+		entry, err := c.searchUser(conn, user_id)
+		if err != nil {
+			return nil, err
+		}
+		dn = entry.DN
+	} else {
+		dn = fmt.Sprintf(c.conf.UserDN, escapeDN(user_id))
+	}
+
+	// 没有服务账号时,无法区分用户不存在与密码错误,都返回ErrInvalidCredentials
+	if err = conn.Bind(dn, passwd); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	// 使用用户自己的身份读取属性
+	entry, err := c.searchUser(conn, user_id)
+	if err != nil {
+		return nil, err
+	}
+	return c.toEntry(conn, entry)
+}
+
+func (c *Client) dial() (*ldap.Conn, error) {
+	tlsConf := &tls.Config{InsecureSkipVerify: c.conf.InsecureSkipVerify}
+	conn, err := ldap.DialURL(c.conf.Url, ldap.DialWithTLSConfig(tlsConf))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(c.conf.Timeout)
+	if c.conf.StartTLS {
+		if err = conn.StartTLS(tlsConf); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func (c *Client) attributes() []string {
+	var rst []string
+	for _, attr := range []string{c.conf.AttrName, c.conf.AttrEmail, c.conf.AttrPhone, c.conf.AttrOrg, c.conf.AttrGroups} {
+		if attr != "" {
+			rst = append(rst, attr)
+		}
+	}
+	return rst
+}
+
+func (c *Client) searchUser(conn *ldap.Conn, user_id string) (*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(c.conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf(c.conf.UserFilter, ldap.EscapeFilter(user_id)), c.attributes(), nil)
+	rst, err := conn.Search(req)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if len(rst.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	if len(rst.Entries) > 1 {
+		return nil, fmt.Errorf("more than one entry match user %s", user_id)
+	}
+	return rst.Entries[0], nil
+}
+
+func (c *Client) toEntry(conn *ldap.Conn, e *ldap.Entry) (*Entry, error) {
+	rst := &Entry{DN: e.DN}
+	if c.conf.AttrName != "" {
+		rst.Name = e.GetAttributeValue(c.conf.AttrName)
+	}
+	if c.conf.AttrEmail != "" {
+		rst.Email = e.GetAttributeValue(c.conf.AttrEmail)
+	}
+	if c.conf.AttrPhone != "" {
+		rst.Phone = e.GetAttributeValue(c.conf.AttrPhone)
+	}
+	if c.conf.AttrOrg != "" {
+		rst.Org = e.GetAttributeValue(c.conf.AttrOrg)
+	}
+
+	var groups []string
+	if c.conf.AttrGroups != "" {
+		groups = append(groups, e.GetAttributeValues(c.conf.AttrGroups)...)
+	}
+	if c.conf.GroupFilter != "" {
+		base := c.conf.GroupBaseDN
+		if base == "" {
+			base = c.conf.BaseDN
+		}
+		req := ldap.NewSearchRequest(base, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+			fmt.Sprintf(c.conf.GroupFilter, ldap.EscapeFilter(e.DN)), []string{"1.1"}, nil)
+		sr, err := conn.Search(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range sr.Entries {
+			groups = append(groups, g.DN)
+		}
+	}
+
+	rst.Groups = groups
+	return rst, nil
+}
+
+// 转义DN中的特殊字符,RFC 4514
+func escapeDN(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case strings.IndexByte(",+\"\\<>;=", c) >= 0,
+			c == '#' && i == 0,
+			c == ' ' && (i == 0 || i == len(s)-1):
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// NormalizeDN 返回DN的规范形式,属性名与属性值转换为小写,去掉多余的空格与转义,
+// 多值RDN中的属性按照属性名排序. DN格式不正确时返回空字符串.
+func NormalizeDN(dn string) string {
+	d, err := ldap.ParseDN(dn)
+	if err != nil || len(d.RDNs) == 0 {
+		return ""
+	}
+	rdns := make([]string, 0, len(d.RDNs))
+	for _, rdn := range d.RDNs {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, a := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(a.Type)+"="+escapeDN(strings.ToLower(a.Value)))
+		}
+		sort.Strings(attrs)
+		rdns = append(rdns, strings.Join(attrs, "+"))
+	}
+	return strings.Join(rdns, ",")
+}
+
+// HasGroup 判断用户是否属于group, group必须是组的完整DN,
+// 按照规范形式比较,不区分大小写. 只有组名相同,但是位于其他目录中的组不匹配.
+func (e *Entry) HasGroup(group string) bool {
+	dn := NormalizeDN(group)
+	if dn == "" {
+		return false
+	}
+	for _, g := range e.Groups {
+		if NormalizeDN(g) == dn {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package ldapauth
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// 测试使用的目录服务,只实现了绑定,查询与解绑,查询条件只支持(attr=value)
+type testEntry struct {
+	passwd string
+	attrs  map[string][]string
+}
+
+var testDirectory = map[string]testEntry{
+	"cn=svc,dc=example,dc=com": {passwd: "svcpass"},
+	"uid=jdoe,ou=people,dc=example,dc=com": {
+		passwd: "secret",
+		attrs: map[string][]string{
+			"uid":        {"jdoe"},
+			"cn":         {"John Doe"},
+			"mail":       {"jdoe@example.com"},
+			"department": {"sales"},
+			"memberOf":   {"cn=admins,ou=groups,dc=example,dc=com"},
+		},
+	},
+	"cn=devs,ou=groups,dc=example,dc=com": {
+		attrs: map[string][]string{
+			"member": {"uid=jdoe,ou=people,dc=example,dc=com"},
+		},
+	},
+}
+
+func startTestServer(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestConn(conn)
+		}
+	}()
+	return "ldap://" + ln.Addr().String()
+}
+
+func serveTestConn(conn net.Conn) {
+	defer conn.Close()
+	bound := false
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		id := req.Children[0].Value.(int64)
+		op := req.Children[1]
+		switch op.Tag {
+		case 0: // bind
+			dn := op.Children[1].Value.(string)
+			passwd := op.Children[2].Data.String()
+			code := 49
+			if e, ok := testDirectory[dn]; ok && e.passwd != "" && e.passwd == passwd {
+				code = 0
+				bound = true
+			}
+			writeTestResult(conn, id, 1, code)
+		case 2: // unbind
+			return
+		case 3: // search
+			if !bound {
+				writeTestResult(conn, id, 5, 50)
+				continue
+			}
+			base := op.Children[0].Value.(string)
+			filter := op.Children[6]
+			attr := filter.Children[0].Value.(string)
+			val := filter.Children[1].Value.(string)
+			for dn, e := range testDirectory {
+				if !strings.HasSuffix(dn, base) || !hasTestValue(e.attrs[attr], val) {
+					continue
+				}
+				writeTestEntry(conn, id, dn, e.attrs)
+			}
+			writeTestResult(conn, id, 5, 0)
+		default:
+			return
+		}
+	}
+}
+
+func hasTestValue(vals []string, val string) bool {
+	for _, v := range vals {
+		if strings.EqualFold(v, val) {
+			return true
+		}
+	}
+	return false
+}
+
+func testEnvelope(id int64, op *ber.Packet) []byte {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, ""))
+	p.AppendChild(op)
+	return p.Bytes()
+}
+
+func writeTestResult(conn net.Conn, id int64, tag ber.Tag, code int) {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	conn.Write(testEnvelope(id, op))
+}
+
+func writeTestEntry(conn net.Conn, id int64, dn string, attrs map[string][]string) {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, 4, nil, "")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, dn, ""))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	for name, vals := range attrs {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, ""))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+		for _, v := range vals {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, ""))
+		}
+		attr.AppendChild(set)
+		list.AppendChild(attr)
+	}
+	op.AppendChild(list)
+	conn.Write(testEnvelope(id, op))
+}
+
+func testConfig(url string) Config {
+	return Config{
+		Url:          url,
+		BindDN:       "cn=svc,dc=example,dc=com",
+		BindPassword: "svcpass",
+		BaseDN:       "dc=example,dc=com",
+		UserFilter:   "(uid=%s)",
+		AttrName:     "cn",
+		AttrEmail:    "mail",
+		AttrOrg:      "department",
+		AttrGroups:   "memberOf",
+		GroupBaseDN:  "ou=groups,dc=example,dc=com",
+		GroupFilter:  "(member=%s)",
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	c := NewClient(testConfig(startTestServer(t)))
+
+	e, err := c.Authenticate("jdoe", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.DN != "uid=jdoe,ou=people,dc=example,dc=com" || e.Name != "John Doe" ||
+		e.Email != "jdoe@example.com" || e.Org != "sales" {
+		t.Error("read user attributes failed.", e)
+	}
+	for _, g := range []string{"cn=admins,ou=groups,dc=example,dc=com", "CN=Admins, OU=Groups, DC=example, DC=com", "cn=devs,ou=groups,dc=example,dc=com"} {
+		if !e.HasGroup(g) {
+			t.Error("user should be member of", g, e.Groups)
+		}
+	}
+	// 只按照组名不能匹配,其他目录中的同名组也不能匹配
+	for _, g := range []string{"admins", "cn=admins,ou=contractors,dc=example,dc=com", "cn=ops,ou=groups,dc=example,dc=com"} {
+		if e.HasGroup(g) {
+			t.Error("user should not be member of", g)
+		}
+	}
+
+	if _, err := c.Authenticate("jdoe", "wrong"); err != ErrInvalidCredentials {
+		t.Error("wrong password should be rejected.", err)
+	}
+	// 空密码在目录服务中是匿名绑定,必须在客户端拒绝
+	if _, err := c.Authenticate("jdoe", ""); err != ErrInvalidCredentials {
+		t.Error("empty password should be rejected.", err)
+	}
+	if _, err := c.Authenticate("nobody", "secret"); err != ErrUserNotFound {
+		t.Error("unknown user should return ErrUserNotFound.", err)
+	}
+	if _, err := c.Authenticate("jdoe)(uid=*", "secret"); err != ErrUserNotFound {
+		t.Error("filter injection should not match any user.", err)
+	}
+}
+
+func TestAuthenticateUserDN(t *testing.T) {
+	conf := testConfig(startTestServer(t))
+	conf.BindDN, conf.BindPassword = "", ""
+	conf.UserDN = "uid=%s,ou=people,dc=example,dc=com"
+	c := NewClient(conf)
+
+	e, err := c.Authenticate("jdoe", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Name != "John Doe" || !e.HasGroup("cn=admins,ou=groups,dc=example,dc=com") {
+		t.Error("read user attributes failed.", e)
+	}
+	if _, err := c.Authenticate("nobody", "secret"); err != ErrInvalidCredentials {
+		t.Error("unknown user should be rejected.", err)
+	}
+}
+
+func TestEscapeDN(t *testing.T) {
+	cases := map[string]string{
+		"jdoe":      "jdoe",
+		"a,b=c":     `a\,b\=c`,
+		" #lead":    `\ #lead`,
+		"#hash":     `\#hash`,
+		"trail ":    `trail\ `,
+		`x+y"z\<>;`: `x\+y\"z\\\<\>\;`,
+	}
+	for in, want := range cases {
+		if got := escapeDN(in); got != want {
+			t.Errorf("escapeDN(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
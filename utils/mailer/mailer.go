@@ -0,0 +1,200 @@
+// 邮件发送
+// Mailer 是发送邮件的接口,SmtpMailer 通过SMTP服务器发送邮件,
+// LocalMailer 不发送邮件,只把邮件保存在内存中,用于测试与没有SMTP服务器的开发环境.
+//
+// 邮件正文是纯文本,使用UTF-8编码,主题按照RFC 2047编码,正文使用base64编码.
+package mailer
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// 收件人,发件人或者主题中包含换行符,或者邮箱地址格式错误
+	ErrInvalidMessage = errors.New("invalid mail message.")
+)
+
+// Message 一封纯文本邮件
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(msg *Message) error
+}
+
+type SmtpConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// 发件人地址,为空时使用Username
+	From string
+	// 使用465端口时开启,连接建立时就使用TLS;否则服务器支持STARTTLS时升级为TLS连接
+	TLS                bool
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+}
+
+type SmtpMailer struct {
+	cfg SmtpConfig
+}
+
+func NewSmtpMailer(cfg SmtpConfig) *SmtpMailer {
+	if cfg.Port == 0 {
+		cfg.Port = 25
+	}
+	if cfg.From == "" {
+		cfg.From = cfg.Username
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &SmtpMailer{cfg: cfg}
+}
+
+func (s *SmtpMailer) Send(msg *Message) error {
+	data, err := buildMessage(s.cfg.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	tls_config := &tls.Config{ServerName: s.cfg.Host, InsecureSkipVerify: s.cfg.InsecureSkipVerify}
+	dialer := &net.Dialer{Timeout: s.cfg.Timeout}
+
+	var conn net.Conn
+	if s.cfg.TLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tls_config)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(s.cfg.Timeout))
+
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if !s.cfg.TLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err = c.StartTLS(tls_config); err != nil {
+				return err
+			}
+		}
+	}
+	if s.cfg.Username != "" {
+		if err = c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err = c.Mail(s.cfg.From); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err = c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// LocalMailer 把邮件保存在内存中,不发送
+type LocalMailer struct {
+	lock     sync.Mutex
+	messages []Message
+}
+
+func NewLocalMailer() *LocalMailer {
+	return &LocalMailer{}
+}
+
+func (l *LocalMailer) Send(msg *Message) error {
+	if _, err := buildMessage("local@localhost", msg, time.Now()); err != nil {
+		return err
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.messages = append(l.messages, Message{
+		To:      append([]string(nil), msg.To...),
+		Subject: msg.Subject,
+		Body:    msg.Body,
+	})
+	return nil
+}
+
+// Messages 返回已经发送的邮件
+func (l *LocalMailer) Messages() []Message {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return append([]Message(nil), l.messages...)
+}
+
+// 校验邮箱地址,地址中不能包含显示名称
+func checkAddress(addr string) bool {
+	if strings.ContainsAny(addr, "\r\n") {
+		return false
+	}
+	a, err := mail.ParseAddress(addr)
+	return err == nil && a.Address == addr
+}
+
+// 生成邮件内容,包括邮件头与base64编码的正文
+func buildMessage(from string, msg *Message, date time.Time) ([]byte, error) {
+	if msg == nil || len(msg.To) == 0 || !checkAddress(from) || strings.ContainsAny(msg.Subject, "\r\n") {
+		return nil, ErrInvalidMessage
+	}
+	for _, to := range msg.To {
+		if !checkAddress(to) {
+			return nil, ErrInvalidMessage
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from + "\r\n")
+	buf.WriteString("To: " + strings.Join(msg.To, ", ") + "\r\n")
+	buf.WriteString("Subject: " + mime.BEncoding.Encode("utf-8", msg.Subject) + "\r\n")
+	buf.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	// base64编码后每行不超过76个字符
+	body := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(body) > 76 {
+		buf.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	buf.WriteString(body + "\r\n")
+	return buf.Bytes(), nil
+}
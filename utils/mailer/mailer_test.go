@@ -0,0 +1,193 @@
+package mailer
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 测试使用的SMTP服务器,只接收一封邮件,不支持STARTTLS与认证
+type testSmtpServer struct {
+	ln   net.Listener
+	from string
+	rcpt []string
+	data chan string
+}
+
+func newTestSmtpServer(t *testing.T) *testSmtpServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testSmtpServer{ln: ln, data: make(chan string, 1)}
+	go s.serve()
+	return s
+}
+
+func (s *testSmtpServer) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *testSmtpServer) serve() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost test")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = strings.Trim(line[10:], "<>")
+			reply("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			s.rcpt = append(s.rcpt, strings.Trim(line[8:], "<>"))
+			reply("250 ok")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var buf []string
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				buf = append(buf, l)
+			}
+			s.data <- strings.Join(buf, "")
+			reply("250 ok")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// 从邮件内容中解码正文
+func decodeBody(t *testing.T, data string) string {
+	i := strings.Index(data, "\r\n\r\n")
+	if i < 0 {
+		t.Fatal("mail body not found:", data)
+	}
+	body, err := base64.StdEncoding.DecodeString(strings.Replace(data[i+4:], "\r\n", "", -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestSmtpMailer(t *testing.T) {
+	s := newTestSmtpServer(t)
+	defer s.ln.Close()
+
+	m := NewSmtpMailer(SmtpConfig{
+		Host:    "127.0.0.1",
+		Port:    s.port(),
+		From:    "noreply@example.com",
+		Timeout: 5 * time.Second,
+	})
+	body := strings.Repeat("重置密码链接 https://hauth.example.com/?reset_token=abc ", 5)
+	err := m.Send(&Message{To: []string{"user@example.com"}, Subject: "重置密码", Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data string
+	select {
+	case data = <-s.data:
+	case <-time.After(5 * time.Second):
+		t.Fatal("mail is not received")
+	}
+	if s.from != "noreply@example.com" || len(s.rcpt) != 1 || s.rcpt[0] != "user@example.com" {
+		t.Fatal("unexpected envelope:", s.from, s.rcpt)
+	}
+	if !strings.Contains(data, "Subject: =?utf-8?b?") {
+		t.Fatal("subject is not encoded:", data)
+	}
+	if got := decodeBody(t, data); got != body {
+		t.Fatal("unexpected body:", got)
+	}
+}
+
+func TestSmtpMailerDialError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	ln.Close()
+
+	m := NewSmtpMailer(SmtpConfig{Host: "127.0.0.1", Port: port, From: "noreply@example.com", Timeout: time.Second})
+	if err := m.Send(&Message{To: []string{"user@example.com"}, Subject: "test", Body: "test"}); err == nil {
+		t.Fatal("send to closed port should fail, port is " + strconv.Itoa(port))
+	}
+}
+
+func TestLocalMailer(t *testing.T) {
+	m := NewLocalMailer()
+	if err := m.Send(&Message{To: []string{"a@example.com"}, Subject: "s1", Body: "b1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Send(&Message{To: []string{"b@example.com"}, Subject: "s2", Body: "b2"}); err != nil {
+		t.Fatal(err)
+	}
+	list := m.Messages()
+	if len(list) != 2 || list[0].To[0] != "a@example.com" || list[1].Body != "b2" {
+		t.Fatal("unexpected messages:", list)
+	}
+}
+
+func TestBuildMessageInvalid(t *testing.T) {
+	cases := []*Message{
+		nil,
+		{Subject: "no recipient"},
+		{To: []string{"user@example.com\r\nBcc: evil@example.com"}, Subject: "s"},
+		{To: []string{"User <user@example.com>"}, Subject: "s"},
+		{To: []string{"user@example.com"}, Subject: "s\r\nBcc: evil@example.com"},
+	}
+	for i, c := range cases {
+		if _, err := buildMessage("noreply@example.com", c, time.Now()); err != ErrInvalidMessage {
+			t.Fatal("case", i, "should be invalid, got", err)
+		}
+	}
+	if _, err := buildMessage("", &Message{To: []string{"user@example.com"}}, time.Now()); err != ErrInvalidMessage {
+		t.Fatal("empty sender should be invalid")
+	}
+}
+
+func TestBuildMessageLineLength(t *testing.T) {
+	data, err := buildMessage("noreply@example.com", &Message{
+		To:      []string{"user@example.com"},
+		Subject: "test",
+		Body:    strings.Repeat("x", 1000),
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\r\n") {
+		if len(line) > 76 {
+			t.Fatal("line is too long:", line)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// OpenID Connect 依赖方(Relying Party)
+// 使用外部身份提供者(IdP)登录时,通过服务发现获取授权接口,token接口与JWKS地址,
+// 使用授权码流程与PKCE获取ID token,并使用IdP的公钥校验ID token.
+//
+// ID token只接受RS与ES系列算法的签名,不接受HS系列与none.
+package oidcclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+var (
+	// ID token签名,签发者,受众,有效期或者nonce校验失败
+	ErrInvalidIdToken = errors.New("invalid id token.")
+)
+
+type Config struct {
+	// IdP的issuer,服务发现地址为 Issuer + /.well-known/openid-configuration
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	RedirectUri  string
+	// 为空时使用 openid profile email
+	Scopes  []string
+	Timeout time.Duration
+}
+
+// Discovery IdP服务发现返回的内容
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+// IdToken 校验通过的ID token中的用户信息
+type IdToken struct {
+	Issuer        string
+	Subject       string
+	Name          string
+	Email         string
+	EmailVerified bool
+	Claims        map[string]interface{}
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IdToken     string `json:"id_token"`
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// 服务发现与公钥缓存的时间,IdP轮换密钥时,遇到未知的kid会立即重新获取公钥
+const cacheTime = 3600
+
+type Provider struct {
+	conf   Config
+	client *http.Client
+
+	lock       sync.RWMutex
+	discovery  *Discovery
+	keys       map[string]interface{}
+	fetch_time int64
+}
+
+func NewProvider(conf Config) *Provider {
+	conf.Issuer = strings.TrimRight(conf.Issuer, "/")
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{"openid", "profile", "email"}
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	return &Provider{
+		conf:   conf,
+		client: &http.Client{Timeout: conf.Timeout},
+	}
+}
+
+// RandomString 生成state,nonce与PKCE code verifier使用的随机字符串
+func RandomString() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallenge PKCE S256, BASE64URL(SHA256(code_verifier))
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL 返回跳转到IdP授权接口的地址
+func (p *Provider) AuthCodeURL(state, nonce, verifier string) (string, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.conf.ClientId)
+	query.Set("redirect_uri", p.conf.RedirectUri)
+	query.Set("scope", strings.Join(p.conf.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", CodeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// Exchange 使用授权码换取token,校验ID token后返回用户信息
+func (p *Provider) Exchange(code, verifier, nonce string) (*IdToken, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.conf.RedirectUri},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequest("POST", d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.conf.ClientId), url.QueryEscape(p.conf.ClientSecret))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var rst tokenResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&rst); err != nil {
+		return nil, fmt.Errorf("decode token response failed, status is %d: %v", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || rst.Error != "" {
+		return nil, fmt.Errorf("token request failed, status is %d: %s %s", resp.StatusCode, rst.Error, rst.Description)
+	}
+	if rst.IdToken == "" {
+		return nil, errors.New("token response has no id token.")
+	}
+	return p.Verify(rst.IdToken, nonce)
+}
+
+// Verify 校验ID token的签名,签发者,受众,有效期与nonce
+func (p *Provider) Verify(raw, nonce string) (*IdToken, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		default:
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if iss, _ := claims["iss"].(string); iss != p.conf.Issuer {
+		return nil, ErrInvalidIdToken
+	}
+	if !hasAudience(claims["aud"], p.conf.ClientId) {
+		return nil, ErrInvalidIdToken
+	}
+	// 多个受众时,azp必须是本系统
+	if azp, ok := claims["azp"].(string); ok && azp != p.conf.ClientId {
+		return nil, ErrInvalidIdToken
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, ErrInvalidIdToken
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, ErrInvalidIdToken
+	}
+
+	rst := &IdToken{Issuer: p.conf.Issuer, Claims: claims}
+	rst.Subject, _ = claims["sub"].(string)
+	if rst.Subject == "" {
+		return nil, ErrInvalidIdToken
+	}
+	rst.Name, _ = claims["name"].(string)
+	rst.Email, _ = claims["email"].(string)
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		rst.EmailVerified = v
+	case string:
+		rst.EmailVerified = v == "true"
+	}
+	return rst, nil
+}
+
+// aud可以是字符串,也可以是字符串数组
+func hasAudience(aud interface{}, client_id string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == client_id
+	case []interface{}:
+		for _, val := range v {
+			if s, ok := val.(string); ok && s == client_id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *Provider) getDiscovery() (*Discovery, error) {
+	p.lock.RLock()
+	d := p.discovery
+	p.lock.RUnlock()
+	if d != nil {
+		return d, nil
+	}
+	if err := p.refresh(); err != nil {
+		return nil, err
+	}
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.discovery, nil
+}
+
+// 查找签名使用的公钥,找不到时重新获取一次公钥
+func (p *Provider) getKey(kid string) (interface{}, error) {
+	p.lock.RLock()
+	key, ok := p.lookupKey(kid)
+	expired := time.Now().Unix()-p.fetch_time > cacheTime
+	p.lock.RUnlock()
+	if ok && !expired {
+		return key, nil
+	}
+	if err := p.refresh(); err != nil {
+		return nil, err
+	}
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if key, ok = p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id: %s", kid)
+}
+
+// kid为空时,只有一个公钥才能确定签名使用的公钥
+func (p *Provider) lookupKey(kid string) (interface{}, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// 重新获取服务发现信息与公钥
+func (p *Provider) refresh() error {
+	var d Discovery
+	if err := p.getJson(p.conf.Issuer+"/.well-known/openid-configuration", &d); err != nil {
+		return err
+	}
+	if strings.TrimRight(d.Issuer, "/") != p.conf.Issuer {
+		return fmt.Errorf("issuer mismatch, expected %s, got %s", p.conf.Issuer, d.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JwksUri == "" {
+		return errors.New("discovery document is incomplete.")
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := p.getJson(d.JwksUri, &set); err != nil {
+		return err
+	}
+	keys := make(map[string]interface{})
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.discovery = &d
+	p.keys = keys
+	p.fetch_time = time.Now().Unix()
+	return nil
+}
+
+func (p *Provider) getJson(uri string, v interface{}) error {
+	resp, err := p.client.Get(uri)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(ioutil.Discard, resp.Body)
+		return fmt.Errorf("get %s failed, status is %d", uri, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("invalid EC public key.")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %s", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, errors.New("empty key parameter.")
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
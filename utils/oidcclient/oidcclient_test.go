@@ -0,0 +1,207 @@
+package oidcclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// 测试使用的IdP,只实现服务发现,JWKS与token接口
+// 授权码就是授权请求中的nonce,token接口校验PKCE后签发ID token
+type testIdp struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	ec        *ecdsa.PrivateKey
+	useEc     bool
+	challenge map[string]string
+	claims    jwt.MapClaims
+}
+
+func newTestIdp(t *testing.T) *testIdp {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ec, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &testIdp{key: key, ec: ec, challenge: make(map[string]string)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Discovery{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JwksUri:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		enc := base64.RawURLEncoding
+		json.NewEncoder(w).Encode(map[string][]jwk{"keys": {
+			{Kty: "RSA", Kid: "rsa", Use: "sig", N: enc.EncodeToString(key.N.Bytes()),
+				E: enc.EncodeToString(big.NewInt(int64(key.E)).Bytes())},
+			{Kty: "EC", Kid: "ec", Use: "sig", Crv: "P-256", X: enc.EncodeToString(ec.X.Bytes()),
+				Y: enc.EncodeToString(ec.Y.Bytes())},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		id, secret, _ := r.BasicAuth()
+		code := r.FormValue("code")
+		if id != "hauth" || secret != "s3cret" || idp.challenge[code] != CodeChallenge(r.FormValue("code_verifier")) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		delete(idp.challenge, code)
+		json.NewEncoder(w).Encode(tokenResponse{AccessToken: "at", TokenType: "Bearer", IdToken: idp.sign(t, code)})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *testIdp) sign(t *testing.T, nonce string) string {
+	now := time.Now().Unix()
+	claims := jwt.MapClaims{
+		"iss":            idp.server.URL,
+		"sub":            "248289761001",
+		"aud":            []string{"hauth", "other"},
+		"azp":            "hauth",
+		"exp":            now + 60,
+		"iat":            now,
+		"nonce":          nonce,
+		"name":           "Jane Doe",
+		"email":          "jane@example.com",
+		"email_verified": true,
+	}
+	for key, val := range idp.claims {
+		claims[key] = val
+	}
+	var token *jwt.Token
+	var key interface{}
+	if idp.useEc {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodES256, claims), idp.ec
+		token.Header["kid"] = "ec"
+	} else {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodRS256, claims), idp.key
+		token.Header["kid"] = "rsa"
+	}
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func newTestProvider(idp *testIdp) *Provider {
+	return NewProvider(Config{
+		Issuer:       idp.server.URL + "/",
+		ClientId:     "hauth",
+		ClientSecret: "s3cret",
+		RedirectUri:  "https://hauth.example.com/login/oidc/callback",
+	})
+}
+
+// 模拟浏览器跳转到IdP,返回授权码
+func authorize(t *testing.T, idp *testIdp, p *Provider) (code, verifier string) {
+	verifier, _ = RandomString()
+	nonce, _ := RandomString()
+	uri, err := p.AuthCodeURL("xyz", nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(uri)
+	query := u.Query()
+	if u.Path != "/authorize" || query.Get("client_id") != "hauth" || query.Get("state") != "xyz" ||
+		query.Get("scope") != "openid profile email" || query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected authorize url: %s", uri)
+	}
+	idp.challenge[nonce] = query.Get("code_challenge")
+	return nonce, verifier
+}
+
+func TestExchange(t *testing.T) {
+	idp := newTestIdp(t)
+	p := newTestProvider(idp)
+
+	for _, useEc := range []bool{false, true} {
+		idp.useEc = useEc
+		code, verifier := authorize(t, idp, p)
+		rst, err := p.Exchange(code, verifier, code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rst.Subject != "248289761001" || rst.Email != "jane@example.com" || !rst.EmailVerified || rst.Name != "Jane Doe" {
+			t.Fatalf("unexpected id token: %+v", rst)
+		}
+	}
+}
+
+func TestExchangeRejected(t *testing.T) {
+	idp := newTestIdp(t)
+	p := newTestProvider(idp)
+
+	// PKCE校验失败
+	code, _ := authorize(t, idp, p)
+	if _, err := p.Exchange(code, "wrong-verifier", code); err == nil {
+		t.Fatal("exchange with wrong verifier should fail")
+	}
+
+	// nonce不一致
+	code, verifier := authorize(t, idp, p)
+	if _, err := p.Exchange(code, verifier, "other"); err != ErrInvalidIdToken {
+		t.Fatalf("expected invalid id token, got %v", err)
+	}
+
+	cases := []jwt.MapClaims{
+		{"iss": "https://evil.example.com"},
+		{"aud": "other"},
+		{"azp": "other"},
+		{"exp": time.Now().Unix() - 10},
+		{"sub": ""},
+	}
+	for _, c := range cases {
+		idp.claims = c
+		if _, err := p.Verify(idp.sign(t, "n"), "n"); err == nil {
+			t.Fatalf("id token with %v should be rejected", c)
+		}
+	}
+	idp.claims = nil
+	if _, err := p.Verify(idp.sign(t, "n"), "n"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifyRejectsHmac(t *testing.T) {
+	idp := newTestIdp(t)
+	p := newTestProvider(idp)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": idp.server.URL, "sub": "1", "aud": "hauth", "exp": time.Now().Unix() + 60, "nonce": "n",
+	})
+	raw, _ := token.SignedString([]byte("s3cret"))
+	if _, err := p.Verify(raw, "n"); err == nil {
+		t.Fatal("HS256 id token should be rejected")
+	}
+
+	parts := strings.Split(idp.sign(t, "n"), ".")
+	parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"x"}`))
+	if _, err := p.Verify(strings.Join(parts, "."), "n"); err == nil {
+		t.Fatal("tampered id token should be rejected")
+	}
+}
@@ -0,0 +1,247 @@
+// API路由匹配
+// API资源声明HTTP方法集合与路径模式,访问API时按照请求的方法与路径匹配.
+//
+// 方法集合是逗号分隔的HTTP方法,例如 GET,POST,为空或者*时匹配所有方法.
+// 路径模式按照 / 分段匹配:
+//
+//	{name}  匹配任意一个非空的段,例如 /v1/auth/user/{user_id}
+//	*       与{name}相同
+//	**      匹配剩余的零个或者多个段,只能出现在最后,例如 /v1/static/**
+//
+// 其他的段必须与请求路径完全相同,没有占位符与通配符的模式就是原来的精确匹配.
+//
+// 编译后的模式缓存在包中,相同的方法集合与路径模式只编译一次.
+package routematch
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrInvalidPattern = errors.New("invalid route pattern.")
+	ErrInvalidMethod  = errors.New("invalid http method.")
+)
+
+// 缓存的模式超过这个数量后清空缓存
+const maxCacheSize = 4096
+
+var cache = struct {
+	lock sync.RWMutex
+	list map[string]*Pattern
+}{list: make(map[string]*Pattern)}
+
+type segment struct {
+	value string
+	// 占位符或者*,匹配任意一个非空的段
+	any bool
+}
+
+// Pattern 编译后的方法集合与路径模式
+type Pattern struct {
+	raw string
+	// 为nil时匹配所有方法
+	methods map[string]bool
+	segs    []segment
+	// 以**结尾
+	tail bool
+	// 没有占位符与通配符
+	literal bool
+}
+
+// Compile 编译方法集合与路径模式,编译结果会被缓存
+func Compile(methods, pattern string) (*Pattern, error) {
+	key := methods + " " + pattern
+	cache.lock.RLock()
+	p, ok := cache.list[key]
+	cache.lock.RUnlock()
+	if ok {
+		return p, nil
+	}
+
+	p, err := compile(methods, pattern)
+	if err != nil {
+		return nil, err
+	}
+	cache.lock.Lock()
+	if len(cache.list) >= maxCacheSize {
+		cache.list = make(map[string]*Pattern)
+	}
+	cache.list[key] = p
+	cache.lock.Unlock()
+	return p, nil
+}
+
+func compile(methods, pattern string) (*Pattern, error) {
+	if !strings.HasPrefix(pattern, "/") {
+		return nil, ErrInvalidPattern
+	}
+	p := &Pattern{raw: pattern, literal: true}
+
+	for _, m := range strings.Split(methods, ",") {
+		m = strings.ToUpper(strings.TrimSpace(m))
+		if m == "" {
+			continue
+		}
+		if m == "*" {
+			p.methods = nil
+			break
+		}
+		for _, c := range m {
+			if c < 'A' || c > 'Z' {
+				return nil, ErrInvalidMethod
+			}
+		}
+		if p.methods == nil {
+			p.methods = make(map[string]bool)
+		}
+		p.methods[m] = true
+	}
+
+	parts := strings.Split(pattern[1:], "/")
+	for i, part := range parts {
+		switch {
+		case part == "**":
+			if i != len(parts)-1 {
+				return nil, ErrInvalidPattern
+			}
+			p.tail = true
+			p.literal = false
+		case part == "*":
+			p.segs = append(p.segs, segment{any: true})
+			p.literal = false
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			name := part[1 : len(part)-1]
+			if name == "" || strings.ContainsAny(name, "{}*") {
+				return nil, ErrInvalidPattern
+			}
+			p.segs = append(p.segs, segment{any: true})
+			p.literal = false
+		case strings.ContainsAny(part, "{}*"):
+			return nil, ErrInvalidPattern
+		default:
+			p.segs = append(p.segs, segment{value: part})
+		}
+	}
+	return p, nil
+}
+
+// String 返回路径模式
+func (p *Pattern) String() string {
+	return p.raw
+}
+
+// AllowMethod 判断方法集合中是否包含method
+func (p *Pattern) AllowMethod(method string) bool {
+	return p.methods == nil || p.methods[strings.ToUpper(method)]
+}
+
+// Match 判断请求的方法与路径是否与模式匹配
+func (p *Pattern) Match(method, path string) bool {
+	if !p.AllowMethod(method) {
+		return false
+	}
+	if p.literal {
+		return path == p.raw
+	}
+	return p.matchPath(path)
+}
+
+func (p *Pattern) matchPath(path string) bool {
+	if !strings.HasPrefix(path, "/") {
+		return false
+	}
+	parts := strings.Split(path[1:], "/")
+	if len(parts) < len(p.segs) || (!p.tail && len(parts) != len(p.segs)) {
+		return false
+	}
+	for i, seg := range p.segs {
+		if seg.any {
+			if parts[i] == "" {
+				return false
+			}
+		} else if parts[i] != seg.value {
+			return false
+		}
+	}
+	return true
+}
+
+type entry struct {
+	key     string
+	pattern *Pattern
+}
+
+// Table 一组路由规则,每个规则带有一个标识,例如资源编码.
+// 精确匹配的规则按照路径保存在map中,其他规则按照添加的顺序逐个匹配.
+// Table 创建完成后只读,可以在多个goroutine中同时使用.
+type Table struct {
+	exact    map[string][]entry
+	patterns []entry
+	size     int
+}
+
+func NewTable() *Table {
+	return &Table{exact: make(map[string][]entry)}
+}
+
+// Add 添加规则,方法集合或者路径模式错误时返回错误
+func (t *Table) Add(key, methods, pattern string) error {
+	p, err := Compile(methods, pattern)
+	if err != nil {
+		return err
+	}
+	e := entry{key: key, pattern: p}
+	if p.literal {
+		t.exact[pattern] = append(t.exact[pattern], e)
+	} else {
+		t.patterns = append(t.patterns, e)
+	}
+	t.size++
+	return nil
+}
+
+// Len 返回规则的数量
+func (t *Table) Len() int {
+	return t.size
+}
+
+// Allow 判断是否有规则与请求的方法与路径匹配
+func (t *Table) Allow(method, path string) bool {
+	for _, e := range t.exact[path] {
+		if e.pattern.AllowMethod(method) {
+			return true
+		}
+	}
+	for _, e := range t.patterns {
+		if e.pattern.Match(method, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Match 返回与请求的方法与路径匹配的规则的标识,相同的标识只返回一次
+func (t *Table) Match(method, path string) []string {
+	var rst []string
+	add := func(key string) {
+		for _, k := range rst {
+			if k == key {
+				return
+			}
+		}
+		rst = append(rst, key)
+	}
+	for _, e := range t.exact[path] {
+		if e.pattern.AllowMethod(method) {
+			add(e.key)
+		}
+	}
+	for _, e := range t.patterns {
+		if e.pattern.Match(method, path) {
+			add(e.key)
+		}
+	}
+	return rst
+}
@@ -0,0 +1,120 @@
+package routematch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompileInvalid(t *testing.T) {
+	cases := []struct {
+		methods string
+		pattern string
+		err     error
+	}{
+		{"", "v1/auth/user", ErrInvalidPattern},
+		{"", "/v1/**/user", ErrInvalidPattern},
+		{"", "/v1/user/{}", ErrInvalidPattern},
+		{"", "/v1/user/{id", ErrInvalidPattern},
+		{"", "/v1/user/id*", ErrInvalidPattern},
+		{"GET,PO ST", "/v1/user", ErrInvalidMethod},
+		{"GET;POST", "/v1/user", ErrInvalidMethod},
+	}
+	for _, c := range cases {
+		if _, err := Compile(c.methods, c.pattern); err != c.err {
+			t.Errorf("Compile(%q, %q) error is %v, want %v", c.methods, c.pattern, err, c.err)
+		}
+	}
+}
+
+func TestPatternMatch(t *testing.T) {
+	cases := []struct {
+		methods string
+		pattern string
+		method  string
+		path    string
+		want    bool
+	}{
+		{"", "/v1/auth/user/get", "GET", "/v1/auth/user/get", true},
+		{"", "/v1/auth/user/get", "DELETE", "/v1/auth/user/get", true},
+		{"", "/v1/auth/user/get", "GET", "/v1/auth/user/get/", false},
+		{"*", "/v1/auth/user/get", "PUT", "/v1/auth/user/get", true},
+		{"GET", "/v1/auth/user/get", "POST", "/v1/auth/user/get", false},
+		{"get, post", "/v1/auth/user/get", "POST", "/v1/auth/user/get", true},
+		{"GET", "/v1/auth/user/{user_id}", "GET", "/v1/auth/user/admin", true},
+		{"GET", "/v1/auth/user/{user_id}", "DELETE", "/v1/auth/user/admin", false},
+		{"GET", "/v1/auth/user/{user_id}", "GET", "/v1/auth/user/", false},
+		{"GET", "/v1/auth/user/{user_id}", "GET", "/v1/auth/user/admin/roles", false},
+		{"", "/v1/auth/*/roles", "GET", "/v1/auth/user/roles", true},
+		{"", "/v1/auth/*/roles", "GET", "/v1/auth/role/user", false},
+		{"", "/v1/static/**", "GET", "/v1/static", true},
+		{"", "/v1/static/**", "GET", "/v1/static/js/utils.js", true},
+		{"", "/v1/static/**", "GET", "/v1/staticfiles", false},
+		{"", "/v1/{id}/**", "GET", "/v1/a/b/c", true},
+		{"", "/v1/{id}/**", "GET", "/v1", false},
+	}
+	for _, c := range cases {
+		p, err := Compile(c.methods, c.pattern)
+		if err != nil {
+			t.Fatalf("Compile(%q, %q) failed: %v", c.methods, c.pattern, err)
+		}
+		if got := p.Match(c.method, c.path); got != c.want {
+			t.Errorf("%q %q match %s %s = %v, want %v", c.methods, c.pattern, c.method, c.path, got, c.want)
+		}
+	}
+}
+
+func TestCompileCache(t *testing.T) {
+	p1, err := Compile("GET", "/v1/cache/{id}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, _ := Compile("GET", "/v1/cache/{id}")
+	if p1 != p2 {
+		t.Error("compiled pattern is not cached")
+	}
+	p3, _ := Compile("POST", "/v1/cache/{id}")
+	if p1 == p3 {
+		t.Error("patterns with different methods share cache entry")
+	}
+}
+
+func TestTable(t *testing.T) {
+	tbl := NewTable()
+	rules := []struct{ key, methods, pattern string }{
+		{"0101", "", "/v1/auth/user/get"},
+		{"0102", "GET", "/v1/auth/user/{user_id}"},
+		{"0103", "DELETE", "/v1/auth/user/{user_id}"},
+		{"0104", "", "/v1/auth/**"},
+		{"0101", "", "/v1/auth/user/get"},
+	}
+	for _, r := range rules {
+		if err := tbl.Add(r.key, r.methods, r.pattern); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tbl.Add("0105", "", "/v1/**/x"); err != ErrInvalidPattern {
+		t.Errorf("invalid pattern is added, error is %v", err)
+	}
+	if tbl.Len() != len(rules) {
+		t.Errorf("table size is %d, want %d", tbl.Len(), len(rules))
+	}
+
+	cases := []struct {
+		method string
+		path   string
+		want   []string
+	}{
+		{"GET", "/v1/auth/user/get", []string{"0101", "0102", "0104"}},
+		{"GET", "/v1/auth/user/admin", []string{"0102", "0104"}},
+		{"DELETE", "/v1/auth/user/admin", []string{"0103", "0104"}},
+		{"GET", "/v1/help/system/help", nil},
+	}
+	for _, c := range cases {
+		if got := tbl.Match(c.method, c.path); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Match(%s %s) = %v, want %v", c.method, c.path, got, c.want)
+		}
+		if got := tbl.Allow(c.method, c.path); got != (len(c.want) > 0) {
+			t.Errorf("Allow(%s %s) = %v", c.method, c.path, got)
+		}
+	}
+}
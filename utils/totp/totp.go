@@ -0,0 +1,97 @@
+// RFC 6238 TOTP 一次性密码
+// 使用HMAC-SHA1算法,30秒一个时间窗口,6位数字,与常见的身份验证器应用兼容.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// 时间窗口,单位:秒
+	Period = 30
+	// 一次性密码位数
+	Digits = 6
+	// 校验时允许前后偏差的时间窗口个数,用于容忍客户端与服务端的时间误差
+	Skew = 1
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenSecret 生成一个160位的随机密钥,返回base32编码
+func GenSecret() (string, error) {
+	buf := make([]byte, 20)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	return b32.EncodeToString(buf), nil
+}
+
+// ProvisioningURI 返回身份验证器应用扫描的二维码内容
+// 格式为 otpauth://totp/issuer:account?secret=xxx&issuer=xxx
+func ProvisioningURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// Step 返回时间t所在的时间窗口序号
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// Code 计算密钥在指定时间窗口的一次性密码
+func Code(secret string, step int64) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return "", err
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, bin%mod), nil
+}
+
+// Validate 校验一次性密码
+// 校验成功时返回匹配的时间窗口序号,调用方需要记录这个序号,
+// 拒绝序号小于等于已使用序号的密码,防止同一个密码被重复使用.
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+	now := Step(t)
+	for i := -Skew; i <= Skew; i++ {
+		expect, err := Code(secret, now+int64(i))
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expect), []byte(code)) == 1 {
+			return now + int64(i), true
+		}
+	}
+	return 0, false
+}
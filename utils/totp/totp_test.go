@@ -0,0 +1,47 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录B中的SHA1测试数据,截取后6位
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for ts, expect := range cases {
+		code, err := Code(secret, ts/Period)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != expect {
+			t.Error("time:", ts, "expect:", expect, "got:", code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	code, _ := Code(secret, Step(now)-1)
+	step, ok := Validate(secret, code, now)
+	if !ok || step != Step(now)-1 {
+		t.Error("code in previous step should be valid.")
+	}
+
+	code, _ = Code(secret, Step(now)-3)
+	if _, ok := Validate(secret, code, now); ok {
+		t.Error("code out of skew should be invalid.")
+	}
+}
@@ -55,6 +55,7 @@
                 <th data-field="user_id" data-sortable="true">账户</th>
                 <th data-field="user_name">用户名称</th>
                 <th data-align="center" data-field="status_desc">状态</th>
+                <th data-align="center" data-formatter="UserObj.lockFormatter">锁定信息</th>
                 <th data-field="org_unit_desc" data-sortable="true">机构</th>
                 <th data-align="center" data-field="user_phone">手机号</th>
                 <th data-field="user_email">邮箱</th>
@@ -358,6 +359,59 @@
                 },
             })
         },
+        revokeToken:function (user_id,user_name) {
+            $.Hconfirm({
+                body:"点击确定强制用户["+user_name+"]退出系统",
+                callback:function () {
+                    $.HAjaxRequest({
+                        url:"/v1/auth/user/revoke/token",
+                        type:"post",
+                        data:{userId:user_id},
+                        success:function () {
+                            $.Notify({
+                                title:"温馨提示：",
+                                message:"强制用户退出系统成功",
+                                type:"success",
+                            })
+                        },
+                    })
+                }
+            })
+        },
+        impersonate:function (user_id,user_name) {
+            $.Hconfirm({
+                body:"点击确定模拟用户["+user_name+"]登录系统,模拟期间的操作会同时记录您的账号",
+                callback:function () {
+                    $.HAjaxRequest({
+                        url:"/v1/auth/user/impersonate",
+                        type:"post",
+                        data:{userId:user_id},
+                        success:function () {
+                            window.location.href="/HomePage"
+                        },
+                    })
+                }
+            })
+        },
+        resetMfa:function (user_id,user_name) {
+            $.Hconfirm({
+                body:"点击确定重置用户["+user_name+"]的两步验证,用户需要重新绑定身份验证器",
+                callback:function () {
+                    $.HAjaxRequest({
+                        url:"/v1/auth/mfa/reset",
+                        type:"post",
+                        data:{userId:user_id},
+                        success:function () {
+                            $.Notify({
+                                title:"温馨提示：",
+                                message:"重置两步验证成功",
+                                type:"success",
+                            })
+                        },
+                    })
+                }
+            })
+        },
         delete:function(){
             var $table = $("#h-user-info-table-details")
             var obj =$table.bootstrapTable('getSelections')
@@ -408,8 +462,20 @@
                 },
             })
         },
+        lockFormatter:function(value,rows,index){
+            var fmt = function (t) {
+                return new Date(parseInt(t) * 1000).toLocaleString()
+            };
+            var now = new Date().getTime() / 1000;
+            if (rows.lock_reason == "2") {
+                return '管理员锁定于' + fmt(rows.lock_time)
+            } else if (rows.lock_reason == "1" && parseInt(rows.unlock_time) > now) {
+                return '密码错误次数过多,锁定至' + fmt(rows.unlock_time)
+            }
+            return ''
+        },
         formatter:function(value,rows,index){
-            return '<span class="h-td-btn btn-primary btn-xs" onclick="UserObj.modifyPasswd(\''+rows.user_id+'\')">改密</span>&nbsp;&nbsp;&nbsp;&nbsp;<span class="h-td-btn btn-success btn-xs" onclick="UserObj.modifyStatus(\''+rows.user_id+'\',\''+ rows.status_cd+'\',\''+ rows.user_name+'\')">解锁</span>'
+            return '<span class="h-td-btn btn-primary btn-xs" onclick="UserObj.modifyPasswd(\''+rows.user_id+'\')">改密</span>&nbsp;&nbsp;&nbsp;&nbsp;<span class="h-td-btn btn-success btn-xs" onclick="UserObj.modifyStatus(\''+rows.user_id+'\',\''+ rows.status_cd+'\',\''+ rows.user_name+'\')">解锁</span>&nbsp;&nbsp;&nbsp;&nbsp;<span class="h-td-btn btn-danger btn-xs" onclick="UserObj.revokeToken(\''+rows.user_id+'\',\''+ rows.user_name+'\')">强退</span>&nbsp;&nbsp;&nbsp;&nbsp;<span class="h-td-btn btn-warning btn-xs" onclick="UserObj.resetMfa(\''+rows.user_id+'\',\''+ rows.user_name+'\')">重置MFA</span>&nbsp;&nbsp;&nbsp;&nbsp;<span class="h-td-btn btn-info btn-xs" onclick="UserObj.impersonate(\''+rows.user_id+'\',\''+ rows.user_name+'\')">模拟</span>'
         },
     }
 </script>
@@ -460,6 +526,15 @@
                 </select>
             </div>
         </div>
+        <div class="col-sm-12 col-md-12 col-lg-12" style="margin-top: 8px;">
+            <div class="form-group-sm col-sm-6 col-md-6 col-lg-6">
+                <label class="h-label" style="width: 100%;">用户类型：</label>
+                <select name="userType" class="form-control" style="width: 100%;height: 30px;line-height: 30px;">
+                    <option value="0" selected="selected">普通用户</option>
+                    <option value="1">服务账号(不能登录,密码可以为空)</option>
+                </select>
+            </div>
+        </div>
         <div class="col-sm-12 col-md-12 col-lg-12" style="margin-top: 8px; display: none;">
             <div class="form-group-sm col-sm-6 col-md-6 col-lg-6">
                 <label class="h-label" style="width: 100%;">状　态：</label>
@@ -535,7 +610,7 @@
         </div>
         <div class="form-group col-sm-12 col-md-12 col-lg-12">
             <label class="h-label" style="width: 100%;">新密码：</label>
-            <input placeholder="密码长度必须大于6位，小于30位" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="password" name="newpasswd"/>
+            <input placeholder="新密码需要满足密码策略的要求" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="password" name="newpasswd"/>
         </div>
         <div class="form-group col-sm-12 col-md-12 col-lg-12">
             <label class="h-label" style="width: 100%;">确认密码：</label>
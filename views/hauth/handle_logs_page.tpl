@@ -131,6 +131,30 @@
 
                     },{
 
+                        field: 'api_key',
+
+                        title: 'API key',
+
+                        align: 'left',
+
+                        valign: 'middle',
+
+                        sortable: false
+
+                    }, {
+
+                        field: 'impersonator',
+
+                        title: '模拟登录管理员',
+
+                        align: 'left',
+
+                        valign: 'middle',
+
+                        sortable: false
+
+                    }, {
+
                         field: 'method',
 
                         title: '请求方式',
@@ -264,6 +288,30 @@
 
                 },{
 
+                    field: 'api_key',
+
+                    title: 'API key',
+
+                    align: 'left',
+
+                    valign: 'middle',
+
+                    sortable: false
+
+                }, {
+
+                    field: 'impersonator',
+
+                    title: '模拟登录管理员',
+
+                    align: 'left',
+
+                    valign: 'middle',
+
+                    sortable: false
+
+                }, {
+
                     field: 'method',
 
                     title: '请求方式',
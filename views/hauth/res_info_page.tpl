@@ -187,6 +187,14 @@
                             style="vertical-align: middle;padding-left: 15px;">-
                         </td>
                     </tr>
+                    <tr style="height: 36px;line-height: 36px;">
+                        <td class="col-sm-4 col-md-4 col-lg-4"
+                            style="text-align: right;padding-right: 15px;vertical-align: middle;">请求方法
+                        </td>
+                        <td id="h-resource-show-res-method" class="col-sm-8 col-md-8 col-lg-8"
+                            style="vertical-align: middle;padding-left: 15px;">-
+                        </td>
+                    </tr>
                     <tr style="height: 36px;line-height: 36px;">
                         <td class="col-sm-4 col-md-4 col-lg-4"
                             style="text-align: right;padding-right: 15px;vertical-align: middle;">分组号
@@ -361,6 +369,7 @@
                     $("#h-res-add-res-img").parent().parent().show();
                     $("#h-res-add-res-class").parent().parent().show();
                     $("#h-res-add-res-url").parent().parent().show();
+                    $("#h-res-add-res-method").parent().parent().hide();
                     $("#h-res-modify-res-open-type").parent().parent().show();
                     break;
                 case "1":
@@ -389,6 +398,7 @@
                     $("#h-res-add-res-img").parent().parent().show();
                     $("#h-res-add-res-class").parent().parent().show();
                     $("#h-res-add-res-url").parent().parent().show();
+                    $("#h-res-add-res-method").parent().parent().hide();
                     $("#h-res-modify-res-open-type").parent().parent().show();
                     break;
                 case "2":
@@ -417,6 +427,7 @@
                     $("#h-res-add-res-img").parent().parent().hide();
                     $("#h-res-add-res-class").parent().parent().hide();
                     $("#h-res-add-res-url").parent().parent().show();
+                    $("#h-res-add-res-method").parent().parent().show();
                     $("#h-res-modify-res-open-type").parent().parent().hide();
                     break;
                 case "4":
@@ -444,6 +455,7 @@
                     $("#h-res-add-res-img").parent().parent().hide();
                     $("#h-res-add-res-class").parent().parent().hide();
                     $("#h-res-add-res-url").parent().parent().hide();
+                    $("#h-res-add-res-method").parent().parent().hide();
                     $("#h-res-modify-res-open-type").parent().parent().hide();
                     break;
             }
@@ -459,6 +471,7 @@
                     $("#h-resource-show-res-class").html("-")
                     $("#h-resource-show-res-img").html("-")
                     $("#h-resource-show-res-url").html("-")
+                    $("#h-resource-show-res-method").html("-")
                     $("#h-resource-show-res-group-id").html("-")
                     $("#h-resource-show-res-sort-id").html("-")
                     $("#h-resource-show-res-res-type").html("-")
@@ -471,6 +484,7 @@
                         $("#h-resource-show-res-class").html(element.res_class)
                         $("#h-resource-show-res-img").html(element.res_img)
                         $("#h-resource-show-res-url").html(element.res_url)
+                        $("#h-resource-show-res-method").html(element.res_method)
                         $("#h-resource-show-res-group-id").html(element.group_id)
                         $("#h-resource-show-res-sort-id").html(element.sort_id)
 
@@ -529,6 +543,7 @@
                                     $("#h-resource-show-res-class").html("-");
                                     $("#h-resource-show-res-img").html("-");
                                     $("#h-resource-show-res-url").html("-");
+                                    $("#h-resource-show-res-method").html("-");
                                     $("#h-resource-show-res-group-id").html("-");
                                     $("#h-resource-show-res-sort-id").html("-");
                                     $("#h-resource-show-res-res-type").html("-");
@@ -541,6 +556,7 @@
                                         $("#h-resource-show-res-class").html(element.res_class)
                                         $("#h-resource-show-res-img").html(element.res_img)
                                         $("#h-resource-show-res-url").html(element.res_url)
+                                        $("#h-resource-show-res-method").html(element.res_method)
                                         $("#h-resource-show-res-group-id").html(element.group_id)
                                         $("#h-resource-show-res-sort-id").html(element.sort_id)
                                         $("#h-resource-show-res-res-type").html(element.res_type)
@@ -635,6 +651,7 @@
                     var res_group_id = $("#h-res-modify-group-id").val()
                     var res_sort_id = $("#h-res-modify-sort-id").val()
                     var res_type = $("#h-res-modify-res-type").val()
+                    var res_method = $("#h-res-modify-res-method").val()
 
                     $.HAjaxRequest({
                         url:"/v1/auth/resource/config/theme",
@@ -649,6 +666,7 @@
                             res_group_id:res_group_id,
                             res_sort_id:res_sort_id,
                             res_openType:res_type,
+                            res_method:res_method,
                         },
                         success:function(){
                             $(hmode).remove()
@@ -669,6 +687,7 @@
                     var res_group_id = $("#h-resource-show-res-group-id").html()
                     var res_sort_id = $("#h-resource-show-res-sort-id").html()
                     var open_type = $("#h-resource-show-res-res-type").html();
+                    var res_method = $("#h-resource-show-res-method").html();
 
                     if (res_type == "2") {
                         $("#h-res-modify-res-class").parent().parent().hide()
@@ -677,7 +696,9 @@
                         $("#h-res-modify-group-id").parent().parent().hide()
                         $("#h-res-modify-sort-id").parent().parent().hide()
                         $("#h-res-modify-res-type").parent().parent().hide()
+                        $("#h-res-modify-res-method").val(res_method == "-" ? "" : res_method)
                     } else {
+                        $("#h-res-modify-res-method").parent().parent().hide()
                         $("#h-res-modify-res-class").Hselect({
                             height:"30px",
                             value:res_class,
@@ -773,7 +794,13 @@
         <div class="col-sm-6 col-md-6 col-lg-6" style="margin-top: 8px;">
             <label class="col-sm-12 control-label" style="font-size: 14px; font-weight: 500;text-align: left">路由信息：</label>
             <div class="col-sm-12">
-                <input id="h-res-add-res-url" placeholder="如：/v1/auth/help" type="url" class="form-control" name="res_url" style="height: 30px; line-height: 30px;">
+                <input id="h-res-add-res-url" placeholder="如：/v1/auth/help，可以使用{name}、*与**" type="url" class="form-control" name="res_url" style="height: 30px; line-height: 30px;">
+            </div>
+        </div>
+        <div class="col-sm-6 col-md-6 col-lg-6" style="display: none;margin-top: 8px;">
+            <label class="col-sm-12 control-label" style="font-size: 14px; font-weight: 500;text-align: left">请求方法：</label>
+            <div class="col-sm-12">
+                <input id="h-res-add-res-method" placeholder="如：GET,POST，为空时允许所有方法" type="text" class="form-control" name="res_method" style="height: 30px; line-height: 30px;">
             </div>
         </div>
         <div class="col-sm-6 col-md-6 col-lg-6" style="margin-top: 8px;">
@@ -879,7 +906,13 @@
         <div class="col-sm-6 col-md-6 col-lg-6" style="margin-top: 8px;">
             <label class="col-sm-12 control-label" style="font-size: 14px; font-weight: 500;text-align: left">路由信息：</label>
             <div class="col-sm-12">
-                <input id="h-res-modify-res-url" placeholder="如：/v1/auth/help" type="url" class="form-control" name="res_url" style="height: 30px; line-height: 30px;">
+                <input id="h-res-modify-res-url" placeholder="如：/v1/auth/help，可以使用{name}、*与**" type="url" class="form-control" name="res_url" style="height: 30px; line-height: 30px;">
+            </div>
+        </div>
+        <div class="col-sm-6 col-md-6 col-lg-6" style="margin-top: 8px;">
+            <label class="col-sm-12 control-label" style="font-size: 14px; font-weight: 500;text-align: left">请求方法：</label>
+            <div class="col-sm-12">
+                <input id="h-res-modify-res-method" placeholder="如：GET,POST，为空时允许所有方法" type="text" class="form-control" name="res_method" style="height: 30px; line-height: 30px;">
             </div>
         </div>
         <div class="col-sm-6 col-md-6 col-lg-6" style="margin-top: 8px;">
@@ -28,7 +28,7 @@
             <div class="col-ms-12 col-md-12 col-lg-12" style="margin-top: 3%">
                 <div style="border-bottom: #598f56 solid 1px;height: 44px; line-height: 44px;">
                     <div class="pull-left">
-                        <span><i class="icon-sitemap"> </i>已经被授权获取菜单资源:</span>
+                        <span><i class="icon-sitemap"> </i>已经被授权获取菜单资源(包括继承的资源):</span>
                     </div>
                     <div class="pull-right">
                         <span>
@@ -115,6 +115,10 @@
                         var ijs = {}
                         ijs.id=element.res_id
                         ijs.text = element.res_name
+                        // 从上级角色继承的资源
+                        if (element.from_role != undefined && element.from_role != "") {
+                            ijs.text = element.res_name + "（继承自 " + element.from_role + "）"
+                        }
                         ijs.upId = element.res_up_id
                         arr.push(ijs)
                     });
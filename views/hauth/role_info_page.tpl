@@ -39,6 +39,8 @@
                 <th data-field="role_name">角色名称</th>
                 <th data-align="center"
                     data-field="role_status_desc">状态</th>
+                <th data-field="parent_role_id"
+                    data-formatter="RoleObj.parentFormatter">上级角色</th>
                 <th data-field="domain_desc">所属域</th>
                 <th data-align="center"
                     data-field="create_user">创建人</th>
@@ -75,6 +77,28 @@
                 }
             })
         },
+        parentFormatter:function(value,rows,index){
+            if (value == undefined || value == "") {
+                return "";
+            }
+            var name = value;
+            $($("#h-role-info-table-details").bootstrapTable('getData')).each(function (i, element) {
+                if (element.role_id == value) {
+                    name = element.role_name;
+                }
+            });
+            return name;
+        },
+        // 上级角色下拉框,只能选择当前域中除自身以外的角色
+        initParent:function (selector, role_id, value) {
+            var $select = $(selector);
+            $($("#h-role-info-table-details").bootstrapTable('getData')).each(function (index, element) {
+                if (element.role_id != role_id) {
+                    $select.append($("<option></option>").val(element.role_id).text(element.role_name));
+                }
+            });
+            $select.val(value || "");
+        },
         formatter:function(value,rows,index){
             return '<span class="h-td-btn btn-primary btn-xs" onclick="RoleObj.getResourcePage(\''+rows.role_id+'\',\''+ rows.role_name+'\')">资源管理</span>'
         },
@@ -102,6 +126,7 @@
                         });
                     });
                     $("#h-role-add-status").Hselect({height:"30px"})
+                    RoleObj.initParent("#h-role-add-parent", "", "")
                 },
                 callback:function (hmode) {
                     $.HAjaxRequest({
@@ -135,25 +160,28 @@
                 var role_id = rows[0].role_id;
                 var role_name = rows[0].role_name;
                 var status_id = rows[0].role_status_code;
+                var parent_role_id = rows[0].parent_role_id;
 
                 console.log(code_number,role_id,role_name,status_id)
                 $.Hmodal({
                     header:"编辑角色信息",
                     body:$("#role_modify_form").html(),
-                    height:"320px",
+                    height:"380px",
                     preprocess:function () {
                         $("#h-role-modify-role-code-number").val(code_number)
                         $("#h-role-modify-role-name").val(role_name)
                         $("#h-role-modify-role-status-cd").Hselect({height:"30px"})
                         $("#h-role-modify-role-status-cd").val(status_id).trigger("change")
+                        RoleObj.initParent("#h-role-modify-parent", role_id, parent_role_id)
                     },
                     callback:function (hmode) {
                         var new_name = $("#h-role-modify-role-name").val()
                         var new_status = $("#h-role-modify-role-status-cd").val()
+                        var new_parent = $("#h-role-modify-parent").val()
                         $.HAjaxRequest({
                             url:"/v1/auth/role/update",
                             type:"put",
-                            data:{Role_id:role_id,Role_name:new_name,Role_status:new_status},
+                            data:{Role_id:role_id,Role_name:new_name,Role_status:new_status,Parent_role_id:new_parent},
                             success:function () {
                                 $.Notify({
                                     title:"操作成功",
@@ -269,6 +297,12 @@
             <select id="h-role-domain-id" name="domain_id" style="width: 100%;height: 30px;line-height: 30px;">
             </select>
         </div>
+        <div class="form-group-sm col-sm-6 col-md-6 col-lg-6" style="margin-top: 15px;">
+            <label class="h-label" style="width: 100%;">上级角色：</label>
+            <select id="h-role-add-parent" name="parent_role_id" class="form-control" style="width: 100%;height: 30px;line-height: 30px;">
+                <option value="">无</option>
+            </select>
+        </div>
     </form>
 </script>
 
@@ -290,5 +324,11 @@
                 <option value="1">失效</option>
             </select>
         </div>
+        <div class="col-sm-12 col-md-12 col-lg-12" style="margin-top: 8px;">
+            <label class="h-label" style="width: 100%;">上级角色：</label>
+            <select id="h-role-modify-parent" name="parent_role_id" class="form-control" style="width: 100%;height: 30px;line-height: 30px;">
+                <option value="">无</option>
+            </select>
+        </div>
     </form>
 </script>
\ No newline at end of file
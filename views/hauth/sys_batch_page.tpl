@@ -74,8 +74,8 @@
             $.getJSON("/v1/auth/role/get", {domain_id: domain_id}, function (data) {
                 $.Hmodal({
                     header: "授权管理",
-                    body: $("#h-other-user-role-table-details-html").html(),
-                    height: "360px",
+                    body: $("#h-grant-role-scope-html").html(),
+                    height: "400px",
                     submitDesc: "授予角色",
                     cancelDesc: "关闭",
                     preprocess: function () {
@@ -86,16 +86,20 @@
                             striped: true,
                         });
                         $table.bootstrapTable('load', data)
+                        AuthObj.initScope();
                     },
                     callback: function (hmode) {
                         var $table = $("#h-other-user-role-table-details");
                         var sect = $table.bootstrapTable('getSelections');
+                        var scope = AuthObj.getScope();
                         var arr = new Array();
                         $(userList).each(function (index1, user) {
                             $(sect).each(function (index2, role) {
                                 var e = {};
                                 e.user_id = user.user_id;
                                 e.role_id = role.role_id;
+                                e.org_scope = scope.org_scope;
+                                e.org_scope_sub = scope.org_scope_sub;
                                 arr.push(e);
                             });
                         });
@@ -197,8 +201,8 @@
             $.getJSON("/v1/auth/user/roles/other",{user_id:user_id},function (data) {
                 $.Hmodal({
                     header:"授权管理",
-                    body:$("#h-other-user-role-table-details-html").html(),
-                    height:"360px",
+                    body:$("#h-grant-role-scope-html").html(),
+                    height:"400px",
                     submitDesc:"授权",
                     cancelDesc:"关闭",
                     preprocess:function () {
@@ -209,13 +213,17 @@
                             striped:true,
                         });
                         $table.bootstrapTable('load',data)
+                        AuthObj.initScope();
                     },
                     callback:function (hmode) {
                         var $table =  $("#h-other-user-role-table-details");
                         var sect = $table.bootstrapTable('getSelections');
+                        var scope = AuthObj.getScope();
                         var arr = new Array();
                         $(sect).each(function (index,element) {
                             element.user_id = user_id
+                            element.org_scope = scope.org_scope
+                            element.org_scope_sub = scope.org_scope_sub
                             arr.push(element)
                         });
                         $.HAjaxRequest({
@@ -238,6 +246,22 @@
                 })
             });
         },
+        // 授权时选择机构范围,不选择机构时不限制机构
+        initScope:function () {
+            var did = $("#h-auth-domain-list").val();
+            $.getJSON("/v1/auth/resource/org/get",{domain_id:did},function (data) {
+                var $select = $("#h-grant-role-org-scope");
+                $(data).each(function (index,element) {
+                    $select.append($("<option></option>").val(element.org_id).text(element.org_desc));
+                });
+            });
+        },
+        getScope:function () {
+            return {
+                org_scope:$("#h-grant-role-org-scope").val(),
+                org_scope_sub:$("#h-grant-role-org-scope-sub").is(":checked") ? "1" : "0",
+            }
+        },
         formatter:function (value,row,index) {
             var user_id = row.user_id;
             var role_id = row.role_id;
@@ -389,3 +413,31 @@
         </thead>
     </table>
 </script>
+
+<script type="text/html" id="h-grant-role-scope-html">
+    <div style="height: 36px; line-height: 36px;">
+        <span style="font-size: 10px;font-weight: 600;" class="pull-left">机构范围：</span>
+        <select id="h-grant-role-org-scope" class="form-control pull-left"
+                style="width: 240px;height: 24px; line-height: 24px; margin-top: 6px; padding: 0px;">
+            <option value="">不限制机构</option>
+        </select>
+        <label style="font-size: 10px; margin-left: 12px;">
+            <input id="h-grant-role-org-scope-sub" type="checkbox" checked="checked"> 包含下级机构
+        </label>
+    </div>
+    <table id="h-other-user-role-table-details"
+           data-toggle="table"
+           data-side-pagination="client"
+           data-pagination="false"
+           data-page-list="[20, 50, 100, 200]"
+           data-click-to-select="true"
+           data-search="false">
+        <thead>
+        <tr>
+            <th data-field="state" data-checkbox="true">角色编码</th>
+            <th data-field="code_number" data-sortable="true">角色编码</th>
+            <th data-field="role_name">角色名称</th>
+        </tr>
+        </thead>
+    </table>
+</script>
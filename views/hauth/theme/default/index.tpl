@@ -51,6 +51,12 @@
 </head>
 
 <body style="overflow: hidden" class="hzwy23-theme-background">
+{{if .Impersonator}}
+<div id="h-impersonate-banner" class="alert alert-warning" style="position: fixed; top: 0; left: 0; right: 0; z-index: 2147483646; margin: 0; padding: 6px 15px; border-radius: 0; text-align: center;">
+	<i class="icon-warning-sign"></i>&nbsp;管理员 {{.Impersonator}} 正在模拟用户 {{.UserId}} 登录, {{.ExpiresAt}} 自动结束
+	<button class="btn btn-xs btn-warning" style="margin-left: 15px;" onclick="Hutils.HEndImpersonate()">结束模拟</button>
+</div>
+{{end}}
 <div id="bigdata-platform-subsystem"
 	 style="margin-right:0px; background-size: cover; overflow: hidden;">
 	<div style="position: relative; height: 60px; text-align: left;">
@@ -58,7 +64,7 @@
 			<h3 id="huuid" style="color: #ffffff; font-size: 23px; font-weight: 700; height: 60px; line-height: 60px;">大数据应用分析平台</h3>
 		</div>
 		<div class="col-sm-6 col-md-6 col-lg-6" style="padding-left: 30px; text-align: right">
-			<span class="label label-primary" style="color: #ffffff; font-size: 14px; font-weight: 700; height: 60px; line-height: 60px;"><i class="icon-user"></i>&nbsp;{{.}}</span>
+			<span class="label label-primary" style="color: #ffffff; font-size: 14px; font-weight: 700; height: 60px; line-height: 60px;"><i class="icon-user"></i>&nbsp;{{.UserId}}</span>
 		</div>
 	</div>
 	<div id="wrap" class="col-sm-12 col-md-12 col-lg-12" style="overflow: auto;">
@@ -216,6 +222,34 @@
         var hh = document.documentElement.clientHeight;
         $("#wrap").height(hh-96);
     }
+    /*
+     * 用户有操作时,在访问token过期前刷新token,
+     * 长时间没有操作,刷新token过期后,需要重新登录.
+     * */
+    $(function () {
+        var lastRefresh = new Date().getTime();
+        var refreshing = false;
+        var expiresIn = 900;
+        var refresh = function () {
+            if (refreshing || $("#h-impersonate-banner").length > 0 || new Date().getTime() - lastRefresh < expiresIn * 1000 / 2) {
+                return
+            }
+            refreshing = true;
+            $.ajax({
+                url:"/v1/auth/token/refresh",
+                type:"post",
+                dataType:"json",
+                success:function (data) {
+                    expiresIn = data.expires_in;
+                    lastRefresh = new Date().getTime();
+                },
+                complete:function () {
+                    refreshing = false;
+                }
+            })
+        };
+        $(document).on("click keydown mousemove", refresh);
+    });
 </script>
 
 <script id="mas-passwd-prop" type="text/html">
@@ -291,7 +325,7 @@
 		</div>
 		<div class="form-group col-sm-12 col-md-12 col-lg-12">
 			<label class="h-label" style="width: 100%;">新密码：</label>
-			<input placeholder="密码长度必须大于6位，小于30位" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="password" name="newpasswd"/>
+			<input placeholder="新密码需要满足密码策略的要求" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="password" name="newpasswd"/>
 		</div>
 		<div class="form-group col-sm-12 col-md-12 col-lg-12">
 			<label class="h-label" style="width: 100%;">确认密码：</label>
@@ -39,6 +39,8 @@
         <div id="h-login-button-list" style="margin-top: 26px;">
             <button onclick="LoginSubmit(this)" class="btn btn-xs btn-success" style="margin-left: -16px;">登陆</button>
             <button class="btn btn-xs btn-info" onclick="clearInputForm(this)" style="margin-left: 6px;">重置</button>
+            <button class="btn btn-xs btn-primary" onclick="loginOidc(this)" style="margin-left: 6px;">企业账号</button>
+            <button class="btn btn-xs btn-warning" onclick="forgotPasswd(this)" style="margin-left: 6px;">忘记密码</button>
         </div>
     </div>
 </div>
@@ -73,6 +75,40 @@
             async: true,
         });
         NProgress.done();
+
+        // 已经登录的用户直接确认授权
+        if (oauthRequest) {
+            oauthConsent(true);
+        }
+
+        // 外部身份提供者登录失败后回到登录页面,显示错误信息
+        var loginError = new RegExp("[?&]login_error=([^&]*)").exec(window.location.search);
+        if (loginError) {
+            $.Notify({
+                title: "温馨提示",
+                message: $("<div>").text(decodeURIComponent(loginError[1].replace(/\+/g, " "))).html(),
+                type: "warning",
+                placement: {from: "bottom", align: "right"},
+            })
+        }
+
+        // 外部身份提供者登录后,需要完成两步验证
+        var mfaChallenge = new RegExp("[#&]mfa_challenge=([0-9a-f]+)").exec(window.location.hash);
+        if (mfaChallenge) {
+            var mfaEnrolled = /[#&]mfa_enrolled=1/.test(window.location.hash);
+            history.replaceState(null, "", window.location.pathname + window.location.search);
+            if (mfaEnrolled) {
+                loginMfa(mfaChallenge[1])
+            } else {
+                enrollMfa(mfaChallenge[1])
+            }
+        }
+
+        // 打开邮件中的重置密码链接,设置新密码
+        var resetToken = new RegExp("[?&]reset_token=([^&]*)").exec(window.location.search);
+        if (resetToken) {
+            resetPasswd(decodeURIComponent(resetToken[1]));
+        }
     });
 
     function clearInputForm(obj) {
@@ -107,7 +143,15 @@
             data: {username: user, password: psd},
             dataType: 'json',
             success: function (data) {
-                window.location.href = "/HomePage";
+                if (data.mfa_required) {
+                    if (data.mfa_enrolled) {
+                        loginMfa(data.challenge)
+                    } else {
+                        enrollMfa(data.challenge)
+                    }
+                    return
+                }
+                afterLogin();
             },
             error: function (msg) {
                 var imsg = JSON.parse(msg.responseText);
@@ -126,30 +170,42 @@
                         placement: {from: "bottom", align: "right"},
                     })
                 } else if (imsg.error_code == 403) {
+                    // error_details中是自动解锁的时间,单位:秒
+                    var unlock = imsg.error_details && imsg.error_details[0];
+                    var minutes = Math.max(1, Math.ceil((unlock * 1000 - new Date().getTime()) / 60000));
                     $.Notify({
                         title: "温馨提示",
-                        message: "已经连续6次输错密码,用户已被锁定,请联系管理员",
+                        message: "连续输错密码次数过多,用户已被锁定,请" + minutes + "分钟后再登录",
                         type: "warning",
                         placement: {from: "bottom", align: "right"},
                     })
-                } else if (imsg.error_code == 404) {
+                } else if (imsg.error_code == 405) {
                     $.Notify({
                         title: "温馨提示",
-                        message: "已经连续6次输错密码,用户已被锁定,请联系管理员",
+                        message: "用户密码输入错误,如忘记密码,请联系管理员",
                         type: "warning",
                         placement: {from: "bottom", align: "right"},
                     })
-                } else if (imsg.error_code == 405) {
+                } else if (imsg.error_code == 406) {
                     $.Notify({
                         title: "温馨提示",
-                        message: "用户密码输入错误,如忘记密码,请联系管理员",
+                        message: "用户已被锁定,请联系管理员",
                         type: "warning",
                         placement: {from: "bottom", align: "right"},
                     })
-                } else if (imsg.error_code == 406) {
+                } else if (imsg.error_code == 407) {
+                    changeExpiredPasswd(user, psd, imsg.error_msg)
+                } else if (imsg.error_code == 429) {
                     $.Notify({
                         title: "温馨提示",
-                        message: "用户已被锁定,请联系管理员",
+                        message: "登录请求过于频繁,请" + msg.getResponseHeader("Retry-After") + "秒后再试",
+                        type: "warning",
+                        placement: {from: "bottom", align: "right"},
+                    })
+                } else {
+                    $.Notify({
+                        title: "温馨提示",
+                        message: imsg.error_msg,
                         type: "warning",
                         placement: {from: "bottom", align: "right"},
                     })
@@ -157,8 +213,343 @@
             }
         });
     };
+
+    /*
+     * 密码已经过期,或者管理员重置了密码,修改密码后重新登录
+     * */
+    function changeExpiredPasswd(user, psd, msg) {
+        $.Hmodal({
+            header: msg,
+            body: $("#h-login-expired-passwd").html(),
+            height: "320px",
+            width: "520px",
+            callback: function (hmode) {
+                var form = $("#h-login-expired-form");
+                var newpd = form.find('input[name="newpasswd"]').val();
+                var surpd = form.find('input[name="surepasswd"]').val();
+                if (newpd != surpd) {
+                    $.Notify({
+                        message: "两次输入的新密码不一致，请确认是否存在多余的空格",
+                        type: "danger",
+                    });
+                    return
+                }
+                $.HAjaxRequest({
+                    type: "post",
+                    url: "/passwd/expired",
+                    data: {username: user, orapasswd: psd, newpasswd: newpd, surepasswd: surpd},
+                    dataType: "json",
+                    success: function () {
+                        $(hmode).remove();
+                        $("#h-login-login-input").find("input[name='password']").val("");
+                        $.Notify({
+                            message: "修改密码成功,请使用新密码登录",
+                            type: "success",
+                        })
+                    },
+                });
+            }
+        })
+    };
+    /*
+     * 忘记密码,向账号配置的邮箱发送重置密码链接
+     * */
+    function forgotPasswd(obj) {
+        $.Hmodal({
+            header: "找回密码",
+            body: $("#h-login-forgot-passwd").html(),
+            height: "240px",
+            width: "420px",
+            preprocess: function () {
+                var user = $("#h-login-login-input").find("input[name='username']").val();
+                $("#h-login-forgot-form").find('input[name="username"]').val(user);
+            },
+            callback: function (hmode) {
+                var user = $("#h-login-forgot-form").find('input[name="username"]').val();
+                if (user == "") {
+                    return
+                }
+                $.HAjaxRequest({
+                    type: "post",
+                    url: "/passwd/forgot",
+                    data: {username: user},
+                    dataType: "json",
+                    success: function (msg) {
+                        $(hmode).remove();
+                        $.Notify({
+                            message: msg.data,
+                            type: "success",
+                        })
+                    },
+                });
+            }
+        })
+    };
+
+    /*
+     * 使用邮件中的重置密码链接设置新密码,成功后重新登录
+     * */
+    function resetPasswd(token) {
+        $.Hmodal({
+            header: "设置新密码",
+            body: $("#h-login-expired-passwd").html(),
+            height: "320px",
+            width: "520px",
+            callback: function (hmode) {
+                var form = $("#h-login-expired-form");
+                var newpd = form.find('input[name="newpasswd"]').val();
+                var surpd = form.find('input[name="surepasswd"]').val();
+                if (newpd != surpd) {
+                    $.Notify({
+                        message: "两次输入的新密码不一致，请确认是否存在多余的空格",
+                        type: "danger",
+                    });
+                    return
+                }
+                $.HAjaxRequest({
+                    type: "post",
+                    url: "/passwd/reset",
+                    data: {reset_token: token, newpasswd: newpd, surepasswd: surpd},
+                    dataType: "json",
+                    success: function () {
+                        $(hmode).remove();
+                        window.history.replaceState(null, "", window.location.pathname);
+                        $.Notify({
+                            message: "设置密码成功,请使用新密码登录",
+                            type: "success",
+                        })
+                    },
+                });
+            }
+        })
+    };
+
+    /*
+     * 两步验证,输入身份验证器中的验证码,或者恢复码
+     * */
+    function loginMfa(challenge) {
+        $.Hmodal({
+            header: "两步验证",
+            body: $("#h-login-mfa").html(),
+            height: "240px",
+            width: "420px",
+            callback: function (hmode) {
+                var code = $("#h-login-mfa-form").find('input[name="code"]').val();
+                $.HAjaxRequest({
+                    type: "post",
+                    url: "/login/mfa",
+                    data: {challenge: challenge, code: code},
+                    dataType: "json",
+                    success: function (data) {
+                        $(hmode).remove();
+                        if ($.isArray(data) && data.length > 0) {
+                            showRecoveryCodes(data);
+                            return
+                        }
+                        afterLogin();
+                    },
+                });
+            }
+        })
+    };
+
+    /*
+     * 要求两步验证但是还没有绑定身份验证器的用户,先绑定身份验证器
+     * */
+    function enrollMfa(challenge) {
+        $.HAjaxRequest({
+            type: "post",
+            url: "/login/mfa/enroll",
+            data: {challenge: challenge},
+            dataType: "json",
+            success: function (data) {
+                $.Hmodal({
+                    header: "绑定身份验证器",
+                    body: $("#h-login-mfa-enroll").html(),
+                    height: "360px",
+                    width: "520px",
+                    preprocess: function () {
+                        var form = $("#h-login-mfa-form");
+                        form.find(".h-mfa-secret").text(data.secret);
+                        form.find(".h-mfa-uri").text(data.uri);
+                    },
+                    callback: function (hmode) {
+                        var code = $("#h-login-mfa-form").find('input[name="code"]').val();
+                        $.HAjaxRequest({
+                            type: "post",
+                            url: "/login/mfa",
+                            data: {challenge: challenge, code: code},
+                            dataType: "json",
+                            success: function (data) {
+                                $(hmode).remove();
+                                showRecoveryCodes(data);
+                            },
+                        });
+                    }
+                })
+            },
+        });
+    };
+
+    /*
+     * 使用域配置的外部身份提供者登录
+     * */
+    function loginOidc(obj) {
+        $.Hmodal({
+            header: "企业账号登录",
+            body: $("#h-login-oidc").html(),
+            height: "240px",
+            width: "420px",
+            callback: function (hmode) {
+                var domain = $("#h-login-oidc-form").find('input[name="domain_id"]').val();
+                if (domain == "") {
+                    return
+                }
+                $(hmode).remove();
+                window.location.href = "/login/oidc?domain_id=" + encodeURIComponent(domain);
+            }
+        })
+    };
+
+    /*
+     * 从其他应用跳转过来的授权请求,登录后确认是否授权应用获取用户信息
+     * */
+    var oauthRequest = window.location.pathname == "/oauth2/authorize";
+
+    function afterLogin() {
+        if (oauthRequest) {
+            oauthConsent(false);
+            return
+        }
+        window.location.href = "/HomePage";
+    };
+
+    function oauthConsent(onlyLoggedIn) {
+        $.HAjaxRequest({
+            url: "/oauth2/authorize/info" + window.location.search,
+            type: "get",
+            dataType: "json",
+            success: function (info) {
+                if (onlyLoggedIn && !info.logged_in) {
+                    return
+                }
+                $.Hmodal({
+                    header: "授权确认",
+                    body: $("#h-login-oauth-consent").html(),
+                    height: "280px",
+                    width: "420px",
+                    submitDesc: "同意",
+                    cancelDesc: "拒绝",
+                    preprocess: function (hmode) {
+                        $(hmode).find(".h-oauth-client").text(info.client_name);
+                        $(hmode).find(".h-oauth-user").text(info.user_id);
+                        $(hmode).find(".h-oauth-scopes").text(info.scopes.join(", "));
+                        $(hmode).find("button.cancel").on("click", function () {
+                            oauthDecide("deny");
+                        });
+                    },
+                    callback: function (hmode) {
+                        $(hmode).remove();
+                        oauthDecide("allow");
+                    }
+                })
+            },
+        });
+    };
+
+    function oauthDecide(consent) {
+        $.HAjaxRequest({
+            url: "/oauth2/authorize" + window.location.search,
+            type: "post",
+            data: {consent: consent},
+            dataType: "json",
+            success: function (data) {
+                window.location.href = data.redirect;
+            },
+        });
+    };
+
+    /*
+     * 恢复码只显示一次,用户保存后进入主页
+     * */
+    function showRecoveryCodes(codes) {
+        $.Hmodal({
+            header: "请妥善保存恢复码",
+            body: $("#h-login-mfa-recovery").html(),
+            height: "360px",
+            width: "420px",
+            preprocess: function () {
+                $("#h-login-mfa-codes").text(codes.join("\n"));
+            },
+            callback: function (hmode) {
+                $(hmode).remove();
+                afterLogin();
+            }
+        })
+    };
     /*]]>*/
 </script>
+<script id="h-login-mfa" type="text/html">
+    <form id="h-login-mfa-form" class="col-sm-12 col-md-12 col-lg-12">
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">验证码：</label>
+            <input placeholder="身份验证器中的6位验证码,或者恢复码" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="text" name="code" autocomplete="off"/>
+        </div>
+    </form>
+</script>
+<script id="h-login-mfa-enroll" type="text/html">
+    <form id="h-login-mfa-form" class="col-sm-12 col-md-12 col-lg-12">
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">请使用身份验证器添加以下密钥,或者扫描下面地址生成的二维码：</label>
+            <pre class="h-mfa-secret"></pre>
+            <pre class="h-mfa-uri" style="white-space: pre-wrap; word-break: break-all;"></pre>
+        </div>
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">验证码：</label>
+            <input placeholder="身份验证器中的6位验证码" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="text" name="code" autocomplete="off"/>
+        </div>
+    </form>
+</script>
+<script id="h-login-oidc" type="text/html">
+    <form id="h-login-oidc-form" class="col-sm-12 col-md-12 col-lg-12">
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">域编码：</label>
+            <input placeholder="请输入所在的域编码,跳转到企业统一身份认证登录" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="text" name="domain_id"/>
+        </div>
+    </form>
+</script>
+<script id="h-login-oauth-consent" type="text/html">
+    <div class="col-sm-12 col-md-12 col-lg-12">
+        <label class="h-label" style="width: 100%;">应用 <span class="h-oauth-client"></span> 请求使用您的账号 <span class="h-oauth-user"></span> 登录,并获取以下信息：</label>
+        <pre class="h-oauth-scopes"></pre>
+    </div>
+</script>
+<script id="h-login-mfa-recovery" type="text/html">
+    <div class="col-sm-12 col-md-12 col-lg-12">
+        <label class="h-label" style="width: 100%;">身份验证器丢失时,可以使用恢复码登录,每个恢复码只能使用一次：</label>
+        <pre id="h-login-mfa-codes"></pre>
+    </div>
+</script>
+<script id="h-login-forgot-passwd" type="text/html">
+    <form id="h-login-forgot-form" class="col-sm-12 col-md-12 col-lg-12">
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">账号：</label>
+            <input placeholder="重置密码链接将发送到账号配置的邮箱" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="text" name="username"/>
+        </div>
+    </form>
+</script>
+<script id="h-login-expired-passwd" type="text/html">
+    <form id="h-login-expired-form" class="col-sm-12 col-md-12 col-lg-12">
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">新密码：</label>
+            <input placeholder="新密码需要满足密码策略的要求" class="form-control" style="width:100%;height: 30px; line-height: 30px;" type="password" name="newpasswd"/>
+        </div>
+        <div class="form-group col-sm-12 col-md-12 col-lg-12">
+            <label class="h-label" style="width: 100%;">确认密码：</label>
+            <input placeholder="请确认新密码信息" class="form-control" style="height: 30px; line-height: 30px; width: 100%;" type="password" name="surepasswd"/>
+        </div>
+    </form>
+</script>
 <script type="text/javascript" src="/static/jquery-i18n-properties/jquery.i18n.properties.min.js"></script>
 <script type="text/javascript" src="/static/js/utils.min.js"></script>
 <script type="text/javascript" src="/static/js/bootstrap-notify.min.js"></script>